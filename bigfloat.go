@@ -0,0 +1,463 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// BigFloatPrec is the precision, in mantissa bits, that a BigFloat parses
+// text, JSON and scanned strings with when it does not already hold a
+// *big.Float, enough for about 77 significant decimal digits.
+const BigFloatPrec = 256
+
+// BigExponentLimit is the largest decimal exponent, in absolute value, of a
+// number that BigFloat, BigRat and Decimal parse from JSON, text or a scanned
+// string, so that 1e1000 is accepted and 1e1001 and 1e-1001 are not.
+// Formatting a number takes time and space that grow with its exponent, so
+// without a limit a few bytes of input such as 1e1000000 could take seconds
+// to marshal back, or marshal to a megabyte of digits. Fractions such as "1/3" are not
+// limited, since their decimal form is no longer than their digits.
+var BigExponentLimit = 1000
+
+// BigFloat is a nullable *big.Float, for arbitrary-precision floating point
+// numbers such as Postgres numeric columns. A nil BigFloat.BigFloat is
+// treated as null. The *big.Float is shared, not copied, by the constructors
+// and SetValid.
+//
+// Unmarshaling and Scan replace BigFloat with a new *big.Float rather than
+// modifying it. If BigFloat already holds one, the new value keeps its
+// precision and rounding mode, so a field can be given a precision before
+// decoding into it; otherwise BigFloatPrec is used. The binary and gob
+// encodings keep the precision and mode of the value they encode.
+type BigFloat struct {
+	BigFloat *big.Float
+	Valid    bool
+}
+
+// NewBigFloat creates a new BigFloat
+func NewBigFloat(f *big.Float, valid bool) BigFloat {
+	return BigFloat{
+		BigFloat: f,
+		Valid:    valid,
+	}
+}
+
+// BigFloatFrom creates a new BigFloat that will be null if f is nil. There is
+// no BigFloatFromPtr, since a *big.Float is already a pointer.
+func BigFloatFrom(f *big.Float) BigFloat {
+	return NewBigFloat(f, f != nil)
+}
+
+// ParseBigFloat parses a decimal number such as "12.34" into a valid BigFloat,
+// as UnmarshalText does, but returns an error rather than a null BigFloat for
+// empty text.
+func ParseBigFloat(s string) (BigFloat, error) {
+	return parseText[BigFloat]("BigFloat", s)
+}
+
+// MustBigFloat is like ParseBigFloat, but panics if s cannot be parsed. It is
+// meant for test fixtures and package level values written as string literals.
+func MustBigFloat(s string) BigFloat {
+	v, err := ParseBigFloat(s)
+	return mustParse("MustBigFloat", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a bare number of any size or precision, or a quoted string
+// holding one, and an empty string will be null.
+func (f *BigFloat) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		f.BigFloat = nil
+		f.Valid = false
+		return nil
+	}
+
+	s, err := jsonNumber(data, "BigFloat")
+	if err != nil || s == "" {
+		f.BigFloat = nil
+		f.Valid = false
+		return err
+	}
+
+	x, err := parseBigFloat(s, f.BigFloat)
+	f.BigFloat = x
+	f.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null BigFloat if the input is blank.
+func (f *BigFloat) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		f.BigFloat = nil
+		f.Valid = false
+		return nil
+	}
+
+	x, err := parseBigFloat(string(text), f.BigFloat)
+	f.BigFloat = x
+	f.Valid = err == nil
+	return err
+}
+
+// parseBigFloat parses a number in JSON syntax into a new *big.Float with the
+// precision and rounding mode of like, or BigFloatPrec if like is nil or has
+// no precision set. It returns nil on error.
+func parseBigFloat(s string, like *big.Float) (*big.Float, error) {
+	x := newBigFloat(like)
+	if !isJSONNumber(s) {
+		return nil, fmt.Errorf("null: invalid number %q for null.BigFloat", s)
+	}
+	if err := checkBigExponent(s, "BigFloat"); err != nil {
+		return nil, err
+	}
+	if _, _, err := x.Parse(s, 10); err != nil {
+		return nil, fmt.Errorf("null: invalid number %q for null.BigFloat", s)
+	}
+	return x, nil
+}
+
+// newBigFloat returns a new zero *big.Float with the precision and rounding
+// mode of like, or BigFloatPrec if like is nil or has no precision set.
+func newBigFloat(like *big.Float) *big.Float {
+	if like == nil || like.Prec() == 0 {
+		return new(big.Float).SetPrec(BigFloatPrec)
+	}
+	return new(big.Float).SetPrec(like.Prec()).SetMode(like.Mode())
+}
+
+// checkBigExponent returns an error if the number s, in JSON syntax, has a
+// decimal exponent beyond BigExponentLimit.
+func checkBigExponent(s, typ string) error {
+	if exp, ok := decimalExponent(s); !ok || exp > BigExponentLimit || exp < -BigExponentLimit {
+		return fmt.Errorf("null: %q is out of range for null.%s, whose exponent is limited to ±%d by BigExponentLimit", s, typ, BigExponentLimit)
+	}
+	return nil
+}
+
+// decimalExponent returns the decimal exponent of the first significant digit
+// of the number s, in JSON syntax, so that 12345, 1.2345e4 and 0.00012345e8
+// all give 4, and zero gives 0. It returns false if the exponent does not fit
+// in an int32.
+func decimalExponent(s string) (int, bool) {
+	mant, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return 0, false
+		}
+		mant, exp = s[:i], int(e)
+	}
+	whole, frac, _ := strings.Cut(strings.TrimPrefix(mant, "-"), ".")
+	if whole = strings.TrimLeft(whole, "0"); whole != "" {
+		return exp + len(whole) - 1, true
+	}
+	if digits := strings.TrimLeft(frac, "0"); digits != "" {
+		return exp - (len(frac) - len(digits)) - 1, true
+	}
+	return 0, true
+}
+
+// text returns the shortest decimal that reads back as the same value at the
+// value's precision. Like encoding/json does for a float64, it uses an exponent
+// only for very large or small values, such as "1e+100" but "0.000001".
+func (f BigFloat) text() string {
+	s := f.BigFloat.Text('e', -1)
+	exp, err := strconv.Atoi(s[strings.LastIndexByte(s, 'e')+1:])
+	if err == nil && exp >= -6 && exp < 21 {
+		return f.BigFloat.Text('f', -1)
+	}
+	return s
+}
+
+// MarshalJSON implements json.Marshaler.
+// Valid values are encoded as a bare number to avoid losing precision. An
+// infinite value cannot be represented in JSON and returns an error.
+func (f BigFloat) MarshalJSON() ([]byte, error) {
+	if !f.Valid || f.BigFloat == nil {
+		return NullBytes, nil
+	}
+	if f.BigFloat.IsInf() {
+		return nil, fmt.Errorf("json: unsupported value: %s", f.text())
+	}
+	return []byte(f.text()), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null BigFloat as chosen by opts.
+func (f BigFloat) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(f)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this BigFloat is null.
+func (f BigFloat) MarshalText() ([]byte, error) {
+	if !f.Valid || f.BigFloat == nil {
+		return []byte{}, nil
+	}
+	return []byte(f.text()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this BigFloat is null.
+func (f BigFloat) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, f, f.Valid && f.BigFloat != nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (f *BigFloat) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, f)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this BigFloat is null.
+func (f BigFloat) MarshalYAML() (interface{}, error) {
+	if !f.Valid || f.BigFloat == nil {
+		return nil, nil
+	}
+	return f.text(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (f *BigFloat) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return f.UnmarshalText(nil)
+	}
+	return f.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this BigFloat is null.
+func (f BigFloat) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !f.Valid || f.BigFloat == nil {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(f.text())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (f *BigFloat) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return f.UnmarshalText(nil)
+	}
+	return f.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null BigFloat is encoded as a single byte. The encoding keeps the
+// precision and rounding mode of the value.
+func (f BigFloat) MarshalBinary() ([]byte, error) {
+	if !f.Valid || f.BigFloat == nil {
+		return []byte{encodedNull}, nil
+	}
+	data, err := f.BigFloat.GobEncode()
+	if err != nil {
+		return nil, err
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, data), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *BigFloat) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "BigFloat")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		f.BigFloat = nil
+		f.Valid = false
+		return nil
+	}
+	enc, err := decodeLengthPrefixed(value, "BigFloat")
+	if err != nil {
+		return err
+	}
+	x := new(big.Float)
+	if err := x.GobDecode(enc); err != nil {
+		return err
+	}
+	f.BigFloat = x
+	f.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (f BigFloat) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (f *BigFloat) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// SetValid changes this BigFloat's value and also sets it to be non-null.
+func (f *BigFloat) SetValid(n *big.Float) {
+	f.BigFloat = n
+	f.Valid = true
+}
+
+// SetNull sets this BigFloat to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (f *BigFloat) SetNull() {
+	f.BigFloat = nil
+	f.Valid = false
+}
+
+// Ptr returns this BigFloat's value, or a nil pointer if this BigFloat is null.
+func (f BigFloat) Ptr() *big.Float {
+	if !f.Valid {
+		return nil
+	}
+	return f.BigFloat
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (f BigFloat) ValueOrZero() *big.Float {
+	if !f.Valid {
+		return nil
+	}
+	return f.BigFloat
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (f BigFloat) ValueOr(def *big.Float) *big.Float {
+	if !f.Valid || f.BigFloat == nil {
+		return def
+	}
+	return f.BigFloat
+}
+
+// Or returns this BigFloat if it is valid, otherwise other.
+func (f BigFloat) Or(other BigFloat) BigFloat {
+	if !f.Valid {
+		return other
+	}
+	return f
+}
+
+// MustValue returns the inner value, and panics if this BigFloat is null.
+func (f BigFloat) MustValue() *big.Float {
+	if !f.Valid || f.BigFloat == nil {
+		panic("null.BigFloat: MustValue called on invalid value")
+	}
+	return f.BigFloat
+}
+
+// IsZero returns true if this BigFloat is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (f BigFloat) IsZero() bool {
+	return !f.Valid || f.BigFloat == nil
+}
+
+// IsNull returns true if this BigFloat is null, the same as !Valid.
+func (f BigFloat) IsNull() bool {
+	return !f.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this BigFloat, or nil if it is null, for
+// a *BigFloat field tagged omitempty that should leave nulls out.
+func (f BigFloat) OmitEmpty() *BigFloat {
+	if !f.Valid {
+		return nil
+	}
+	return &f
+}
+
+// Equal returns true if both BigFloats are null or both hold the same value,
+// as compared by big.Float.Cmp, whatever their precisions.
+func (f BigFloat) Equal(other BigFloat) bool {
+	if f.IsZero() || other.IsZero() {
+		return f.IsZero() == other.IsZero()
+	}
+	return f.BigFloat.Cmp(other.BigFloat) == 0
+}
+
+// String implements fmt.Stringer.
+// It returns the value in base 10, or NullDisplay if this BigFloat is null.
+func (f BigFloat) String() string {
+	if !f.Valid || f.BigFloat == nil {
+		return NullDisplay
+	}
+	return f.text()
+}
+
+// Scan implements the Scanner interface.
+// It accepts an int64 or a finite float64, or a decimal number as a string,
+// []byte or json.Number, such as a Postgres numeric column.
+func (f *BigFloat) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case int64:
+		f.BigFloat = newBigFloat(f.BigFloat).SetInt64(x)
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			f.BigFloat, err = nil, errors.New("not a finite number")
+			break
+		}
+		f.BigFloat = newBigFloat(f.BigFloat).SetFloat64(x)
+	case string:
+		f.BigFloat, err = parseBigFloat(x, f.BigFloat)
+	case []byte:
+		f.BigFloat, err = parseBigFloat(string(x), f.BigFloat)
+	case json.Number:
+		f.BigFloat, err = parseBigFloat(string(x), f.BigFloat)
+	case nil:
+		f.BigFloat, f.Valid = nil, false
+		return nil
+	default:
+		f.BigFloat = nil
+		err = errUnsupportedScanType
+	}
+	f.Valid = err == nil
+	return scanError("BigFloat", value, nil, err)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the value as a base 10 string, so no precision is lost.
+func (f BigFloat) Value() (driver.Value, error) {
+	if !f.Valid || f.BigFloat == nil {
+		return nil, nil
+	}
+	return f.text(), nil
+}
+
+// ValueOrNil returns nil if this BigFloat is null, otherwise the same value as Value.
+func (f BigFloat) ValueOrNil() interface{} {
+	if !f.Valid || f.BigFloat == nil {
+		return nil
+	}
+	return f.text()
+}
+
+// Randomize for sqlboiler
+func (f *BigFloat) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		f.BigFloat = nil
+		f.Valid = false
+	} else {
+		f.BigFloat = newBigFloat(nil).SetInt64(nextInt())
+		f.Valid = true
+	}
+}
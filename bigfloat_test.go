@@ -0,0 +1,319 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+var (
+	// bigFloatString has more significant digits than a float64 can hold.
+	bigFloatString     = "1234567890.12345678901234567890123456789"
+	bigFloatJSON       = []byte(bigFloatString)
+	bigFloatStringJSON = []byte(`"` + bigFloatString + `"`)
+	bigFloatValue, _   = new(big.Float).SetPrec(BigFloatPrec).SetString(bigFloatString)
+)
+
+func TestBigFloatFrom(t *testing.T) {
+	f := BigFloatFrom(bigFloatValue)
+	assertBigFloat(t, f, "BigFloatFrom()")
+
+	zero := BigFloatFrom(new(big.Float))
+	if !zero.Valid {
+		t.Error("BigFloatFrom(0)", "is invalid, but should be valid")
+	}
+
+	null := BigFloatFrom(nil)
+	assertNullBigFloat(t, null, "BigFloatFrom(nil)")
+}
+
+func TestUnmarshalBigFloat(t *testing.T) {
+	var f BigFloat
+	err := json.Unmarshal(bigFloatJSON, &f)
+	maybePanic(err)
+	assertBigFloat(t, f, "big float json")
+	if f.BigFloat.Prec() != BigFloatPrec {
+		t.Errorf("bad default precision: %d", f.BigFloat.Prec())
+	}
+
+	var sf BigFloat
+	err = json.Unmarshal(bigFloatStringJSON, &sf)
+	maybePanic(err)
+	assertBigFloat(t, sf, "big float string json")
+
+	var exp BigFloat
+	err = json.Unmarshal([]byte(`-1.5e300`), &exp)
+	maybePanic(err)
+	if want, _ := new(big.Float).SetPrec(BigFloatPrec).SetString("-1.5e300"); exp.BigFloat.Cmp(want) != 0 {
+		t.Errorf("bad exponent big float: %v", exp)
+	}
+
+	var null BigFloat
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBigFloat(t, null, "null json")
+
+	var blank BigFloat
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullBigFloat(t, blank, "blank json string")
+
+	for _, in := range []string{`"Inf"`, `"0x1p-2"`, `"1_000"`, `".5"`, `true`, `"test"`, `[1]`} {
+		var bad BigFloat
+		err = json.Unmarshal([]byte(in), &bad)
+		if err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+		assertNullBigFloat(t, bad, "bad json "+in)
+	}
+}
+
+func TestBigFloatPrecision(t *testing.T) {
+	// an existing value's precision and mode are kept, and it is not modified
+	orig := new(big.Float).SetPrec(24).SetMode(big.ToZero)
+	f := BigFloatFrom(orig)
+	err := json.Unmarshal([]byte(`0.1`), &f)
+	maybePanic(err)
+	if f.BigFloat == orig || orig.Sign() != 0 {
+		t.Error("UnmarshalJSON should replace, not modify, the *big.Float")
+	}
+	if f.BigFloat.Prec() != 24 || f.BigFloat.Mode() != big.ToZero {
+		t.Errorf("bad precision or mode: %d %v", f.BigFloat.Prec(), f.BigFloat.Mode())
+	}
+
+	err = f.Scan("0.2")
+	maybePanic(err)
+	if f.BigFloat.Prec() != 24 {
+		t.Errorf("Scan should keep the precision, got %d", f.BigFloat.Prec())
+	}
+
+	// the binary encoding keeps the precision too
+	data, err := f.MarshalBinary()
+	maybePanic(err)
+	var out BigFloat
+	err = out.UnmarshalBinary(data)
+	maybePanic(err)
+	if !out.Equal(f) || out.BigFloat.Prec() != 24 || out.BigFloat.Mode() != big.ToZero {
+		t.Errorf("bad binary round trip: %v prec %d", out, out.BigFloat.Prec())
+	}
+}
+
+func TestBigFloatExponentLimit(t *testing.T) {
+	for _, s := range []string{"1e1000", "-9.9e1000", "1e-1000", "0.01e-998", "1000e997", "0e99999", "0.000"} {
+		var f BigFloat
+		if err := f.UnmarshalText([]byte(s)); err != nil || !f.Valid {
+			t.Errorf("%s: should be within the limit: %v", s, err)
+		}
+	}
+
+	huge := "0." + strings.Repeat("0", 1000) + "1"
+	for _, s := range []string{"1e1001", "1e-1001", "10e1000", "0.01e-1000", "1e10000000", "1e99999999999", huge} {
+		f := BigFloatFrom(big.NewFloat(1))
+		err := f.UnmarshalText([]byte(s))
+		if err == nil || !strings.Contains(err.Error(), "limited to ±1000 by BigExponentLimit") {
+			t.Errorf("%.20s: expected an exponent limit error, got %v", s, err)
+		}
+		assertNullBigFloat(t, f, s)
+
+		if err := json.Unmarshal([]byte(s), &f); err == nil {
+			t.Errorf("%.20s: expected an exponent limit error from UnmarshalJSON", s)
+		}
+		if err := f.Scan(s); err == nil {
+			t.Errorf("%.20s: expected an exponent limit error from Scan", s)
+		}
+	}
+
+	defer func(limit int) { BigExponentLimit = limit }(BigExponentLimit)
+	BigExponentLimit = 2000
+	var f BigFloat
+	maybePanic(f.UnmarshalText([]byte("1e2000")))
+}
+
+func TestTextUnmarshalBigFloat(t *testing.T) {
+	var f BigFloat
+	err := f.UnmarshalText([]byte(bigFloatString))
+	maybePanic(err)
+	assertBigFloat(t, f, "UnmarshalText() big float")
+
+	var blank BigFloat
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBigFloat(t, blank, "UnmarshalText() empty big float")
+}
+
+func TestMarshalBigFloat(t *testing.T) {
+	f := BigFloatFrom(bigFloatValue)
+	data, err := json.Marshal(f)
+	maybePanic(err)
+	assertJSONEquals(t, data, bigFloatString, "non-empty json marshal")
+
+	for in, want := range map[string]string{"0.1": "0.1", "1e20": "100000000000000000000", "1e21": "1e+21", "0.000001": "0.000001", "-1.5e-7": "-1.5e-07", "0": "0"} {
+		data, err = json.Marshal(MustBigFloat(in))
+		maybePanic(err)
+		assertJSONEquals(t, data, want, "json marshal "+in)
+	}
+
+	// invalid values should be encoded as null
+	null := NewBigFloat(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	unset := NewBigFloat(nil, true)
+	data, err = json.Marshal(unset)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "nil big float json marshal")
+
+	if _, err := json.Marshal(BigFloatFrom(new(big.Float).SetInf(false))); err == nil {
+		t.Error("expected error for an infinite value")
+	}
+}
+
+func TestMarshalBigFloatText(t *testing.T) {
+	f := BigFloatFrom(bigFloatValue)
+	data, err := f.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, bigFloatString, "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewBigFloat(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestBigFloatRoundTrip(t *testing.T) {
+	for _, in := range []BigFloat{BigFloatFrom(bigFloatValue), MustBigFloat("-0.001"), MustBigFloat("1e-300"), NewBigFloat(nil, false)} {
+		data, err := json.Marshal(in)
+		maybePanic(err)
+		var fromJSON BigFloat
+		maybePanic(json.Unmarshal(data, &fromJSON))
+
+		v, err := in.Value()
+		maybePanic(err)
+		var scanned BigFloat
+		maybePanic(scanned.Scan(v))
+
+		data, err = in.MarshalBinary()
+		maybePanic(err)
+		var fromBinary BigFloat
+		maybePanic(fromBinary.UnmarshalBinary(data))
+
+		for name, out := range map[string]BigFloat{"json": fromJSON, "Value": scanned, "binary": fromBinary} {
+			if !out.Equal(in) {
+				t.Errorf("bad %s round trip: %v ≠ %v", name, out, in)
+			}
+		}
+	}
+}
+
+func TestBigFloatSetValid(t *testing.T) {
+	change := NewBigFloat(nil, false)
+	assertNullBigFloat(t, change, "SetValid()")
+	change.SetValid(bigFloatValue)
+	assertBigFloat(t, change, "SetValid()")
+}
+
+func TestBigFloatSetNull(t *testing.T) {
+	change := BigFloatFrom(bigFloatValue)
+	change.SetNull()
+	assertNullBigFloat(t, change, "SetNull()")
+	if change.BigFloat != nil {
+		t.Errorf("SetNull() should zero the value, got %v", change.BigFloat)
+	}
+}
+
+func TestBigFloatEqual(t *testing.T) {
+	if !NewBigFloat(nil, false).Equal(NewBigFloat(bigFloatValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if BigFloatFrom(bigFloatValue).Equal(NewBigFloat(nil, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	if !BigFloatFrom(big.NewFloat(1.5)).Equal(BigFloatFrom(new(big.Float).SetPrec(500).SetFloat64(1.5))) {
+		t.Error("Equal() should be true for equal values of different precisions")
+	}
+	if BigFloatFrom(bigFloatValue).Equal(BigFloatFrom(big.NewFloat(1))) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestBigFloatScanValue(t *testing.T) {
+	var f BigFloat
+	err := f.Scan([]byte(bigFloatString))
+	maybePanic(err)
+	assertBigFloat(t, f, "scanned []byte")
+	if v, err := f.Value(); v != bigFloatString || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var i BigFloat
+	err = i.Scan(int64(-42))
+	maybePanic(err)
+	if v, err := i.Value(); v != "-42" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var fl BigFloat
+	err = fl.Scan(0.5)
+	maybePanic(err)
+	if v, err := fl.Value(); v != "0.5" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null BigFloat
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBigFloat(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	for _, in := range []interface{}{"abc", "NaN", math.Inf(1), math.NaN(), true} {
+		wrong := BigFloatFrom(bigFloatValue)
+		if err := wrong.Scan(in); err == nil {
+			t.Errorf("%v: expected error", in)
+		}
+		assertNullBigFloat(t, wrong, fmt.Sprintf("scanned %v", in))
+	}
+}
+
+func TestBigFloatString(t *testing.T) {
+	v := BigFloatFrom(bigFloatValue)
+	if s := v.String(); s != bigFloatString {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewBigFloat(nil, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestBigFloatValueOrNil(t *testing.T) {
+	assertValueOrNil(t, BigFloatFrom(bigFloatValue), "valid")
+	assertValueOrNil(t, NewBigFloat(nil, false), "null")
+}
+
+func TestBigFloatMustValue(t *testing.T) {
+	v := BigFloatFrom(bigFloatValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewBigFloat(nil, false).MustValue() }, "BigFloat")
+}
+
+func assertBigFloat(t *testing.T, f BigFloat, from string) {
+	if f.BigFloat == nil || f.BigFloat.Cmp(bigFloatValue) != 0 {
+		t.Errorf("bad %s big float: %v ≠ %v\n", from, f.BigFloat, bigFloatValue)
+	}
+	if !f.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBigFloat(t *testing.T, f BigFloat, from string) {
+	if f.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,368 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math/big"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// BigInt is a nullable *big.Int, for arbitrary-precision integers such as
+// Postgres numeric columns without a scale. A nil BigInt.BigInt is treated
+// as null. The *big.Int is shared, not copied, by the constructors and SetValid.
+type BigInt struct {
+	BigInt *big.Int
+	Valid  bool
+}
+
+// NewBigInt creates a new BigInt
+func NewBigInt(i *big.Int, valid bool) BigInt {
+	return BigInt{
+		BigInt: i,
+		Valid:  valid,
+	}
+}
+
+// BigIntFrom creates a new BigInt that will be null if i is nil. There is
+// no BigIntFromPtr, since a *big.Int is already a pointer.
+func BigIntFrom(i *big.Int) BigInt {
+	return NewBigInt(i, i != nil)
+}
+
+// ParseBigInt parses a base 10 integer such as "12345678901234567890" into a valid BigInt, as UnmarshalText
+// does, but returns an error rather than a null BigInt for empty text.
+func ParseBigInt(s string) (BigInt, error) {
+	return parseText[BigInt]("BigInt", s)
+}
+
+// MustBigInt is like ParseBigInt, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustBigInt(s string) BigInt {
+	v, err := ParseBigInt(s)
+	return mustParse("MustBigInt", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a bare integer of any size, or a quoted string holding one, and
+// an empty string will be null. Like the other numeric types, a quoted
+// integer must be written as a JSON number would be, so "+5" and "05" are an
+// error, and so are numbers with a fraction or exponent, such as 1.5 or 1e3.
+func (b *BigInt) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) || bytes.Equal(data, []byte(`""`)) {
+		b.BigInt = nil
+		b.Valid = false
+		return nil
+	}
+
+	s, err := jsonNumber(data, "BigInt")
+	if err == nil && !isJSONInteger([]byte(s)) {
+		err = fmt.Errorf("json: cannot unmarshal %s into Go value of type null.BigInt", data)
+	}
+	if err != nil {
+		b.BigInt = nil
+		b.Valid = false
+		return err
+	}
+
+	i, err := parseBigInt(s)
+	b.BigInt = i
+	b.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null BigInt if the input is blank.
+func (b *BigInt) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.BigInt = nil
+		b.Valid = false
+		return nil
+	}
+
+	i, err := parseBigInt(string(text))
+	b.BigInt = i
+	b.Valid = err == nil
+	return err
+}
+
+// parseBigInt parses a base 10 integer, returning nil on error.
+func parseBigInt(s string) (*big.Int, error) {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("null: invalid integer %q for null.BigInt", s)
+	}
+	return i, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// Valid values are encoded as a bare number to avoid losing precision.
+func (b BigInt) MarshalJSON() ([]byte, error) {
+	if !b.Valid || b.BigInt == nil {
+		return NullBytes, nil
+	}
+	return []byte(b.BigInt.String()), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null BigInt as chosen by opts.
+func (b BigInt) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this BigInt is null.
+func (b BigInt) MarshalText() ([]byte, error) {
+	if !b.Valid || b.BigInt == nil {
+		return []byte{}, nil
+	}
+	return []byte(b.BigInt.String()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this BigInt is null.
+func (b BigInt) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, b, b.Valid && b.BigInt != nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (b *BigInt) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this BigInt is null.
+func (b BigInt) MarshalYAML() (interface{}, error) {
+	if !b.Valid || b.BigInt == nil {
+		return nil, nil
+	}
+	return b.BigInt.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (b *BigInt) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return b.UnmarshalText(nil)
+	}
+	return b.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this BigInt is null.
+func (b BigInt) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !b.Valid || b.BigInt == nil {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(b.BigInt.String())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (b *BigInt) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return b.UnmarshalText(nil)
+	}
+	return b.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null BigInt is encoded as a single byte.
+func (b BigInt) MarshalBinary() ([]byte, error) {
+	if !b.Valid || b.BigInt == nil {
+		return []byte{encodedNull}, nil
+	}
+	data, err := b.BigInt.GobEncode()
+	if err != nil {
+		return nil, err
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, data), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *BigInt) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "BigInt")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		b.BigInt = nil
+		b.Valid = false
+		return nil
+	}
+	enc, err := decodeLengthPrefixed(value, "BigInt")
+	if err != nil {
+		return err
+	}
+	i := new(big.Int)
+	if err := i.GobDecode(enc); err != nil {
+		return err
+	}
+	b.BigInt = i
+	b.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (b BigInt) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (b *BigInt) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// SetValid changes this BigInt's value and also sets it to be non-null.
+func (b *BigInt) SetValid(n *big.Int) {
+	b.BigInt = n
+	b.Valid = true
+}
+
+// SetNull sets this BigInt to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (b *BigInt) SetNull() {
+	b.BigInt = nil
+	b.Valid = false
+}
+
+// Ptr returns this BigInt's value, or a nil pointer if this BigInt is null.
+func (b BigInt) Ptr() *big.Int {
+	if !b.Valid {
+		return nil
+	}
+	return b.BigInt
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (b BigInt) ValueOrZero() *big.Int {
+	if !b.Valid {
+		return nil
+	}
+	return b.BigInt
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (b BigInt) ValueOr(def *big.Int) *big.Int {
+	if !b.Valid || b.BigInt == nil {
+		return def
+	}
+	return b.BigInt
+}
+
+// Or returns this BigInt if it is valid, otherwise other.
+func (b BigInt) Or(other BigInt) BigInt {
+	if !b.Valid {
+		return other
+	}
+	return b
+}
+
+// MustValue returns the inner value, and panics if this BigInt is null.
+func (b BigInt) MustValue() *big.Int {
+	if !b.Valid || b.BigInt == nil {
+		panic("null.BigInt: MustValue called on invalid value")
+	}
+	return b.BigInt
+}
+
+// IsZero returns true if this BigInt is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (b BigInt) IsZero() bool {
+	return !b.Valid || b.BigInt == nil
+}
+
+// IsNull returns true if this BigInt is null, the same as !Valid.
+func (b BigInt) IsNull() bool {
+	return !b.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this BigInt, or nil if it is null, for
+// a *BigInt field tagged omitempty that should leave nulls out.
+func (b BigInt) OmitEmpty() *BigInt {
+	if !b.Valid {
+		return nil
+	}
+	return &b
+}
+
+// Equal returns true if both BigInts are null or both hold the same value.
+func (b BigInt) Equal(other BigInt) bool {
+	if b.IsZero() || other.IsZero() {
+		return b.IsZero() == other.IsZero()
+	}
+	return b.BigInt.Cmp(other.BigInt) == 0
+}
+
+// String implements fmt.Stringer.
+// It returns the value in base 10, or NullDisplay if this BigInt is null.
+func (b BigInt) String() string {
+	if !b.Valid || b.BigInt == nil {
+		return NullDisplay
+	}
+	return b.BigInt.String()
+}
+
+// Scan implements the Scanner interface.
+// It accepts an int64, or a base 10 integer as a string, []byte or json.Number.
+func (b *BigInt) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case int64:
+		b.BigInt = big.NewInt(x)
+	case string:
+		b.BigInt, err = parseBigInt(x)
+	case []byte:
+		b.BigInt, err = parseBigInt(string(x))
+	case json.Number:
+		b.BigInt, err = parseBigInt(string(x))
+	case nil:
+		b.BigInt, b.Valid = nil, false
+		return nil
+	default:
+		b.BigInt = nil
+		err = errUnsupportedScanType
+	}
+	b.Valid = err == nil
+	return scanError("BigInt", value, nil, err)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the value as a base 10 string, so no precision is lost.
+func (b BigInt) Value() (driver.Value, error) {
+	if !b.Valid || b.BigInt == nil {
+		return nil, nil
+	}
+	return b.BigInt.String(), nil
+}
+
+// ValueOrNil returns nil if this BigInt is null, otherwise the same value as Value.
+func (b BigInt) ValueOrNil() interface{} {
+	if !b.Valid || b.BigInt == nil {
+		return nil
+	}
+	return b.BigInt.String()
+}
+
+// Randomize for sqlboiler
+func (b *BigInt) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		b.BigInt = nil
+		b.Valid = false
+	} else {
+		b.BigInt = big.NewInt(nextInt())
+		b.Valid = true
+	}
+}
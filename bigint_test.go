@@ -0,0 +1,229 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+var (
+	// bigIntString is well beyond the range of an int64 or a float64 mantissa.
+	bigIntString     = "123456789012345678901234567890123456789"
+	bigIntJSON       = []byte(bigIntString)
+	bigIntStringJSON = []byte(`"` + bigIntString + `"`)
+	bigIntValue, _   = new(big.Int).SetString(bigIntString, 10)
+)
+
+func TestBigIntFrom(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	assertBigInt(t, b, "BigIntFrom()")
+
+	zero := BigIntFrom(new(big.Int))
+	if !zero.Valid {
+		t.Error("BigIntFrom(0)", "is invalid, but should be valid")
+	}
+
+	null := BigIntFrom(nil)
+	assertNullBigInt(t, null, "BigIntFrom(nil)")
+}
+
+func TestUnmarshalBigInt(t *testing.T) {
+	var b BigInt
+	err := json.Unmarshal(bigIntJSON, &b)
+	maybePanic(err)
+	assertBigInt(t, b, "big int json")
+
+	var sb BigInt
+	err = json.Unmarshal(bigIntStringJSON, &sb)
+	maybePanic(err)
+	assertBigInt(t, sb, "big int string json")
+
+	var negative BigInt
+	err = json.Unmarshal([]byte("-"+bigIntString), &negative)
+	maybePanic(err)
+	if !negative.Valid || negative.BigInt.Cmp(new(big.Int).Neg(bigIntValue)) != 0 {
+		t.Errorf("bad negative big int: %v", negative)
+	}
+
+	var null BigInt
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBigInt(t, null, "null json")
+
+	var blank BigInt
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullBigInt(t, blank, "blank json string")
+
+	for _, in := range []string{`1.5`, `1e3`, `"1.5"`, `true`, `"test"`, `[1]`,
+		`"+5"`, `"05"`, `" 5"`, `"1_000"`, `"0x10"`, `"-"`} {
+		var bad BigInt
+		err = json.Unmarshal([]byte(in), &bad)
+		if err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+		assertNullBigInt(t, bad, "bad json "+in)
+	}
+}
+
+func TestTextUnmarshalBigInt(t *testing.T) {
+	var b BigInt
+	err := b.UnmarshalText([]byte(bigIntString))
+	maybePanic(err)
+	assertBigInt(t, b, "UnmarshalText() big int")
+
+	var blank BigInt
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBigInt(t, blank, "UnmarshalText() empty big int")
+}
+
+func TestMarshalBigInt(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, bigIntString, "non-empty json marshal")
+
+	// invalid values should be encoded as null
+	null := NewBigInt(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	unset := NewBigInt(nil, true)
+	data, err = json.Marshal(unset)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "nil big int json marshal")
+}
+
+func TestMarshalBigIntText(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	data, err := b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, bigIntString, "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewBigInt(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestBigIntBinary(t *testing.T) {
+	for _, in := range []BigInt{BigIntFrom(bigIntValue), BigIntFrom(new(big.Int).Neg(bigIntValue)), NewBigInt(nil, false)} {
+		data, err := in.MarshalBinary()
+		maybePanic(err)
+		var out BigInt
+		err = out.UnmarshalBinary(data)
+		maybePanic(err)
+		if !out.Equal(in) {
+			t.Errorf("bad binary round trip: %v ≠ %v", out, in)
+		}
+	}
+}
+
+func TestBigIntSetValid(t *testing.T) {
+	change := NewBigInt(nil, false)
+	assertNullBigInt(t, change, "SetValid()")
+	change.SetValid(bigIntValue)
+	assertBigInt(t, change, "SetValid()")
+}
+
+func TestBigIntSetNull(t *testing.T) {
+	change := BigIntFrom(bigIntValue)
+	change.SetNull()
+	assertNullBigInt(t, change, "SetNull()")
+	if change.BigInt != nil {
+		t.Errorf("SetNull() should zero the value, got %v", change.BigInt)
+	}
+}
+
+func TestBigIntEqual(t *testing.T) {
+	if !NewBigInt(nil, false).Equal(NewBigInt(bigIntValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if BigIntFrom(bigIntValue).Equal(NewBigInt(nil, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	if !BigIntFrom(bigIntValue).Equal(BigIntFrom(new(big.Int).Set(bigIntValue))) {
+		t.Error("Equal() should be true for equal values")
+	}
+	if BigIntFrom(bigIntValue).Equal(BigIntFrom(big.NewInt(1))) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestBigIntScanValue(t *testing.T) {
+	var b BigInt
+	err := b.Scan([]byte(bigIntString))
+	maybePanic(err)
+	assertBigInt(t, b, "scanned []byte")
+	if v, err := b.Value(); v != bigIntString || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var s BigInt
+	err = s.Scan(bigIntString)
+	maybePanic(err)
+	assertBigInt(t, s, "scanned string")
+
+	var i BigInt
+	err = i.Scan(int64(-42))
+	maybePanic(err)
+	if v, err := i.Value(); v != "-42" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null BigInt
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBigInt(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong BigInt
+	err = wrong.Scan("1.5")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBigInt(t, wrong, "scanned wrong")
+}
+
+func TestBigIntString(t *testing.T) {
+	v := BigIntFrom(bigIntValue)
+	if s := v.String(); s != bigIntString {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewBigInt(nil, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestBigIntValueOrNil(t *testing.T) {
+	assertValueOrNil(t, BigIntFrom(bigIntValue), "valid")
+	assertValueOrNil(t, NewBigInt(nil, false), "null")
+}
+
+func TestBigIntMustValue(t *testing.T) {
+	v := BigIntFrom(bigIntValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewBigInt(nil, false).MustValue() }, "BigInt")
+}
+
+func assertBigInt(t *testing.T, b BigInt, from string) {
+	if b.BigInt == nil || b.BigInt.Cmp(bigIntValue) != 0 {
+		t.Errorf("bad %s big int: %v ≠ %v\n", from, b.BigInt, bigIntValue)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBigInt(t *testing.T, b BigInt, from string) {
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
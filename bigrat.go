@@ -0,0 +1,407 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// BigRat is a nullable *big.Rat, for exact rational numbers such as tax rates
+// and currency conversions. A nil BigRat.BigRat is treated as null. The
+// *big.Rat is shared, not copied, by the constructors and SetValid.
+//
+// A BigRat is written as text, as a JSON string and by Value as an exact
+// decimal when it has one, such as "0.25" or "-3", and otherwise as a fraction
+// "num/den" in lowest terms, such as "1/3". Either form is accepted when
+// decoding, as is a bare JSON number.
+type BigRat struct {
+	BigRat *big.Rat
+	Valid  bool
+}
+
+// NewBigRat creates a new BigRat
+func NewBigRat(r *big.Rat, valid bool) BigRat {
+	return BigRat{
+		BigRat: r,
+		Valid:  valid,
+	}
+}
+
+// BigRatFrom creates a new BigRat that will be null if r is nil. There is
+// no BigRatFromPtr, since a *big.Rat is already a pointer.
+func BigRatFrom(r *big.Rat) BigRat {
+	return NewBigRat(r, r != nil)
+}
+
+// ParseBigRat parses a fraction such as "1/3" or a decimal such as "0.25" into
+// a valid BigRat, as UnmarshalText does, but returns an error rather than a
+// null BigRat for empty text.
+func ParseBigRat(s string) (BigRat, error) {
+	return parseText[BigRat]("BigRat", s)
+}
+
+// MustBigRat is like ParseBigRat, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustBigRat(s string) BigRat {
+	v, err := ParseBigRat(s)
+	return mustParse("MustBigRat", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a bare number, or a string holding a fraction or a decimal, and
+// an empty string will be null.
+func (r *BigRat) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) || bytes.Equal(data, []byte(`""`)) {
+		r.BigRat = nil
+		r.Valid = false
+		return nil
+	}
+
+	s := string(data)
+	if !isJSONNumber(s) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			r.BigRat = nil
+			r.Valid = false
+			return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.BigRat", data)
+		}
+	}
+
+	x, err := parseBigRat(s)
+	r.BigRat = x
+	r.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null BigRat if the input is blank.
+func (r *BigRat) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		r.BigRat = nil
+		r.Valid = false
+		return nil
+	}
+
+	x, err := parseBigRat(string(text))
+	r.BigRat = x
+	r.Valid = err == nil
+	return err
+}
+
+// parseBigRat parses a fraction of two base 10 integers, such as "-1/3", or a
+// number in JSON syntax, such as "0.25" or "1e-3", returning nil on error. The
+// octal and hex prefixes big.Rat.SetString also accepts are rejected.
+func parseBigRat(s string) (*big.Rat, error) {
+	num, den, fraction := strings.Cut(s, "/")
+	valid := isJSONNumber(s)
+	if fraction {
+		valid = isJSONInteger([]byte(num)) && isJSONInteger([]byte(den)) && den[0] != '-'
+	} else if valid {
+		if err := checkBigExponent(s, "BigRat"); err != nil {
+			return nil, err
+		}
+	}
+	if valid {
+		if x, ok := new(big.Rat).SetString(s); ok {
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("null: invalid rational number %q for null.BigRat", s)
+}
+
+// text returns the value as an exact decimal if it has one, and otherwise as
+// a fraction in lowest terms.
+func (r BigRat) text() string {
+	if places, exact := r.BigRat.FloatPrec(); exact {
+		return r.BigRat.FloatString(places)
+	}
+	return r.BigRat.String()
+}
+
+// MarshalJSON implements json.Marshaler.
+// Valid values are encoded as a JSON string, since a fraction is not a JSON
+// number.
+func (r BigRat) MarshalJSON() ([]byte, error) {
+	if !r.Valid || r.BigRat == nil {
+		return NullBytes, nil
+	}
+	return []byte(`"` + r.text() + `"`), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null BigRat as chosen by opts.
+func (r BigRat) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(r)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this BigRat is null.
+func (r BigRat) MarshalText() ([]byte, error) {
+	if !r.Valid || r.BigRat == nil {
+		return []byte{}, nil
+	}
+	return []byte(r.text()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this BigRat is null.
+func (r BigRat) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, r, r.Valid && r.BigRat != nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (r *BigRat) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, r)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this BigRat is null.
+func (r BigRat) MarshalYAML() (interface{}, error) {
+	if !r.Valid || r.BigRat == nil {
+		return nil, nil
+	}
+	return r.text(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (r *BigRat) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return r.UnmarshalText(nil)
+	}
+	return r.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this BigRat is null.
+func (r BigRat) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !r.Valid || r.BigRat == nil {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(r.text())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (r *BigRat) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return r.UnmarshalText(nil)
+	}
+	return r.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null BigRat is encoded as a single byte.
+func (r BigRat) MarshalBinary() ([]byte, error) {
+	if !r.Valid || r.BigRat == nil {
+		return []byte{encodedNull}, nil
+	}
+	data, err := r.BigRat.GobEncode()
+	if err != nil {
+		return nil, err
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, data), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *BigRat) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "BigRat")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		r.BigRat = nil
+		r.Valid = false
+		return nil
+	}
+	enc, err := decodeLengthPrefixed(value, "BigRat")
+	if err != nil {
+		return err
+	}
+	x := new(big.Rat)
+	if err := x.GobDecode(enc); err != nil {
+		return err
+	}
+	r.BigRat = x
+	r.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (r BigRat) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (r *BigRat) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
+
+// SetValid changes this BigRat's value and also sets it to be non-null.
+func (r *BigRat) SetValid(n *big.Rat) {
+	r.BigRat = n
+	r.Valid = true
+}
+
+// SetNull sets this BigRat to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (r *BigRat) SetNull() {
+	r.BigRat = nil
+	r.Valid = false
+}
+
+// Ptr returns this BigRat's value, or a nil pointer if this BigRat is null.
+func (r BigRat) Ptr() *big.Rat {
+	if !r.Valid {
+		return nil
+	}
+	return r.BigRat
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (r BigRat) ValueOrZero() *big.Rat {
+	if !r.Valid {
+		return nil
+	}
+	return r.BigRat
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (r BigRat) ValueOr(def *big.Rat) *big.Rat {
+	if !r.Valid || r.BigRat == nil {
+		return def
+	}
+	return r.BigRat
+}
+
+// Or returns this BigRat if it is valid, otherwise other.
+func (r BigRat) Or(other BigRat) BigRat {
+	if !r.Valid {
+		return other
+	}
+	return r
+}
+
+// MustValue returns the inner value, and panics if this BigRat is null.
+func (r BigRat) MustValue() *big.Rat {
+	if !r.Valid || r.BigRat == nil {
+		panic("null.BigRat: MustValue called on invalid value")
+	}
+	return r.BigRat
+}
+
+// IsZero returns true if this BigRat is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (r BigRat) IsZero() bool {
+	return !r.Valid || r.BigRat == nil
+}
+
+// IsNull returns true if this BigRat is null, the same as !Valid.
+func (r BigRat) IsNull() bool {
+	return !r.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this BigRat, or nil if it is null, for
+// a *BigRat field tagged omitempty that should leave nulls out.
+func (r BigRat) OmitEmpty() *BigRat {
+	if !r.Valid {
+		return nil
+	}
+	return &r
+}
+
+// Equal returns true if both BigRats are null or both hold the same value, as
+// compared by big.Rat.Cmp.
+func (r BigRat) Equal(other BigRat) bool {
+	if r.IsZero() || other.IsZero() {
+		return r.IsZero() == other.IsZero()
+	}
+	return r.BigRat.Cmp(other.BigRat) == 0
+}
+
+// String implements fmt.Stringer.
+// It returns the value as an exact decimal or a fraction, or NullDisplay if
+// this BigRat is null.
+func (r BigRat) String() string {
+	if !r.Valid || r.BigRat == nil {
+		return NullDisplay
+	}
+	return r.text()
+}
+
+// Scan implements the Scanner interface.
+// It accepts an int64 or a finite float64, which is converted exactly, or a
+// fraction or decimal as a string, []byte or json.Number, such as a Postgres
+// numeric column.
+func (r *BigRat) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case int64:
+		r.BigRat = new(big.Rat).SetInt64(x)
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			r.BigRat, err = nil, errors.New("not a finite number")
+			break
+		}
+		r.BigRat = new(big.Rat).SetFloat64(x)
+	case string:
+		r.BigRat, err = parseBigRat(x)
+	case []byte:
+		r.BigRat, err = parseBigRat(string(x))
+	case json.Number:
+		r.BigRat, err = parseBigRat(string(x))
+	case nil:
+		r.BigRat, r.Valid = nil, false
+		return nil
+	default:
+		r.BigRat = nil
+		err = errUnsupportedScanType
+	}
+	r.Valid = err == nil
+	return scanError("BigRat", value, nil, err)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the value as an exact decimal string, or as a fraction if it has
+// no exact decimal, which only text columns can store.
+func (r BigRat) Value() (driver.Value, error) {
+	if !r.Valid || r.BigRat == nil {
+		return nil, nil
+	}
+	return r.text(), nil
+}
+
+// ValueOrNil returns nil if this BigRat is null, otherwise the same value as Value.
+func (r BigRat) ValueOrNil() interface{} {
+	if !r.Valid || r.BigRat == nil {
+		return nil
+	}
+	return r.text()
+}
+
+// Randomize for sqlboiler
+func (r *BigRat) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		r.BigRat = nil
+		r.Valid = false
+	} else {
+		r.BigRat = new(big.Rat).SetInt64(nextInt())
+		r.Valid = true
+	}
+}
@@ -0,0 +1,289 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+var (
+	bigRatString     = "1/3"
+	bigRatStringJSON = []byte(`"` + bigRatString + `"`)
+	bigRatValue      = big.NewRat(1, 3)
+)
+
+func TestBigRatFrom(t *testing.T) {
+	r := BigRatFrom(bigRatValue)
+	assertBigRat(t, r, "BigRatFrom()")
+
+	zero := BigRatFrom(new(big.Rat))
+	if !zero.Valid {
+		t.Error("BigRatFrom(0)", "is invalid, but should be valid")
+	}
+
+	null := BigRatFrom(nil)
+	assertNullBigRat(t, null, "BigRatFrom(nil)")
+}
+
+func TestUnmarshalBigRat(t *testing.T) {
+	var r BigRat
+	err := json.Unmarshal(bigRatStringJSON, &r)
+	maybePanic(err)
+	assertBigRat(t, r, "big rat string json")
+
+	tests := []struct {
+		in   string
+		want *big.Rat
+	}{
+		{`0.25`, big.NewRat(1, 4)},
+		{`"0.25"`, big.NewRat(1, 4)},
+		{`-3`, big.NewRat(-3, 1)},
+		{`1e-3`, big.NewRat(1, 1000)},
+		{`"-2/6"`, big.NewRat(-1, 3)},
+		{`"10/1"`, big.NewRat(10, 1)},
+	}
+	for _, test := range tests {
+		var r BigRat
+		err := json.Unmarshal([]byte(test.in), &r)
+		maybePanic(err)
+		if !r.Valid || r.BigRat.Cmp(test.want) != 0 {
+			t.Errorf("%s: bad big rat: %v ≠ %v", test.in, r, test.want)
+		}
+	}
+
+	var null BigRat
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBigRat(t, null, "null json")
+
+	var blank BigRat
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullBigRat(t, blank, "blank json string")
+
+	for _, in := range []string{`"1/0"`, `"010/3"`, `"0x10"`, `"1/-3"`, `"1/3/4"`, `"1.5/2"`, `true`, `"test"`, `[1]`,
+		`"+5"`, `"+1/3"`, `"05"`, `" 5"`, `"1_000"`, `".5"`, `"5."`} {
+		var bad BigRat
+		err = json.Unmarshal([]byte(in), &bad)
+		if err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+		assertNullBigRat(t, bad, "bad json "+in)
+	}
+}
+
+func TestTextUnmarshalBigRat(t *testing.T) {
+	var r BigRat
+	err := r.UnmarshalText([]byte(bigRatString))
+	maybePanic(err)
+	assertBigRat(t, r, "UnmarshalText() big rat")
+
+	var blank BigRat
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBigRat(t, blank, "UnmarshalText() empty big rat")
+}
+
+func TestBigRatExponentLimit(t *testing.T) {
+	for _, s := range []string{"1e1000", "1e-1000", "123.5e998", "1/3"} {
+		var r BigRat
+		if err := r.UnmarshalText([]byte(s)); err != nil || !r.Valid {
+			t.Errorf("%s: should be within the limit: %v", s, err)
+		}
+	}
+
+	for _, s := range []string{"1e1001", "1e-1001", "1e1000000", `"1e1000000"`} {
+		r := BigRatFrom(big.NewRat(1, 2))
+		err := json.Unmarshal([]byte(s), &r)
+		if err == nil || !strings.Contains(err.Error(), "limited to ±1000 by BigExponentLimit") {
+			t.Errorf("%s: expected an exponent limit error, got %v", s, err)
+		}
+		assertNullBigRat(t, r, s)
+	}
+}
+
+func TestMarshalBigRat(t *testing.T) {
+	tests := []struct {
+		in   *big.Rat
+		want string
+	}{
+		{bigRatValue, `"1/3"`},
+		{big.NewRat(1, 4), `"0.25"`},
+		{big.NewRat(-6, 2), `"-3"`},
+		{big.NewRat(7, 40), `"0.175"`},
+		{big.NewRat(2, 12), `"1/6"`},
+		{new(big.Rat), `"0"`},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(BigRatFrom(test.in))
+		maybePanic(err)
+		assertJSONEquals(t, data, test.want, "json marshal "+test.in.String())
+	}
+
+	// invalid values should be encoded as null
+	null := NewBigRat(nil, false)
+	data, err := json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	unset := NewBigRat(nil, true)
+	data, err = json.Marshal(unset)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "nil big rat json marshal")
+}
+
+func TestMarshalBigRatText(t *testing.T) {
+	r := BigRatFrom(bigRatValue)
+	data, err := r.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, bigRatString, "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewBigRat(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestBigRatRoundTrip(t *testing.T) {
+	for _, in := range []BigRat{BigRatFrom(bigRatValue), MustBigRat("-0.001"), MustBigRat("22/7"), MustBigRat("123456789012345678901234567890.5"), NewBigRat(nil, false)} {
+		data, err := json.Marshal(in)
+		maybePanic(err)
+		var fromJSON BigRat
+		maybePanic(json.Unmarshal(data, &fromJSON))
+
+		v, err := in.Value()
+		maybePanic(err)
+		var scanned BigRat
+		maybePanic(scanned.Scan(v))
+
+		data, err = in.MarshalBinary()
+		maybePanic(err)
+		var fromBinary BigRat
+		maybePanic(fromBinary.UnmarshalBinary(data))
+
+		for name, out := range map[string]BigRat{"json": fromJSON, "Value": scanned, "binary": fromBinary} {
+			if !out.Equal(in) {
+				t.Errorf("bad %s round trip: %v ≠ %v", name, out, in)
+			}
+		}
+	}
+}
+
+func TestBigRatSetValid(t *testing.T) {
+	change := NewBigRat(nil, false)
+	assertNullBigRat(t, change, "SetValid()")
+	change.SetValid(bigRatValue)
+	assertBigRat(t, change, "SetValid()")
+}
+
+func TestBigRatSetNull(t *testing.T) {
+	change := BigRatFrom(bigRatValue)
+	change.SetNull()
+	assertNullBigRat(t, change, "SetNull()")
+	if change.BigRat != nil {
+		t.Errorf("SetNull() should zero the value, got %v", change.BigRat)
+	}
+}
+
+func TestBigRatEqual(t *testing.T) {
+	if !NewBigRat(nil, false).Equal(NewBigRat(bigRatValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if BigRatFrom(bigRatValue).Equal(NewBigRat(nil, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	if !BigRatFrom(bigRatValue).Equal(BigRatFrom(big.NewRat(2, 6))) {
+		t.Error("Equal() should be true for equal values")
+	}
+	if BigRatFrom(bigRatValue).Equal(BigRatFrom(big.NewRat(1, 4))) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestBigRatScanValue(t *testing.T) {
+	var r BigRat
+	err := r.Scan([]byte(bigRatString))
+	maybePanic(err)
+	assertBigRat(t, r, "scanned []byte")
+	if v, err := r.Value(); v != bigRatString || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var numeric BigRat
+	err = numeric.Scan("12.50")
+	maybePanic(err)
+	if v, err := numeric.Value(); v != "12.5" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var i BigRat
+	err = i.Scan(int64(-42))
+	maybePanic(err)
+	if v, err := i.Value(); v != "-42" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var fl BigRat
+	err = fl.Scan(0.1)
+	maybePanic(err)
+	if fl.BigRat.Cmp(new(big.Rat).SetFloat64(0.1)) != 0 {
+		t.Errorf("a float64 should be converted exactly: %v", fl)
+	}
+
+	var null BigRat
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBigRat(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	for _, in := range []interface{}{"abc", "1/0", math.Inf(-1), math.NaN(), true} {
+		wrong := BigRatFrom(bigRatValue)
+		if err := wrong.Scan(in); err == nil {
+			t.Errorf("%v: expected error", in)
+		}
+		assertNullBigRat(t, wrong, fmt.Sprintf("scanned %v", in))
+	}
+}
+
+func TestBigRatString(t *testing.T) {
+	v := BigRatFrom(bigRatValue)
+	if s := v.String(); s != bigRatString {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewBigRat(nil, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestBigRatValueOrNil(t *testing.T) {
+	assertValueOrNil(t, BigRatFrom(bigRatValue), "valid")
+	assertValueOrNil(t, NewBigRat(nil, false), "null")
+}
+
+func TestBigRatMustValue(t *testing.T) {
+	v := BigRatFrom(bigRatValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewBigRat(nil, false).MustValue() }, "BigRat")
+}
+
+func assertBigRat(t *testing.T, r BigRat, from string) {
+	if r.BigRat == nil || r.BigRat.Cmp(bigRatValue) != 0 {
+		t.Errorf("bad %s big rat: %v ≠ %v\n", from, r.BigRat, bigRatValue)
+	}
+	if !r.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBigRat(t *testing.T, r BigRat, from string) {
+	if r.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
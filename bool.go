@@ -2,11 +2,17 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
-	"errors"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Bool is a nullable bool.
@@ -36,7 +42,23 @@ func BoolFromPtr(b *bool) Bool {
 	return NewBool(*b, true)
 }
 
+// BoolFromSQL creates a new Bool from the database/sql NullBool n.
+func BoolFromSQL(n sql.NullBool) Bool {
+	return NewBool(n.Bool, n.Valid)
+}
+
+// BoolFromWrapperpb creates a new Bool from a protobuf BoolValue.
+// It will be null if w is nil.
+func BoolFromWrapperpb(w *wrapperspb.BoolValue) Bool {
+	if w == nil {
+		return NewBool(false, false)
+	}
+	return NewBool(w.Value, true)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// Besides JSON booleans it accepts the numbers 1 and 0, and the strings
+// accepted by UnmarshalText. A blank string will be null.
 func (b *Bool) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
 		b.Bool = false
@@ -44,33 +66,75 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &b.Bool); err != nil {
+	var err error
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err != nil {
+		b.Bool, b.Valid = false, false
 		return err
 	}
+	switch x := v.(type) {
+	case bool:
+		b.Bool = x
+	case float64:
+		switch x {
+		case 1:
+			b.Bool = true
+		case 0:
+			b.Bool = false
+		default:
+			err = fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Bool", data)
+		}
+	case string:
+		if len(x) == 0 {
+			b.Valid = false
+			return nil
+		}
+		b.Bool, err = parseBool(x)
+	case nil:
+		b.Bool = false
+		b.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Bool", data)
+	}
+	b.Valid = err == nil
+	return err
+}
 
-	b.Valid = true
-	return nil
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Bool
+// to def and makes it valid, rather than null.
+func (b *Bool) UnmarshalJSONDefault(data []byte, def bool) error {
+	if bytes.Equal(data, NullBytes) {
+		b.SetValid(def)
+		return nil
+	}
+	return b.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts "true", "false", "yes", "no", "1" and "0", ignoring case.
+// Blank text will be null.
 func (b *Bool) UnmarshalText(text []byte) error {
 	if text == nil || len(text) == 0 {
 		b.Valid = false
 		return nil
 	}
 
-	str := string(text)
-	switch str {
-	case "true":
-		b.Bool = true
-	case "false":
-		b.Bool = false
-	default:
-		b.Valid = false
-		return errors.New("invalid input:" + str)
+	var err error
+	b.Bool, err = parseBool(string(text))
+	b.Valid = err == nil
+	return err
+}
+
+// parseBool maps the accepted true and false tokens, ignoring case.
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "yes", "1":
+		return true, nil
+	case "false", "no", "0":
+		return false, nil
 	}
-	b.Valid = true
-	return nil
+	return false, fmt.Errorf("null: invalid input for null.Bool: %q", s)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -78,13 +142,25 @@ func (b Bool) MarshalJSON() ([]byte, error) {
 	if !b.Valid {
 		return NullBytes, nil
 	}
-	if !b.Bool {
-		return []byte("false"), nil
+	return b.AppendJSON(nil), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Bool to dst and returns
+// the extended buffer.
+func (b Bool) AppendJSON(dst []byte) []byte {
+	if !b.Valid {
+		return append(dst, NullBytes...)
 	}
-	return []byte("true"), nil
+	return strconv.AppendBool(dst, b.Bool)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Bool as chosen by opts.
+func (b Bool) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(b)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It encodes "true" or "false", or empty text if this Bool is null.
 func (b Bool) MarshalText() ([]byte, error) {
 	if !b.Valid {
 		return []byte{}, nil
@@ -95,12 +171,125 @@ func (b Bool) MarshalText() ([]byte, error) {
 	return []byte("true"), nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Bool is null.
+func (b Bool) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, b, b.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (b *Bool) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Bool is null.
+func (b Bool) MarshalYAML() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bool, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (b *Bool) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *bool
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		b.Bool = false
+		b.Valid = false
+		return nil
+	}
+	b.Bool = *v
+	b.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Bool is null.
+func (b Bool) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !b.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeBool(b.Bool)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (b *Bool) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *bool
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		b.Bool = false
+		b.Valid = false
+		return nil
+	}
+	b.Bool = *v
+	b.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Bool is encoded as a single byte.
+func (b Bool) MarshalBinary() ([]byte, error) {
+	if !b.Valid {
+		return []byte{encodedNull}, nil
+	}
+	if b.Bool {
+		return []byte{encodedValid, 1}, nil
+	}
+	return []byte{encodedValid, 0}, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Bool) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Bool")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		b.Bool = false
+		b.Valid = false
+		return nil
+	}
+	value, err = decodeFixed(value, 1, "Bool")
+	if err != nil {
+		return err
+	}
+	b.Bool = value[0] != 0
+	b.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (b Bool) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (b *Bool) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
 // SetValid changes this Bool's value and also sets it to be non-null.
 func (b *Bool) SetValid(v bool) {
 	b.Bool = v
 	b.Valid = true
 }
 
+// SetNull sets this Bool to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (b *Bool) SetNull() {
+	b.Bool = false
+	b.Valid = false
+}
+
 // Ptr returns a pointer to this Bool's value, or a nil pointer if this Bool is null.
 func (b Bool) Ptr() *bool {
 	if !b.Valid {
@@ -109,19 +298,124 @@ func (b Bool) Ptr() *bool {
 	return &b.Bool
 }
 
-// IsZero returns true for invalid Bools, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (b Bool) ValueOrZero() bool {
+	if !b.Valid {
+		return false
+	}
+	return b.Bool
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (b Bool) ValueOr(def bool) bool {
+	if !b.Valid {
+		return def
+	}
+	return b.Bool
+}
+
+// Or returns this Bool if it is valid, otherwise other. It picks a fallback;
+// for the logical OR of two Bools, use Or3.
+func (b Bool) Or(other Bool) Bool {
+	if !b.Valid {
+		return other
+	}
+	return b
+}
+
+// And3 returns the logical AND of this Bool and other under SQL's three-valued
+// logic, where null means unknown: false if either is false, otherwise null
+// if either is null, otherwise true.
+func (b Bool) And3(other Bool) Bool {
+	if (b.Valid && !b.Bool) || (other.Valid && !other.Bool) {
+		return BoolFrom(false)
+	}
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(true)
+}
+
+// Or3 returns the logical OR of this Bool and other under SQL's three-valued
+// logic, where null means unknown: true if either is true, otherwise null if
+// either is null, otherwise false.
+func (b Bool) Or3(other Bool) Bool {
+	if (b.Valid && b.Bool) || (other.Valid && other.Bool) {
+		return BoolFrom(true)
+	}
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(false)
+}
+
+// Not3 returns the logical NOT of this Bool under SQL's three-valued logic:
+// the negation of a valid Bool, and null for a null.
+func (b Bool) Not3() Bool {
+	if !b.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(!b.Bool)
+}
+
+// MustValue returns the inner value, and panics if this Bool is null.
+func (b Bool) MustValue() bool {
+	if !b.Valid {
+		panic("null.Bool: MustValue called on invalid value")
+	}
+	return b.Bool
+}
+
+// IsZero returns true if this Bool is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (b Bool) IsZero() bool {
 	return !b.Valid
 }
 
+// IsNull returns true if this Bool is null, the same as !Valid.
+func (b Bool) IsNull() bool {
+	return !b.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Bool, or nil if it is null, for
+// a *Bool field tagged omitempty that should leave nulls out.
+func (b Bool) OmitEmpty() *Bool {
+	if !b.Valid {
+		return nil
+	}
+	return &b
+}
+
+// Equal returns true if both Bools are null or both hold the same value.
+func (b Bool) Equal(other Bool) bool {
+	return b.Valid == other.Valid && (!b.Valid || b.Bool == other.Bool)
+}
+
+// String implements fmt.Stringer.
+// It returns "true" or "false", or NullDisplay if this Bool is null.
+func (b Bool) String() string {
+	if !b.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatBool(b.Bool)
+}
+
 // Scan implements the Scanner interface.
+// Besides a bool it accepts the integers 1 and 0, and a string or []byte that
+// strconv.ParseBool accepts, such as "1", "0", "true" and "false", for drivers
+// that store booleans as integers or text.
 func (b *Bool) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		b.Bool, b.Valid = false, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&b.Bool, value); err != nil {
+		b.Bool, b.Valid = false, false
+		return scanError("Bool", value, &b.Bool, err)
+	}
 	b.Valid = true
-	return convert.ConvertAssign(&b.Bool, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -132,6 +426,27 @@ func (b Bool) Value() (driver.Value, error) {
 	return b.Bool, nil
 }
 
+// ValueOrNil returns nil if this Bool is null, otherwise the same value as Value.
+func (b Bool) ValueOrNil() interface{} {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bool
+}
+
+// ToSQL returns this Bool as a database/sql NullBool.
+func (b Bool) ToSQL() sql.NullBool {
+	return sql.NullBool{Bool: b.Bool, Valid: b.Valid}
+}
+
+// ToWrapperpb returns this Bool as a protobuf BoolValue, or nil if this Bool is null.
+func (b Bool) ToWrapperpb() *wrapperspb.BoolValue {
+	if !b.Valid {
+		return nil
+	}
+	return wrapperspb.Bool(b.Bool)
+}
+
 // Randomize for sqlboiler
 func (b *Bool) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
@@ -2,7 +2,14 @@ package null
 
 import (
 	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
 )
 
 var (
@@ -79,6 +86,62 @@ func TestTextUnmarshalBool(t *testing.T) {
 	assertNullBool(t, invalid, "invalid json")
 }
 
+func TestUnmarshalBoolTokens(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+		want  bool
+		err   bool
+	}{
+		{in: `true`, valid: true, want: true},
+		{in: `false`, valid: true, want: false},
+		{in: `1`, valid: true, want: true},
+		{in: `0`, valid: true, want: false},
+		{in: `"true"`, valid: true, want: true},
+		{in: `"FALSE"`, valid: true, want: false},
+		{in: `"Yes"`, valid: true, want: true},
+		{in: `"no"`, valid: true, want: false},
+		{in: `"1"`, valid: true, want: true},
+		{in: `"0"`, valid: true, want: false},
+		{in: `""`, valid: false},
+		{in: `null`, valid: false},
+		{in: `"maybe"`, err: true},
+		{in: `"t"`, err: true},
+		{in: `2`, err: true},
+		{in: `-1`, err: true},
+		{in: `0.5`, err: true},
+		{in: `[]`, err: true},
+	}
+
+	for _, test := range tests {
+		var b Bool
+		err := json.Unmarshal([]byte(test.in), &b)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected error", test.in)
+			}
+			assertNullBool(t, b, test.in)
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.in, err)
+		}
+		if b.Valid != test.valid || b.Bool != test.want {
+			t.Errorf("%s: bad bool: %#v", test.in, b)
+		}
+
+		// strings decode the same way as text
+		if str, ok := strings.CutPrefix(test.in, `"`); ok {
+			var text Bool
+			err = text.UnmarshalText([]byte(strings.TrimSuffix(str, `"`)))
+			maybePanic(err)
+			if text != b {
+				t.Errorf("%s: bad text bool: %#v", test.in, text)
+			}
+		}
+	}
+}
+
 func TestMarshalBool(t *testing.T) {
 	b := BoolFrom(true)
 	data, err := json.Marshal(b)
@@ -115,6 +178,27 @@ func TestMarshalBoolText(t *testing.T) {
 	assertJSONEquals(t, data, "", "null text marshal")
 }
 
+func TestBoolTextConsumer(t *testing.T) {
+	// flag.TextVar drives a value purely through the encoding.Text* interfaces
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var verbose, quiet, unset Bool
+	fs.TextVar(&verbose, "verbose", NewBool(false, false), "")
+	fs.TextVar(&quiet, "quiet", NewBool(false, false), "")
+	fs.TextVar(&unset, "unset", BoolFrom(true), "")
+	err := fs.Parse([]string{"-verbose=yes", "-quiet", "FALSE"})
+	maybePanic(err)
+	assertBool(t, verbose, "flag yes")
+	assertFalseBool(t, quiet, "flag FALSE")
+	assertBool(t, unset, "flag default")
+
+	var invalid Bool
+	fs.TextVar(&invalid, "invalid", NewBool(false, false), "")
+	if err := fs.Parse([]string{"-invalid=maybe"}); err == nil {
+		t.Error("expected error")
+	}
+}
+
 func TestBoolPointer(t *testing.T) {
 	b := BoolFrom(true)
 	ptr := b.Ptr()
@@ -153,6 +237,15 @@ func TestBoolSetValid(t *testing.T) {
 	assertBool(t, change, "SetValid()")
 }
 
+func TestBoolSetNull(t *testing.T) {
+	change := BoolFrom(true)
+	change.SetNull()
+	assertNullBool(t, change, "SetNull()")
+	if change.Bool != false {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Bool)
+	}
+}
+
 func TestBoolScan(t *testing.T) {
 	var b Bool
 	err := b.Scan(true)
@@ -165,6 +258,127 @@ func TestBoolScan(t *testing.T) {
 	assertNullBool(t, null, "scanned null")
 }
 
+func TestBoolScanForms(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want bool
+	}{
+		{true, true},
+		{int64(1), true},
+		{int64(0), false},
+		{"1", true},
+		{"0", false},
+		{"true", true},
+		{"false", false},
+		{[]byte("1"), true},
+		{[]byte("FALSE"), false},
+	}
+	for _, test := range tests {
+		var b Bool
+		maybePanic(b.Scan(test.in))
+		if !b.Valid || b.Bool != test.want {
+			t.Errorf("%#v: bad scanned bool: %#v", test.in, b)
+		}
+	}
+
+	for _, in := range []interface{}{int64(2), int64(-1), "yes?", []byte("")} {
+		b := BoolFrom(true)
+		if err := b.Scan(in); err == nil {
+			t.Errorf("%#v: expected error", in)
+		}
+		assertNullBool(t, b, fmt.Sprintf("scanned %#v", in))
+	}
+}
+
+func TestBoolEqual(t *testing.T) {
+	null := NewBool(false, false)
+	other := NewBool(true, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := BoolFrom(true)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := BoolFrom(true)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := BoolFrom(false)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestBoolValueOr(t *testing.T) {
+	valid := BoolFrom(true)
+	if valid.ValueOrZero() != true {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(false) != true {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(false))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewBool(true, false)
+	if null.ValueOrZero() != false {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(false) != false {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(false))
+	}
+}
+
+func TestBoolString(t *testing.T) {
+	v := BoolFrom(true)
+	if s := v.String(); s != "true" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewBool(false, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestBoolMsgpack(t *testing.T) {
+	b := BoolFrom(true)
+	data, err := msgpack.Marshal(b)
+	maybePanic(err)
+
+	var v bool
+	err = msgpack.Unmarshal(data, &v)
+	maybePanic(err)
+	if !v {
+		t.Error("bad msgpack bool")
+	}
+
+	var out Bool
+	err = msgpack.Unmarshal(data, &out)
+	maybePanic(err)
+	assertBool(t, out, "msgpack")
+
+	data, err = msgpack.Marshal(NewBool(false, false))
+	maybePanic(err)
+	null := BoolFrom(true)
+	err = msgpack.Unmarshal(data, &null)
+	maybePanic(err)
+	assertNullBool(t, null, "msgpack nil")
+}
+
+func TestBoolValueOrNil(t *testing.T) {
+	assertValueOrNil(t, BoolFrom(true), "valid")
+	assertValueOrNil(t, NewBool(false, false), "null")
+}
+
+func TestBoolMustValue(t *testing.T) {
+	v := BoolFrom(true)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewBool(false, false).MustValue() }, "Bool")
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
@@ -188,3 +402,39 @@ func assertNullBool(t *testing.T, b Bool, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestBoolThreeValuedLogic(t *testing.T) {
+	var (
+		T = BoolFrom(true)
+		F = BoolFrom(false)
+		N = NewBool(true, false) // a stale true must not leak into the result
+	)
+	name := func(b Bool) string {
+		if !b.Valid {
+			return "NULL"
+		}
+		return strings.ToUpper(strconv.FormatBool(b.Bool))
+	}
+	tests := []struct {
+		a, b    Bool
+		and, or Bool
+	}{
+		{T, T, T, T}, {T, F, F, T}, {T, N, N, T},
+		{F, T, F, T}, {F, F, F, F}, {F, N, F, N},
+		{N, T, N, T}, {N, F, F, N}, {N, N, N, N},
+	}
+	for _, test := range tests {
+		if got := test.a.And3(test.b); !got.Equal(test.and) || (!got.Valid && got.Bool) {
+			t.Errorf("%s AND %s = %s, want %s", name(test.a), name(test.b), name(got), name(test.and))
+		}
+		if got := test.a.Or3(test.b); !got.Equal(test.or) || (!got.Valid && got.Bool) {
+			t.Errorf("%s OR %s = %s, want %s", name(test.a), name(test.b), name(got), name(test.or))
+		}
+	}
+
+	for _, test := range []struct{ in, want Bool }{{T, F}, {F, T}, {N, N}} {
+		if got := test.in.Not3(); !got.Equal(test.want) || (!got.Valid && got.Bool) {
+			t.Errorf("NOT %s = %s, want %s", name(test.in), name(got), name(test.want))
+		}
+	}
+}
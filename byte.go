@@ -2,12 +2,22 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
+	"strconv"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/volatiletech/null/convert"
 )
 
-// Byte is an nullable int.
+// Byte is a nullable byte, such as a tinyint unsigned column. It marshals to
+// a JSON number from 0 to 255, and Value returns an int64. For compatibility
+// with earlier versions, which treated a Byte as a single character,
+// UnmarshalJSON and Scan also accept a string of one byte, and MarshalText and
+// String still write the byte as a character.
 type Byte struct {
 	Byte  byte
 	Valid bool
@@ -34,20 +44,36 @@ func ByteFromPtr(b *byte) Byte {
 	return NewByte(*b, true)
 }
 
+// ByteFromSQL creates a new Byte from the database/sql NullByte n.
+func ByteFromSQL(n sql.NullByte) Byte {
+	return NewByte(n.Byte, n.Valid)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number from 0 to 255, or a string of one byte, which is
+// taken as that character. An empty string will be null.
 func (b *Byte) UnmarshalJSON(data []byte) error {
-	if len(data) == 0 || bytes.Equal(data, NullBytes) {
-		b.Valid = false
-		b.Byte = 0
+	if bytes.Equal(data, NullBytes) {
+		b.Byte, b.Valid = 0, false
 		return nil
 	}
+	if len(data) == 0 || data[0] != '"' {
+		x, valid, err := parseJSONUint(data, 8, "Byte")
+		b.Byte, b.Valid = byte(x), valid
+		return err
+	}
 
 	var x string
 	if err := json.Unmarshal(data, &x); err != nil {
+		b.Byte, b.Valid = 0, false
 		return err
 	}
-
+	if len(x) == 0 {
+		b.Byte, b.Valid = 0, false
+		return nil
+	}
 	if len(x) > 1 {
+		b.Byte, b.Valid = 0, false
 		return errors.New("json: cannot convert to byte, text len is greater than one")
 	}
 
@@ -56,6 +82,16 @@ func (b *Byte) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Byte
+// to def and makes it valid, rather than null.
+func (b *Byte) UnmarshalJSONDefault(data []byte, def byte) error {
+	if bytes.Equal(data, NullBytes) {
+		b.SetValid(def)
+		return nil
+	}
+	return b.UnmarshalJSON(data)
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (b *Byte) UnmarshalText(text []byte) error {
 	if text == nil || len(text) == 0 {
@@ -73,11 +109,17 @@ func (b *Byte) UnmarshalText(text []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler.
+// It encodes the byte as a JSON number.
 func (b Byte) MarshalJSON() ([]byte, error) {
 	if !b.Valid {
 		return NullBytes, nil
 	}
-	return []byte{'"', b.Byte, '"'}, nil
+	return strconv.AppendUint(make([]byte, 0, 3), uint64(b.Byte), 10), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Byte as chosen by opts.
+func (b Byte) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(b)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -88,12 +130,122 @@ func (b Byte) MarshalText() ([]byte, error) {
 	return []byte{b.Byte}, nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Byte is null.
+func (b Byte) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, b, b.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (b *Byte) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It encodes the byte as a YAML integer, like MarshalJSON, or a YAML null if
+// this Byte is null.
+func (b Byte) MarshalYAML() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Byte, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It accepts an integer from 0 to 255, or a string of one byte, like
+// UnmarshalJSON. A YAML null or empty string will be null.
+func (b *Byte) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if s, ok := v.(string); ok || v == nil {
+		return b.UnmarshalText([]byte(s))
+	}
+	var n uint8
+	if err := unmarshal(&n); err != nil {
+		b.Byte, b.Valid = 0, false
+		return err
+	}
+	b.SetValid(n)
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Byte is null.
+func (b Byte) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !b.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(string(b.Byte))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (b *Byte) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return b.UnmarshalText(nil)
+	}
+	return b.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Byte is encoded as a single byte.
+func (b Byte) MarshalBinary() ([]byte, error) {
+	if !b.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return []byte{encodedValid, b.Byte}, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Byte) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Byte")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		b.Byte = 0
+		b.Valid = false
+		return nil
+	}
+	value, err = decodeFixed(value, 1, "Byte")
+	if err != nil {
+		return err
+	}
+	b.Byte = value[0]
+	b.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (b Byte) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (b *Byte) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
 // SetValid changes this Byte's value and also sets it to be non-null.
 func (b *Byte) SetValid(n byte) {
 	b.Byte = n
 	b.Valid = true
 }
 
+// SetNull sets this Byte to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (b *Byte) SetNull() {
+	b.Byte = 0
+	b.Valid = false
+}
+
 // Ptr returns a pointer to this Byte's value, or a nil pointer if this Byte is null.
 func (b Byte) Ptr() *byte {
 	if !b.Valid {
@@ -102,36 +254,127 @@ func (b Byte) Ptr() *byte {
 	return &b.Byte
 }
 
-// IsZero returns true for invalid Bytes, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (b Byte) ValueOrZero() byte {
+	if !b.Valid {
+		return 0
+	}
+	return b.Byte
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (b Byte) ValueOr(def byte) byte {
+	if !b.Valid {
+		return def
+	}
+	return b.Byte
+}
+
+// Or returns this Byte if it is valid, otherwise other.
+func (b Byte) Or(other Byte) Byte {
+	if !b.Valid {
+		return other
+	}
+	return b
+}
+
+// MustValue returns the inner value, and panics if this Byte is null.
+func (b Byte) MustValue() byte {
+	if !b.Valid {
+		panic("null.Byte: MustValue called on invalid value")
+	}
+	return b.Byte
+}
+
+// IsZero returns true if this Byte is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (b Byte) IsZero() bool {
 	return !b.Valid
 }
 
+// IsNull returns true if this Byte is null, the same as !Valid.
+func (b Byte) IsNull() bool {
+	return !b.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Byte, or nil if it is null, for
+// a *Byte field tagged omitempty that should leave nulls out.
+func (b Byte) OmitEmpty() *Byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b
+}
+
+// Equal returns true if both Bytes are null or both hold the same value.
+func (b Byte) Equal(other Byte) bool {
+	return b.Valid == other.Valid && (!b.Valid || b.Byte == other.Byte)
+}
+
+// String implements fmt.Stringer.
+// It returns the byte as a one character string, or NullDisplay if this Byte is null.
+func (b Byte) String() string {
+	if !b.Valid {
+		return NullDisplay
+	}
+	return string(b.Byte)
+}
+
 // Scan implements the Scanner interface.
+// It converts a number with ConvertAssign, returning an error for one out of
+// range, and takes the first byte of a string or []byte, for character
+// columns. An empty string will be null. A sql.NullByte is taken as is.
 func (b *Byte) Scan(value interface{}) error {
-	if value == nil {
-		b.Byte, b.Valid = 0, false
+	if x, ok := value.(sql.NullByte); ok {
+		b.Byte, b.Valid = x.Byte, x.Valid
+		return nil
+	}
+	value = sqlNullValue(value)
+	var val []byte
+	switch x := value.(type) {
+	case string:
+		val = []byte(x)
+	case []byte:
+		val = x
+	case nil:
+	default:
+		if err := convert.ConvertAssign(&b.Byte, value); err != nil {
+			b.Byte, b.Valid = 0, false
+			return scanError("Byte", value, &b.Byte, err)
+		}
+		b.Valid = true
 		return nil
 	}
 
-	val := value.(string)
 	if len(val) == 0 {
-		b.Valid = false
-		b.Byte = 0
+		b.Byte, b.Valid = 0, false
 		return nil
 	}
 
-	b.Valid = true
-	b.Byte = byte(val[0])
+	b.Byte, b.Valid = val[0], true
 	return nil
 }
 
 // Value implements the driver Valuer interface.
+// It returns the byte as an int64.
 func (b Byte) Value() (driver.Value, error) {
 	if !b.Valid {
 		return nil, nil
 	}
-	return []byte{b.Byte}, nil
+	return int64(b.Byte), nil
+}
+
+// ValueOrNil returns nil if this Byte is null, otherwise the same value as Value.
+func (b Byte) ValueOrNil() interface{} {
+	if !b.Valid {
+		return nil
+	}
+	return int64(b.Byte)
+}
+
+// ToSQL returns this Byte as a database/sql NullByte.
+func (b Byte) ToSQL() sql.NullByte {
+	return sql.NullByte{Byte: b.Byte, Valid: b.Valid}
 }
 
 // Randomize for sqlboiler
@@ -2,6 +2,8 @@ package null
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -74,7 +76,7 @@ func TestMarshalByte(t *testing.T) {
 	i := ByteFrom('b')
 	data, err := json.Marshal(i)
 	maybePanic(err)
-	assertJSONEquals(t, data, `"b"`, "non-empty json marshal")
+	assertJSONEquals(t, data, "98", "non-empty json marshal")
 
 	// invalid values should be encoded as null
 	null := NewByte(0, false)
@@ -134,16 +136,168 @@ func TestByteSetValid(t *testing.T) {
 	assertByte(t, change, "SetValid()")
 }
 
+func TestByteSetNull(t *testing.T) {
+	change := ByteFrom('b')
+	change.SetNull()
+	assertNullByte(t, change, "SetNull()")
+	if change.Byte != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Byte)
+	}
+}
+
 func TestByteScan(t *testing.T) {
 	var i Byte
 	err := i.Scan("b")
 	maybePanic(err)
 	assertByte(t, i, "scanned int")
 
+	var b Byte
+	err = b.Scan([]byte("b"))
+	maybePanic(err)
+	assertByte(t, b, "scanned []byte")
+
 	var null Byte
 	err = null.Scan(nil)
 	maybePanic(err)
 	assertNullByte(t, null, "scanned null")
+
+	var n Byte
+	err = n.Scan(int64(98))
+	maybePanic(err)
+	assertByte(t, n, "scanned int64")
+
+	wrong := ByteFrom('b')
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullByte(t, wrong, "scanned wrong type")
+}
+
+func TestByteNumeric(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want byte
+	}{
+		{"0", 0},
+		{"255", 255},
+		{"98", 'b'},
+		{`"b"`, 'b'},
+	} {
+		var b Byte
+		maybePanic(json.Unmarshal([]byte(test.in), &b))
+		if !b.Valid || b.Byte != test.want {
+			t.Errorf("%s: bad unmarshaled byte: %#v ≠ %d", test.in, b, test.want)
+		}
+	}
+
+	for _, in := range []string{"256", "-1"} {
+		b := ByteFrom('b')
+		var o *OverflowError
+		if err := json.Unmarshal([]byte(in), &b); !errors.As(err, &o) {
+			t.Errorf("%s: expected an *OverflowError, got %v", in, err)
+		}
+		assertNullByte(t, b, in)
+	}
+	for _, in := range []string{`"42"`, "1.5", "true"} {
+		b := ByteFrom('b')
+		if err := json.Unmarshal([]byte(in), &b); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+		assertNullByte(t, b, in)
+	}
+
+	if v, err := ByteFrom(255).Value(); v != int64(255) || err != nil {
+		t.Errorf("bad Value(): %#v, %v", v, err)
+	}
+
+	var o *OverflowError
+	b := ByteFrom('b')
+	if err := b.Scan(int64(256)); !errors.As(err, &o) {
+		t.Errorf("expected an *OverflowError scanning 256, got %v", err)
+	}
+	assertNullByte(t, b, "scanned 256")
+}
+
+func TestByteJSONRoundTrip(t *testing.T) {
+	// every byte must read back as itself, including quotes, backslashes,
+	// control bytes and bytes that are not valid UTF-8 on their own
+	for n := 0; n <= 255; n++ {
+		in := ByteFrom(byte(n))
+		data, err := json.Marshal(in)
+		maybePanic(err)
+		if !json.Valid(data) {
+			t.Fatalf("%d: invalid json %q", n, data)
+		}
+		var out Byte
+		if err := json.Unmarshal(data, &out); err != nil || !out.Equal(in) {
+			t.Errorf("%d: bad json round trip through %s: %#v, %v", n, data, out, err)
+		}
+	}
+}
+
+func TestByteEqual(t *testing.T) {
+	null := NewByte(0, false)
+	other := NewByte('a', false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := ByteFrom('a')
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := ByteFrom('a')
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := ByteFrom('b')
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestByteValueOr(t *testing.T) {
+	valid := ByteFrom('a')
+	if valid.ValueOrZero() != 'a' {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr('b') != 'a' {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr('b'))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewByte('a', false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr('b') != 'b' {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr('b'))
+	}
+}
+
+func TestByteString(t *testing.T) {
+	v := ByteFrom('b')
+	if s := v.String(); s != "b" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewByte(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestByteValueOrNil(t *testing.T) {
+	assertValueOrNil(t, ByteFrom('b'), "valid")
+	assertValueOrNil(t, NewByte(0, false), "null")
+}
+
+func TestByteMustValue(t *testing.T) {
+	v := ByteFrom('b')
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewByte(0, false).MustValue() }, "Byte")
 }
 
 func assertByte(t *testing.T, i Byte, from string) {
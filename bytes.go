@@ -3,15 +3,30 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // NullBytes is a global byte slice of JSON null
 var NullBytes = []byte("null")
 
+// NullDisplay is returned by the String method of every type when it is null,
+// so that %v and %s output is predictable. null.String is the exception: its
+// String field prevents it from having a String method.
+const NullDisplay = "<null>"
+
+// bytesDisplayMax is the number of leading bytes shown by Bytes.String.
+const bytesDisplayMax = 16
+
 // Bytes is a nullable []byte.
+// JSON marshals to and from a standard base64 string, like a plain []byte.
+// Use TextBytes to marshal the contents as a plain JSON string instead.
 type Bytes struct {
 	Bytes []byte
 	Valid bool
@@ -39,7 +54,22 @@ func BytesFromPtr(b *[]byte) Bytes {
 	return n
 }
 
+// BytesFromWrapperpb creates a new Bytes from a protobuf BytesValue.
+// It will be null if w is nil.
+func BytesFromWrapperpb(w *wrapperspb.BytesValue) Bytes {
+	if w == nil {
+		return NewBytes(nil, false)
+	}
+	if w.Value == nil {
+		return NewBytes([]byte{}, true)
+	}
+	return NewBytes(w.Value, true)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a standard base64 string, as MarshalJSON produces, and invalid
+// base64 is an error. For encoders that write bytes as numbers, an array of
+// integers from 0 to 255, such as [104,105], is accepted too.
 func (b *Bytes) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
 		b.Valid = false
@@ -47,16 +77,45 @@ func (b *Bytes) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
+	decoded, err := parseJSONBytes(data)
+	if err != nil {
+		b.Bytes, b.Valid = nil, false
 		return err
 	}
-
-	b.Bytes = []byte(s)
+	b.Bytes = decoded
 	b.Valid = true
 	return nil
 }
 
+// parseJSONBytes decodes a base64 JSON string or a JSON array of byte values.
+func parseJSONBytes(data []byte) ([]byte, error) {
+	if t := bytes.TrimSpace(data); len(t) > 0 && t[0] == '[' {
+		var nums []json.Number
+		if err := json.Unmarshal(t, &nums); err != nil {
+			return nil, err
+		}
+		decoded := make([]byte, len(nums))
+		for i, n := range nums {
+			x, err := strconv.ParseUint(string(n), 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("null: invalid byte %s at index %d for null.Bytes", n, i)
+			}
+			decoded[i] = byte(x)
+		}
+		return decoded, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("null: invalid base64 for null.Bytes: %v", err)
+	}
+	return decoded, nil
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (b *Bytes) UnmarshalText(text []byte) error {
 	if text == nil || len(text) == 0 {
@@ -71,11 +130,18 @@ func (b *Bytes) UnmarshalText(text []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler.
+// It will encode null if this Bytes is null or empty, and otherwise a
+// standard base64 string.
 func (b Bytes) MarshalJSON() ([]byte, error) {
-	if len(b.Bytes) == 0 || b.Bytes == nil {
+	if !b.Valid || len(b.Bytes) == 0 {
 		return NullBytes, nil
 	}
-	return b.Bytes, nil
+	return json.Marshal(b.Bytes)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Bytes as chosen by opts.
+func (b Bytes) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(b)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -86,12 +152,111 @@ func (b Bytes) MarshalText() ([]byte, error) {
 	return b.Bytes, nil
 }
 
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Bytes is null.
+// The bytes are encoded as a string, which YAML encoders emit as !!binary
+// when it is not valid UTF-8.
+func (b Bytes) MarshalYAML() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return string(b.Bytes), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (b *Bytes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		b.Bytes = nil
+		b.Valid = false
+		return nil
+	}
+	b.Bytes = []byte(*v)
+	b.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Bytes is null.
+func (b Bytes) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !b.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeBytes(b.Bytes)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (b *Bytes) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *[]byte
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		b.Bytes = nil
+		b.Valid = false
+		return nil
+	}
+	b.Bytes = *v
+	b.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Bytes is encoded as a single byte.
+func (b Bytes) MarshalBinary() ([]byte, error) {
+	if !b.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, b.Bytes), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Bytes) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Bytes")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		b.Bytes = nil
+		b.Valid = false
+		return nil
+	}
+	b.Bytes, err = decodeLengthPrefixed(value, "Bytes")
+	if err != nil {
+		return err
+	}
+	b.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (b Bytes) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (b *Bytes) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
 // SetValid changes this Bytes's value and also sets it to be non-null.
 func (b *Bytes) SetValid(n []byte) {
 	b.Bytes = n
 	b.Valid = true
 }
 
+// SetNull sets this Bytes to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (b *Bytes) SetNull() {
+	b.Bytes = nil
+	b.Valid = false
+}
+
 // Ptr returns a pointer to this Bytes's value, or a nil pointer if this Bytes is null.
 func (b Bytes) Ptr() *[]byte {
 	if !b.Valid {
@@ -100,19 +265,99 @@ func (b Bytes) Ptr() *[]byte {
 	return &b.Bytes
 }
 
-// IsZero returns true for null or zero Bytes's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (b Bytes) ValueOr(def []byte) []byte {
+	if !b.Valid {
+		return def
+	}
+	return b.Bytes
+}
+
+// Or returns this Bytes if it is valid, otherwise other.
+func (b Bytes) Or(other Bytes) Bytes {
+	if !b.Valid {
+		return other
+	}
+	return b
+}
+
+// Clone returns a copy of this Bytes with its own copy of the bytes, so that
+// changes to one do not show in the other. A null Bytes clones to a null.
+func (b Bytes) Clone() Bytes {
+	if !b.Valid {
+		return NewBytes(nil, false)
+	}
+	return NewBytes(cloneBytes(b.Bytes), true)
+}
+
+// MustValue returns the inner value, and panics if this Bytes is null.
+func (b Bytes) MustValue() []byte {
+	if !b.Valid {
+		panic("null.Bytes: MustValue called on invalid value")
+	}
+	return b.Bytes
+}
+
+// IsZero returns true if this Bytes is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (b Bytes) IsZero() bool {
 	return !b.Valid
 }
 
+// IsNull returns true if this Bytes is null, the same as !Valid.
+func (b Bytes) IsNull() bool {
+	return !b.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Bytes, or nil if it is null, for
+// a *Bytes field tagged omitempty that should leave nulls out.
+func (b Bytes) OmitEmpty() *Bytes {
+	if !b.Valid {
+		return nil
+	}
+	return &b
+}
+
+// Equal returns true if both Bytess are null or both hold the same value.
+func (b Bytes) Equal(other Bytes) bool {
+	return b.Valid == other.Valid && (!b.Valid || bytes.Equal(b.Bytes, other.Bytes))
+}
+
+// String implements fmt.Stringer.
+// It returns a short summary of the length and leading bytes in hex, such as
+// "3 bytes: 616263", or NullDisplay if this Bytes is null. Only the first
+// bytesDisplayMax bytes are shown, so large values are never dumped in full.
+func (b Bytes) String() string {
+	if !b.Valid {
+		return NullDisplay
+	}
+	if len(b.Bytes) > bytesDisplayMax {
+		return fmt.Sprintf("%d bytes: %x...", len(b.Bytes), b.Bytes[:bytesDisplayMax])
+	}
+	return fmt.Sprintf("%d bytes: %x", len(b.Bytes), b.Bytes)
+}
+
 // Scan implements the Scanner interface.
 func (b *Bytes) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		b.Bytes, b.Valid = []byte{}, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&b.Bytes, value); err != nil {
+		b.Bytes, b.Valid = nil, false
+		return scanError("Bytes", value, &b.Bytes, err)
+	}
 	b.Valid = true
-	return convert.ConvertAssign(&b.Bytes, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -123,6 +368,22 @@ func (b Bytes) Value() (driver.Value, error) {
 	return b.Bytes, nil
 }
 
+// ValueOrNil returns nil if this Bytes is null, otherwise the same value as Value.
+func (b Bytes) ValueOrNil() interface{} {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// ToWrapperpb returns this Bytes as a protobuf BytesValue, or nil if this Bytes is null.
+func (b Bytes) ToWrapperpb() *wrapperspb.BytesValue {
+	if !b.Valid {
+		return nil
+	}
+	return wrapperspb.Bytes(b.Bytes)
+}
+
 // Randomize for sqlboiler
 func (b *Bytes) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
@@ -133,3 +394,11 @@ func (b *Bytes) Randomize(nextInt func() int64, fieldType string, shouldBeNull b
 		b.Valid = true
 	}
 }
+
+// cloneBytes returns a copy of b, keeping a nil slice nil and an empty one empty.
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
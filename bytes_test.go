@@ -3,11 +3,12 @@ package null
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"testing"
 )
 
 var (
-	bytesJSON = []byte(`"hello"`)
+	bytesJSON = []byte(`"aGVsbG8="`)
 )
 
 func TestBytesFrom(t *testing.T) {
@@ -47,6 +48,59 @@ func TestUnmarshalBytes(t *testing.T) {
 		t.Errorf("Expected error")
 	}
 
+	var empty Bytes
+	err = json.Unmarshal(blankStringJSON, &empty)
+	maybePanic(err)
+	if !empty.Valid || len(empty.Bytes) != 0 {
+		t.Errorf("bad blank string json: %#v", empty)
+	}
+
+	var raw Bytes
+	err = json.Unmarshal([]byte(`"hello"`), &raw)
+	if err == nil || err.Error() != "null: invalid base64 for null.Bytes: illegal base64 data at input byte 4" {
+		t.Errorf("bad invalid base64 error: %v", err)
+	}
+	assertNullBytes(t, raw, "invalid base64 json")
+
+	var array Bytes
+	err = json.Unmarshal([]byte(`[104, 101, 108, 108, 111]`), &array)
+	maybePanic(err)
+	assertBytes(t, array, "byte array json")
+
+	var emptyArray Bytes
+	err = json.Unmarshal([]byte(`[]`), &emptyArray)
+	maybePanic(err)
+	if !emptyArray.Valid || emptyArray.Bytes == nil || len(emptyArray.Bytes) != 0 {
+		t.Errorf("bad empty array json: %#v", emptyArray)
+	}
+
+	badArrays := map[string]string{
+		`[104,256]`:  "null: invalid byte 256 at index 1 for null.Bytes",
+		`[-1]`:       "null: invalid byte -1 at index 0 for null.Bytes",
+		`[1.5]`:      "null: invalid byte 1.5 at index 0 for null.Bytes",
+		`[1e2]`:      "null: invalid byte 1e2 at index 0 for null.Bytes",
+		`["a"]`:      "",
+		`[null]`:     "",
+		`[[1]]`:      "",
+		`[1,true,2]`: "",
+	}
+	for in, want := range badArrays {
+		bad := BytesFrom([]byte("x"))
+		err := json.Unmarshal([]byte(in), &bad)
+		if err == nil || (want != "" && err.Error() != want) {
+			t.Errorf("%s: bad error: %v", in, err)
+		}
+		assertNullBytes(t, bad, in)
+	}
+
+	for _, in := range []string{`1`, `true`, `{}`} {
+		bad := BytesFrom([]byte("x"))
+		if err := json.Unmarshal([]byte(in), &bad); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+		assertNullBytes(t, bad, in)
+	}
+
 	var null Bytes
 	err = null.UnmarshalJSON([]byte("null"))
 	if null.Valid == true {
@@ -57,6 +111,23 @@ func TestUnmarshalBytes(t *testing.T) {
 	}
 }
 
+func TestBytesJSONRoundTrip(t *testing.T) {
+	// MarshalJSON's base64 output is what UnmarshalJSON reads by default
+	for _, in := range [][]byte{[]byte("hello"), {0}, {0xff, 0xfe, 0xfd}, make([]byte, 100)} {
+		data, err := json.Marshal(BytesFrom(in))
+		maybePanic(err)
+		if data[0] != '"' {
+			t.Errorf("%v: MarshalJSON should produce a base64 string, got %s", in, data)
+		}
+		var out Bytes
+		err = json.Unmarshal(data, &out)
+		maybePanic(err)
+		if !out.Valid || !bytes.Equal(out.Bytes, in) {
+			t.Errorf("bad round trip of %v through %s: %v", in, data, out.Bytes)
+		}
+	}
+}
+
 func TestTextUnmarshalBytes(t *testing.T) {
 	var i Bytes
 	err := i.UnmarshalText([]byte(`hello`))
@@ -70,16 +141,31 @@ func TestTextUnmarshalBytes(t *testing.T) {
 }
 
 func TestMarshalBytes(t *testing.T) {
-	i := BytesFrom([]byte(`"hello"`))
+	i := BytesFrom([]byte(`hello`))
 	data, err := json.Marshal(i)
 	maybePanic(err)
-	assertJSONEquals(t, data, `"hello"`, "non-empty json marshal")
+	assertJSONEquals(t, data, string(bytesJSON), "non-empty json marshal")
+
+	var roundTrip Bytes
+	err = json.Unmarshal(data, &roundTrip)
+	maybePanic(err)
+	assertBytes(t, roundTrip, "json round trip")
+
+	binary := BytesFrom([]byte{0xde, 0xad, 0xbe, 0xef})
+	data, err = json.Marshal(binary)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"3q2+7w=="`, "binary json marshal")
 
 	// invalid values should be encoded as null
 	null := NewBytes(nil, false)
 	data, err = json.Marshal(null)
 	maybePanic(err)
 	assertJSONEquals(t, data, "null", "null json marshal")
+
+	stale := NewBytes([]byte(`hello`), false)
+	data, err = json.Marshal(stale)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal with bytes")
 }
 
 func TestMarshalBytesText(t *testing.T) {
@@ -133,6 +219,15 @@ func TestBytesSetValid(t *testing.T) {
 	assertBytes(t, change, "SetValid()")
 }
 
+func TestBytesSetNull(t *testing.T) {
+	change := BytesFrom([]byte("hello"))
+	change.SetNull()
+	assertNullBytes(t, change, "SetNull()")
+	if change.Bytes != nil {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Bytes)
+	}
+}
+
 func TestBytesScan(t *testing.T) {
 	var i Bytes
 	err := i.Scan(`hello`)
@@ -145,6 +240,75 @@ func TestBytesScan(t *testing.T) {
 	assertNullBytes(t, null, "scanned null")
 }
 
+func TestBytesEqual(t *testing.T) {
+	null := NewBytes(nil, false)
+	other := NewBytes([]byte("hello"), false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := BytesFrom([]byte("hello"))
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := BytesFrom([]byte("hello"))
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := BytesFrom([]byte("world"))
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestBytesValueOr(t *testing.T) {
+	valid := BytesFrom([]byte("hello"))
+	if !bytes.Equal(valid.ValueOrZero(), []byte("hello")) {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if !bytes.Equal(valid.ValueOr([]byte("world")), []byte("hello")) {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr([]byte("world")))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewBytes([]byte("hello"), false)
+	if null.ValueOrZero() != nil {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if !bytes.Equal(null.ValueOr([]byte("world")), []byte("world")) {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr([]byte("world")))
+	}
+}
+
+func TestBytesString(t *testing.T) {
+	b := BytesFrom([]byte("hello"))
+	if s := b.String(); s != "5 bytes: 68656c6c6f" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	large := BytesFrom(make([]byte, 1<<20))
+	if s := large.String(); s != "1048576 bytes: 00000000000000000000000000000000..." {
+		t.Errorf("bad large String(): %q", s)
+	}
+
+	null := NewBytes(nil, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestBytesValueOrNil(t *testing.T) {
+	assertValueOrNil(t, BytesFrom([]byte("hello")), "valid")
+	assertValueOrNil(t, NewBytes(nil, false), "null")
+}
+
+func TestBytesMustValue(t *testing.T) {
+	v := BytesFrom([]byte("hello"))
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewBytes(nil, false).MustValue() }, "Bytes")
+}
+
 func assertBytes(t *testing.T, i Bytes, from string) {
 	if !bytes.Equal(i.Bytes, []byte("hello")) {
 		t.Errorf("bad %s []byte: %v ≠ %v\n", from, string(i.Bytes), string([]byte(`hello`)))
@@ -159,3 +323,20 @@ func assertNullBytes(t *testing.T, i Bytes, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestBytesClone(t *testing.T) {
+	orig := BytesFrom([]byte("hello"))
+	clone := orig.Clone()
+	orig.Bytes[0] = 'j'
+	if !clone.Valid || string(clone.Bytes) != "hello" {
+		t.Errorf("clone should be unchanged: %q", clone.Bytes)
+	}
+
+	empty := NewBytes([]byte{}, true).Clone()
+	if !empty.Valid || empty.Bytes == nil || len(empty.Bytes) != 0 {
+		t.Errorf("bad clone of empty Bytes: %#v", empty)
+	}
+
+	null := NewBytes([]byte("stale"), false).Clone()
+	assertNullBytes(t, null, "Clone() null")
+}
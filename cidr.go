@@ -0,0 +1,407 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// CIDR is a nullable *net.IPNet, for Postgres cidr and inet columns, which
+// keep the prefix length along with the address. Both IPv4 and IPv6 networks
+// are supported.
+//
+// The address is kept exactly as given, host bits included, so that an inet
+// value such as 192.168.1.5/24 round trips unchanged. Use Network to clear
+// the host bits, as a cidr column requires.
+type CIDR struct {
+	CIDR  *net.IPNet
+	Valid bool
+}
+
+// NewCIDR creates a new CIDR
+func NewCIDR(n *net.IPNet, valid bool) CIDR {
+	return CIDR{
+		CIDR:  n,
+		Valid: valid,
+	}
+}
+
+// CIDRFrom creates a new CIDR that will be invalid if n is nil.
+func CIDRFrom(n *net.IPNet) CIDR {
+	return NewCIDR(n, n != nil)
+}
+
+// CIDRFromPtr creates a new CIDR that will be invalid if n is nil or points to nil.
+func CIDRFromPtr(n **net.IPNet) CIDR {
+	if n == nil {
+		return NewCIDR(nil, false)
+	}
+	return CIDRFrom(*n)
+}
+
+// ParseCIDR parses a network in CIDR notation such as "192.0.2.0/24" into a valid CIDR, as UnmarshalText
+// does, but returns an error rather than a null CIDR for empty text.
+func ParseCIDR(s string) (CIDR, error) {
+	return parseText[CIDR]("CIDR", s)
+}
+
+// MustCIDR is like ParseCIDR, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustCIDR(s string) CIDR {
+	v, err := ParseCIDR(s)
+	return mustParse("MustCIDR", s, v, err)
+}
+
+// Network returns this CIDR with the host bits of its address cleared, such
+// as 192.168.1.0/24 for 192.168.1.5/24. A null CIDR is returned unchanged.
+func (c CIDR) Network() CIDR {
+	if !c.Valid || c.CIDR == nil {
+		return c
+	}
+	return CIDRFrom(&net.IPNet{IP: c.CIDR.IP.Mask(c.CIDR.Mask), Mask: c.CIDR.Mask})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// An empty string will be null.
+func (c *CIDR) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		c.CIDR = nil
+		c.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		c.CIDR, c.Valid = nil, false
+		return err
+	}
+
+	return c.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CIDR) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		c.CIDR = nil
+		c.Valid = false
+		return nil
+	}
+
+	n, err := parseCIDR(string(text))
+	if err != nil {
+		c.CIDR, c.Valid = nil, false
+		return err
+	}
+
+	c.CIDR = n
+	c.Valid = true
+	return nil
+}
+
+// parseCIDR parses an address with a prefix length, such as "192.168.1.0/24",
+// keeping any host bits. A bare address, as Postgres writes an inet with a
+// full length prefix, is a /32 or /128 network. IPv4 addresses are kept in
+// their 4 byte form so that the address and mask lengths match.
+func parseCIDR(s string) (*net.IPNet, error) {
+	if strings.IndexByte(s, '/') < 0 {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("null: invalid CIDR %q", s)
+		}
+		if v4 := ip.To4(); v4 != nil {
+			return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+
+	ip, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("null: invalid CIDR %q", s)
+	}
+	if v4 := ip.To4(); v4 != nil && len(n.Mask) == net.IPv4len {
+		ip = v4
+	}
+	n.IP = ip
+	return n, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this CIDR is null, and otherwise the address with its
+// prefix length, such as "192.168.1.0/24".
+func (c CIDR) MarshalJSON() ([]byte, error) {
+	if !c.Valid || c.CIDR == nil {
+		return NullBytes, nil
+	}
+	return []byte(`"` + c.CIDR.String() + `"`), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null CIDR as chosen by opts.
+func (c CIDR) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(c)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CIDR) MarshalText() ([]byte, error) {
+	if !c.Valid || c.CIDR == nil {
+		return []byte{}, nil
+	}
+	return []byte(c.CIDR.String()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this CIDR is null.
+func (c CIDR) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, c, c.Valid && c.CIDR != nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (c *CIDR) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, c)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this CIDR is null.
+func (c CIDR) MarshalYAML() (interface{}, error) {
+	if !c.Valid || c.CIDR == nil {
+		return nil, nil
+	}
+	return c.CIDR.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (c *CIDR) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return c.UnmarshalText(nil)
+	}
+	return c.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this CIDR is null.
+func (c CIDR) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !c.Valid || c.CIDR == nil {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(c.CIDR.String())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (c *CIDR) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return c.UnmarshalText(nil)
+	}
+	return c.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null CIDR is encoded as a single byte, and otherwise the address bytes
+// and then the mask bytes follow, length prefixed together.
+func (c CIDR) MarshalBinary() ([]byte, error) {
+	if !c.Valid || c.CIDR == nil {
+		return []byte{encodedNull}, nil
+	}
+	b := append(append([]byte{}, c.CIDR.IP...), c.CIDR.Mask...)
+	return appendLengthPrefixed([]byte{encodedValid}, b), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *CIDR) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "CIDR")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		c.CIDR = nil
+		c.Valid = false
+		return nil
+	}
+	b, err := decodeLengthPrefixed(value, "CIDR")
+	if err != nil {
+		return err
+	}
+	if len(b) != 2*net.IPv4len && len(b) != 2*net.IPv6len {
+		return fmt.Errorf("null: expected %d or %d bytes of data for null.CIDR, got %d", 2*net.IPv4len, 2*net.IPv6len, len(b))
+	}
+	size := len(b) / 2
+	mask := net.IPMask(b[size:])
+	if ones, bits := mask.Size(); ones == 0 && bits == 0 {
+		return fmt.Errorf("null: invalid mask for null.CIDR: %v", mask)
+	}
+	c.CIDR = &net.IPNet{IP: net.IP(b[:size]), Mask: mask}
+	c.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (c CIDR) GobEncode() ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (c *CIDR) GobDecode(data []byte) error {
+	return c.UnmarshalBinary(data)
+}
+
+// SetValid changes this CIDR's value and also sets it to be non-null.
+func (c *CIDR) SetValid(n *net.IPNet) {
+	c.CIDR = n
+	c.Valid = true
+}
+
+// SetNull sets this CIDR to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (c *CIDR) SetNull() {
+	c.CIDR = nil
+	c.Valid = false
+}
+
+// Ptr returns a pointer to this CIDR's value, or a nil pointer if this CIDR is null.
+func (c CIDR) Ptr() **net.IPNet {
+	if !c.Valid {
+		return nil
+	}
+	return &c.CIDR
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (c CIDR) ValueOrZero() *net.IPNet {
+	if !c.Valid {
+		return nil
+	}
+	return c.CIDR
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (c CIDR) ValueOr(def *net.IPNet) *net.IPNet {
+	if !c.Valid {
+		return def
+	}
+	return c.CIDR
+}
+
+// Or returns this CIDR if it is valid, otherwise other.
+func (c CIDR) Or(other CIDR) CIDR {
+	if !c.Valid {
+		return other
+	}
+	return c
+}
+
+// MustValue returns the inner value, and panics if this CIDR is null.
+func (c CIDR) MustValue() *net.IPNet {
+	if !c.Valid {
+		panic("null.CIDR: MustValue called on invalid value")
+	}
+	return c.CIDR
+}
+
+// IsZero returns true if this CIDR is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (c CIDR) IsZero() bool {
+	return !c.Valid
+}
+
+// IsNull returns true if this CIDR is null, the same as !Valid.
+func (c CIDR) IsNull() bool {
+	return !c.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this CIDR, or nil if it is null, for
+// a *CIDR field tagged omitempty that should leave nulls out.
+func (c CIDR) OmitEmpty() *CIDR {
+	if !c.Valid {
+		return nil
+	}
+	return &c
+}
+
+// Equal returns true if both CIDRs are null or both hold the same address and
+// prefix length. Host bits are compared too, so 192.168.1.5/24 and
+// 192.168.1.0/24 are not equal.
+func (c CIDR) Equal(other CIDR) bool {
+	if c.Valid != other.Valid {
+		return false
+	}
+	if !c.Valid {
+		return true
+	}
+	if c.CIDR == nil || other.CIDR == nil {
+		return c.CIDR == other.CIDR
+	}
+	return c.CIDR.IP.Equal(other.CIDR.IP) && bytes.Equal(c.CIDR.Mask, other.CIDR.Mask)
+}
+
+// String implements fmt.Stringer.
+// It returns the address with its prefix length, or NullDisplay if this CIDR is null.
+func (c CIDR) String() string {
+	if !c.Valid || c.CIDR == nil {
+		return NullDisplay
+	}
+	return c.CIDR.String()
+}
+
+// Scan implements the Scanner interface.
+// It accepts the Postgres cidr and inet text forms as a string or []byte.
+func (c *CIDR) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case string:
+		c.CIDR, err = parseCIDR(x)
+	case []byte:
+		c.CIDR, err = parseCIDR(string(x))
+	case nil:
+		c.CIDR, c.Valid = nil, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	c.Valid = err == nil
+	return scanError("CIDR", value, nil, err)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the address with its prefix length as a string.
+func (c CIDR) Value() (driver.Value, error) {
+	if !c.Valid || c.CIDR == nil {
+		return nil, nil
+	}
+	return c.CIDR.String(), nil
+}
+
+// ValueOrNil returns nil if this CIDR is null, otherwise the same value as Value.
+func (c CIDR) ValueOrNil() interface{} {
+	if !c.Valid || c.CIDR == nil {
+		return nil
+	}
+	return c.CIDR.String()
+}
+
+// Randomize for sqlboiler
+func (c *CIDR) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		c.CIDR = nil
+		c.Valid = false
+	} else {
+		ip := net.IPv4(10, byte(nextInt()%256), byte(nextInt()%256), 0).To4()
+		c.CIDR = &net.IPNet{IP: ip, Mask: net.CIDRMask(24, 32)}
+		c.Valid = true
+	}
+}
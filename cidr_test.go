@@ -0,0 +1,197 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+)
+
+var (
+	cidrString        = "192.168.1.0/24"
+	cidrJSON          = []byte(`"` + cidrString + `"`)
+	_, cidrValue, _   = net.ParseCIDR(cidrString)
+	cidrv6String      = "2001:db8::/32"
+	cidrv6JSON        = []byte(`"` + cidrv6String + `"`)
+	_, cidrv6Value, _ = net.ParseCIDR(cidrv6String)
+)
+
+func TestCIDRFrom(t *testing.T) {
+	assertCIDR(t, CIDRFrom(cidrValue), cidrString, "CIDRFrom()")
+	assertNullCIDR(t, CIDRFrom(nil), "CIDRFrom(nil)")
+
+	v := cidrValue
+	assertCIDR(t, CIDRFromPtr(&v), cidrString, "CIDRFromPtr()")
+	assertNullCIDR(t, CIDRFromPtr(nil), "CIDRFromPtr(nil)")
+}
+
+func TestUnmarshalCIDR(t *testing.T) {
+	var c CIDR
+	err := json.Unmarshal(cidrJSON, &c)
+	maybePanic(err)
+	assertCIDR(t, c, cidrString, "ipv4 json")
+
+	var v6 CIDR
+	err = json.Unmarshal(cidrv6JSON, &v6)
+	maybePanic(err)
+	assertCIDR(t, v6, cidrv6String, "ipv6 json")
+
+	// host bits are kept, as in a Postgres inet
+	var host CIDR
+	err = json.Unmarshal([]byte(`"192.168.1.5/24"`), &host)
+	maybePanic(err)
+	assertCIDR(t, host, "192.168.1.5/24", "inet json")
+	assertCIDR(t, host.Network(), cidrString, "inet json Network()")
+
+	var bare CIDR
+	err = json.Unmarshal([]byte(`"10.0.0.1"`), &bare)
+	maybePanic(err)
+	assertCIDR(t, bare, "10.0.0.1/32", "bare address json")
+
+	var null CIDR
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullCIDR(t, null, "null json")
+
+	var blank CIDR
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullCIDR(t, blank, "blank json string")
+
+	for _, data := range []string{`"192.168.1.0/33"`, `"2001:db8::/129"`, `"192.168.1.0/"`, `"test"`, `1`} {
+		bad := CIDRFrom(cidrValue)
+		if err := json.Unmarshal([]byte(data), &bad); err == nil {
+			t.Errorf("%s: expected error", data)
+		}
+		assertNullCIDR(t, bad, data)
+	}
+
+	var invalid CIDR
+	err = json.Unmarshal([]byte(`"10.0.0.0/99"`), &invalid)
+	if err == nil || err.Error() != `null: invalid CIDR "10.0.0.0/99"` {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestMarshalCIDR(t *testing.T) {
+	data, err := json.Marshal(CIDRFrom(cidrValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(cidrJSON), "ipv4 json marshal")
+
+	data, err = json.Marshal(CIDRFrom(cidrv6Value))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(cidrv6JSON), "ipv6 json marshal")
+
+	data, err = json.Marshal(NewCIDR(nil, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestCIDRRoundTrip(t *testing.T) {
+	for _, s := range []string{"192.168.1.5/24", "0.0.0.0/0", "10.0.0.1/32", "2001:db8::1/64", "::/0"} {
+		var c CIDR
+		err := c.UnmarshalText([]byte(s))
+		maybePanic(err)
+
+		data, err := json.Marshal(c)
+		maybePanic(err)
+		var j CIDR
+		err = json.Unmarshal(data, &j)
+		maybePanic(err)
+		if !j.Equal(c) || j.String() != s {
+			t.Errorf("bad json round trip of %s: %v", s, j)
+		}
+
+		bin, err := c.MarshalBinary()
+		maybePanic(err)
+		var b CIDR
+		err = b.UnmarshalBinary(bin)
+		maybePanic(err)
+		if !b.Equal(c) || b.String() != s {
+			t.Errorf("bad binary round trip of %s: %v", s, b)
+		}
+	}
+}
+
+func TestCIDREqual(t *testing.T) {
+	if !NewCIDR(nil, false).Equal(NewCIDR(cidrValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if CIDRFrom(cidrValue).Equal(NewCIDR(nil, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	var host CIDR
+	maybePanic(host.UnmarshalText([]byte("192.168.1.5/24")))
+	if CIDRFrom(cidrValue).Equal(host) {
+		t.Error("Equal() should be false when the host bits differ")
+	}
+	if !CIDRFrom(cidrValue).Equal(host.Network()) {
+		t.Error("Equal() should be true for the same network")
+	}
+}
+
+func TestCIDRScanValue(t *testing.T) {
+	var c CIDR
+	err := c.Scan(cidrString)
+	maybePanic(err)
+	assertCIDR(t, c, cidrString, "scanned string")
+	if v, err := c.Value(); v != cidrString || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var v6 CIDR
+	err = v6.Scan([]byte(cidrv6String))
+	maybePanic(err)
+	assertCIDR(t, v6, cidrv6String, "scanned []byte")
+	if v, err := v6.Value(); v != cidrv6String || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null CIDR
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullCIDR(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	wrong := CIDRFrom(cidrValue)
+	if err := wrong.Scan("10.0.0.0/40"); err == nil {
+		t.Error("expected error")
+	}
+	assertNullCIDR(t, wrong, "scanned wrong")
+}
+
+func TestCIDRString(t *testing.T) {
+	if s := CIDRFrom(cidrValue).String(); s != cidrString {
+		t.Errorf("bad String(): %q", s)
+	}
+	if s := fmt.Sprint(NewCIDR(nil, false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestCIDRValueOrNil(t *testing.T) {
+	assertValueOrNil(t, CIDRFrom(cidrValue), "valid")
+	assertValueOrNil(t, NewCIDR(nil, false), "null")
+}
+
+func TestCIDRMustValue(t *testing.T) {
+	v := CIDRFrom(cidrValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewCIDR(nil, false).MustValue() }, "CIDR")
+}
+
+func assertCIDR(t *testing.T, c CIDR, want string, from string) {
+	if c.CIDR == nil || c.CIDR.String() != want {
+		t.Errorf("bad %s cidr: %v ≠ %s\n", from, c.CIDR, want)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullCIDR(t *testing.T, c CIDR, from string) {
+	if c.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
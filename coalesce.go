@@ -0,0 +1,25 @@
+package null
+
+// Coalesce returns the first of vals that is not null, like SQL's COALESCE.
+// It works with every type in this package, including Null[T], and treats an
+// Optional that is unset or set to null as null. If all of vals are null, or
+// there are none, the zero value of T is returned, which is null.
+func Coalesce[T interface{ IsNull() bool }](vals ...T) T {
+	for _, v := range vals {
+		if !v.IsNull() {
+			return v
+		}
+	}
+	var zero T
+	return zero
+}
+
+// Or returns v if it is not null, otherwise other. Unlike ValueOr it does not
+// unwrap, so fallbacks can be chained, as in Or(Or(a, b), c). Each type in
+// this package also has an Or method, such as a.Or(b).Or(c).
+func Or[T interface{ IsNull() bool }](v, other T) T {
+	if !v.IsNull() {
+		return v
+	}
+	return other
+}
@@ -0,0 +1,86 @@
+package null
+
+import (
+	"testing"
+)
+
+func TestCoalesce(t *testing.T) {
+	none := Coalesce[String]()
+	assertNullStr(t, none, "Coalesce()")
+
+	allNull := Coalesce(NewString("", false), NewString("ignored", false))
+	assertNullStr(t, allNull, "Coalesce() all null")
+
+	first := Coalesce(StringFrom("test"), StringFrom("second"), NewString("", false))
+	assertStr(t, first, "Coalesce() first valid")
+
+	middle := Coalesce(NewString("", false), StringFrom("test"), StringFrom("last"))
+	assertStr(t, middle, "Coalesce() middle valid")
+
+	// a valid zero value is not null, so it is picked
+	zero := Coalesce(NewInt64(0, false), Int64From(0), Int64From(12345))
+	if !zero.Valid || zero.Int64 != 0 {
+		t.Errorf("bad Coalesce() valid zero: %#v", zero)
+	}
+
+	generic := Coalesce(NewNull(0, false), NullFrom(12345))
+	if !generic.Valid || generic.Val != 12345 {
+		t.Errorf("bad Coalesce() Null[int]: %#v", generic)
+	}
+}
+
+func TestOr(t *testing.T) {
+	first := StringFrom("test").Or(StringFrom("second"))
+	assertStr(t, first, "Or() valid first")
+
+	second := NewString("stale", false).Or(StringFrom("test"))
+	assertStr(t, second, "Or() null first, valid second")
+
+	both := NewString("stale", false).Or(NewString("", false))
+	assertNullStr(t, both, "Or() both null")
+
+	chained := NewString("", false).Or(NewString("", false)).Or(StringFrom("test"))
+	assertStr(t, chained, "Or() chained")
+
+	// a valid zero value is not null, so it is kept
+	zero := Int64From(0).Or(Int64From(12345))
+	if !zero.Valid || zero.Int64 != 0 {
+		t.Errorf("bad Or() valid zero: %#v", zero)
+	}
+
+	if n := NewNull(0, false).Or(NullFrom(12345)); !n.Valid || n.Val != 12345 {
+		t.Errorf("bad Null[int] Or(): %#v", n)
+	}
+	if n := NullFrom(1).Or(NullFrom(12345)); n.Val != 1 {
+		t.Errorf("bad Null[int] Or() valid first: %#v", n)
+	}
+	if n := NewNull(0, false).Or(NewNull(1, false)); n.Valid {
+		t.Errorf("bad Null[int] Or() both null: %#v", n)
+	}
+
+	assertStr(t, Or(NewString("", false), StringFrom("test")), "generic Or() null first")
+	assertStr(t, Or(StringFrom("test"), StringFrom("second")), "generic Or() valid first")
+	assertNullStr(t, Or(NewString("", false), NewString("", false)), "generic Or() both null")
+	if u := Or(NewUint8(1, false), Uint8From(2)); !u.Valid || u.Uint8 != 2 {
+		t.Errorf("bad generic Uint8 Or(): %#v", u)
+	}
+}
+
+func TestCoalesceOptional(t *testing.T) {
+	var unset Optional[int]
+	explicitNull := Optional[int]{Set: true}
+	value := Optional[int]{Val: 12345, Valid: true, Set: true}
+
+	if o := Coalesce(explicitNull, unset, value); o != value {
+		t.Errorf("bad Coalesce() with an explicit null Optional: %#v", o)
+	}
+	if o := Or(explicitNull, value); o != value {
+		t.Errorf("bad Or() with an explicit null Optional: %#v", o)
+	}
+	if !AnyNull([]Optional[int]{value, explicitNull}) {
+		t.Error("AnyNull() should be true with an explicit null Optional")
+	}
+	if valid := FilterValid([]Optional[int]{explicitNull, value, unset}); len(valid) != 1 || valid[0] != value {
+		t.Errorf("bad FilterValid() with an explicit null Optional: %#v", valid)
+	}
+}
@@ -0,0 +1,392 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strconv"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Complex128 is a nullable complex128.
+// It marshals to a two-element JSON array of its real and imaginary parts,
+// such as [1,-2], and is stored in the database in the strconv.FormatComplex
+// form, such as "(1-2i)".
+type Complex128 struct {
+	Complex128 complex128
+	Valid      bool
+}
+
+// NewComplex128 creates a new Complex128
+func NewComplex128(c complex128, valid bool) Complex128 {
+	return Complex128{
+		Complex128: c,
+		Valid:      valid,
+	}
+}
+
+// Complex128From creates a new Complex128 that will always be valid.
+func Complex128From(c complex128) Complex128 {
+	return NewComplex128(c, true)
+}
+
+// Complex128FromPtr creates a new Complex128 that will be null if c is nil.
+func Complex128FromPtr(c *complex128) Complex128 {
+	if c == nil {
+		return NewComplex128(0, false)
+	}
+	return NewComplex128(*c, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a two-element array [re, im], whose elements are decoded like a
+// Float64, so NaN and Infinity are rejected.
+func (c *Complex128) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		c.Complex128 = 0
+		c.Valid = false
+		return nil
+	}
+
+	x, err := parseJSONComplex(data, 64, "Complex128")
+	if err != nil {
+		c.Complex128, c.Valid = 0, false
+		return err
+	}
+	c.Complex128 = x
+	c.Valid = true
+	return nil
+}
+
+// parseJSONComplex decodes a [re, im] JSON array whose parts are floats of
+// the given bit size.
+func parseJSONComplex(data []byte, bits int, typ string) (complex128, error) {
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil || len(parts) != 2 {
+		return 0, fmt.Errorf("json: cannot unmarshal %s into Go value of type null.%s: expected a [re, im] array", data, typ)
+	}
+	var xs [2]float64
+	for i, part := range parts {
+		x, valid, err := parseJSONFloat(part, bits, typ)
+		if err != nil {
+			return 0, err
+		}
+		if !valid {
+			return 0, fmt.Errorf("json: cannot unmarshal %s into Go value of type null.%s: expected a [re, im] array", data, typ)
+		}
+		xs[i] = x
+	}
+	return complex(xs[0], xs[1]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the strconv.ParseComplex forms, such as "(1-2i)" or "1-2i".
+func (c *Complex128) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		c.Complex128 = 0
+		c.Valid = false
+		return nil
+	}
+	var err error
+	c.Complex128, err = strconv.ParseComplex(string(text), 128)
+	c.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// A NaN or infinite part cannot be represented in JSON and returns an error.
+// Use MarshalJSONWith and MarshalOptions.NonFiniteAsNull to encode it as null.
+func (c Complex128) MarshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return NullBytes, nil
+	}
+	if isNonFiniteComplex(c.Complex128) {
+		return nil, fmt.Errorf("json: cannot marshal non-finite value %v in null.Complex128", c.Complex128)
+	}
+	b := appendJSONFloat([]byte{'['}, real(c.Complex128), 64)
+	b = append(b, ',')
+	b = appendJSONFloat(b, imag(c.Complex128), 64)
+	return append(b, ']'), nil
+}
+
+// isNonFiniteComplex reports whether either part of x is NaN or infinite.
+func isNonFiniteComplex(x complex128) bool {
+	return cmplx.IsNaN(x) || cmplx.IsInf(x)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Complex128 as chosen by opts.
+// With opts.NonFiniteAsNull, a value with a NaN or infinite part is encoded as null too.
+func (c Complex128) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	if opts.NonFiniteAsNull && c.Valid && isNonFiniteComplex(c.Complex128) {
+		return opts.nullJSON(), nil
+	}
+	return opts.marshalJSON(c)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It uses the strconv.FormatComplex form, such as "(1-2i)".
+func (c Complex128) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatComplex(c.Complex128, 'g', -1, 128)), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Complex128 is null.
+func (c Complex128) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, c, c.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (c *Complex128) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, c)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Complex128 is null, and otherwise a [re, im] sequence.
+func (c Complex128) MarshalYAML() (interface{}, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return []float64{real(c.Complex128), imag(c.Complex128)}, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It expects a [re, im] sequence. A YAML null will be null.
+func (c *Complex128) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *[]float64
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return c.setParts(v)
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Complex128 is null, and otherwise a [re, im] array.
+func (c Complex128) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !c.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode([]float64{real(c.Complex128), imag(c.Complex128)})
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (c *Complex128) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *[]float64
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	return c.setParts(v)
+}
+
+// setParts sets this Complex128 from a decoded [re, im] pair, or to null if parts is nil.
+func (c *Complex128) setParts(parts *[]float64) error {
+	if parts == nil {
+		c.Complex128 = 0
+		c.Valid = false
+		return nil
+	}
+	if len(*parts) != 2 {
+		return fmt.Errorf("null: expected a [re, im] pair for null.Complex128, got %d values", len(*parts))
+	}
+	c.Complex128 = complex((*parts)[0], (*parts)[1])
+	c.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Complex128 is encoded as a single byte.
+func (c Complex128) MarshalBinary() ([]byte, error) {
+	if !c.Valid {
+		return []byte{encodedNull}, nil
+	}
+	b := binary.BigEndian.AppendUint64([]byte{encodedValid}, math.Float64bits(real(c.Complex128)))
+	return binary.BigEndian.AppendUint64(b, math.Float64bits(imag(c.Complex128))), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *Complex128) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Complex128")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		c.Complex128 = 0
+		c.Valid = false
+		return nil
+	}
+	value, err = decodeFixed(value, 16, "Complex128")
+	if err != nil {
+		return err
+	}
+	re := math.Float64frombits(binary.BigEndian.Uint64(value))
+	im := math.Float64frombits(binary.BigEndian.Uint64(value[8:]))
+	c.Complex128 = complex(re, im)
+	c.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (c Complex128) GobEncode() ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (c *Complex128) GobDecode(data []byte) error {
+	return c.UnmarshalBinary(data)
+}
+
+// SetValid changes this Complex128's value and also sets it to be non-null.
+func (c *Complex128) SetValid(n complex128) {
+	c.Complex128 = n
+	c.Valid = true
+}
+
+// SetNull sets this Complex128 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (c *Complex128) SetNull() {
+	c.Complex128 = 0
+	c.Valid = false
+}
+
+// Ptr returns a pointer to this Complex128's value, or a nil pointer if this Complex128 is null.
+func (c Complex128) Ptr() *complex128 {
+	if !c.Valid {
+		return nil
+	}
+	return &c.Complex128
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (c Complex128) ValueOrZero() complex128 {
+	if !c.Valid {
+		return 0
+	}
+	return c.Complex128
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (c Complex128) ValueOr(def complex128) complex128 {
+	if !c.Valid {
+		return def
+	}
+	return c.Complex128
+}
+
+// Or returns this Complex128 if it is valid, otherwise other.
+func (c Complex128) Or(other Complex128) Complex128 {
+	if !c.Valid {
+		return other
+	}
+	return c
+}
+
+// MustValue returns the inner value, and panics if this Complex128 is null.
+func (c Complex128) MustValue() complex128 {
+	if !c.Valid {
+		panic("null.Complex128: MustValue called on invalid value")
+	}
+	return c.Complex128
+}
+
+// IsZero returns true if this Complex128 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (c Complex128) IsZero() bool {
+	return !c.Valid
+}
+
+// IsNull returns true if this Complex128 is null, the same as !Valid.
+func (c Complex128) IsNull() bool {
+	return !c.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Complex128, or nil if it is null, for
+// a *Complex128 field tagged omitempty that should leave nulls out.
+func (c Complex128) OmitEmpty() *Complex128 {
+	if !c.Valid {
+		return nil
+	}
+	return &c
+}
+
+// Equal returns true if both Complex128s are null or both hold the same value.
+func (c Complex128) Equal(other Complex128) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.Complex128 == other.Complex128)
+}
+
+// String implements fmt.Stringer.
+// It returns the strconv.FormatComplex form, such as "(1-2i)", or NullDisplay if this Complex128 is null.
+func (c Complex128) String() string {
+	if !c.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatComplex(c.Complex128, 'g', -1, 128)
+}
+
+// Scan implements the Scanner interface.
+// It accepts the strconv.ParseComplex forms as a string, []byte or json.Number,
+// and a float64 or int64 as the real part alone.
+func (c *Complex128) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case string:
+		c.Complex128, err = strconv.ParseComplex(x, 128)
+	case []byte:
+		c.Complex128, err = strconv.ParseComplex(string(x), 128)
+	case json.Number:
+		c.Complex128, err = strconv.ParseComplex(string(x), 128)
+	case float64:
+		c.Complex128 = complex(x, 0)
+	case int64:
+		c.Complex128 = complex(float64(x), 0)
+	case nil:
+		c.Complex128, c.Valid = 0, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		c.Complex128, c.Valid = 0, false
+		return scanError("Complex128", value, nil, err)
+	}
+	c.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the strconv.FormatComplex form, such as "(1-2i)".
+func (c Complex128) Value() (driver.Value, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return strconv.FormatComplex(c.Complex128, 'g', -1, 128), nil
+}
+
+// ValueOrNil returns nil if this Complex128 is null, otherwise the same value as Value.
+func (c Complex128) ValueOrNil() interface{} {
+	if !c.Valid {
+		return nil
+	}
+	return strconv.FormatComplex(c.Complex128, 'g', -1, 128)
+}
+
+// Randomize for sqlboiler
+func (c *Complex128) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		c.Complex128 = 0
+		c.Valid = false
+	} else {
+		c.Complex128 = complex(float64(nextInt()%10), float64(nextInt()%10)-5)
+		c.Valid = true
+	}
+}
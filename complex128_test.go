@@ -0,0 +1,214 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	complex128Value = complex(1.5, -2.25)
+	complex128JSON  = []byte(`[1.5,-2.25]`)
+	complex128Text  = "(1.5-2.25i)"
+)
+
+func TestComplex128From(t *testing.T) {
+	assertComplex128(t, Complex128From(complex128Value), "Complex128From()")
+
+	zero := Complex128From(0)
+	if !zero.Valid {
+		t.Error("Complex128From(0)", "is invalid, but should be valid")
+	}
+
+	v := complex128Value
+	assertComplex128(t, Complex128FromPtr(&v), "Complex128FromPtr()")
+	assertNullComplex128(t, Complex128FromPtr(nil), "Complex128FromPtr(nil)")
+}
+
+func TestUnmarshalComplex128(t *testing.T) {
+	var c Complex128
+	err := json.Unmarshal(complex128JSON, &c)
+	maybePanic(err)
+	assertComplex128(t, c, "array json")
+
+	var quoted Complex128
+	err = json.Unmarshal([]byte(`["1.5", "-2.25"]`), &quoted)
+	maybePanic(err)
+	assertComplex128(t, quoted, "array of strings json")
+
+	var null Complex128
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullComplex128(t, null, "null json")
+
+	for _, data := range []string{`[1]`, `[1,2,3]`, `[1,null]`, `[1,""]`, `[1,true]`, `{"re":1}`, `"(1+2i)"`, `1`} {
+		bad := Complex128From(complex128Value)
+		if err := json.Unmarshal([]byte(data), &bad); err == nil {
+			t.Errorf("%s: expected error", data)
+		}
+		assertNullComplex128(t, bad, data)
+	}
+
+	var nan Complex128
+	if err := nan.UnmarshalJSON([]byte(`[NaN,1]`)); err == nil {
+		t.Error("expected error for a NaN part")
+	}
+}
+
+func TestMarshalComplex128(t *testing.T) {
+	data, err := json.Marshal(Complex128From(complex128Value))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(complex128JSON), "non-empty json marshal")
+
+	data, err = json.Marshal(Complex128From(complex(0, -1)))
+	maybePanic(err)
+	assertJSONEquals(t, data, "[0,-1]", "negative imaginary json marshal")
+
+	data, err = json.Marshal(NewComplex128(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestComplex128JSONRoundTrip(t *testing.T) {
+	for _, x := range []complex128{0, 1i, -1i, complex(-3, -4), complex(1e300, -1e-300), complex(0.1, 0.2)} {
+		data, err := json.Marshal(Complex128From(x))
+		maybePanic(err)
+		var out Complex128
+		err = json.Unmarshal(data, &out)
+		maybePanic(err)
+		if !out.Valid || out.Complex128 != x {
+			t.Errorf("bad round trip of %v through %s: %v", x, data, out)
+		}
+	}
+}
+
+func TestMarshalComplex128NonFinite(t *testing.T) {
+	for _, x := range []complex128{complex(math.NaN(), 0), complex(0, math.Inf(-1)), complex(math.Inf(1), 1)} {
+		v := Complex128From(x)
+		if _, err := json.Marshal(v); err == nil {
+			t.Errorf("%v: expected error", x)
+		}
+		data, err := v.MarshalJSONWith(MarshalOptions{NonFiniteAsNull: true})
+		maybePanic(err)
+		assertJSONEquals(t, data, "null", "non-finite with NonFiniteAsNull")
+	}
+}
+
+func TestComplex128Text(t *testing.T) {
+	data, err := Complex128From(complex128Value).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, complex128Text, "non-empty text marshal")
+
+	data, err = NewComplex128(0, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var c Complex128
+	err = c.UnmarshalText([]byte(complex128Text))
+	maybePanic(err)
+	assertComplex128(t, c, "UnmarshalText()")
+
+	var blank Complex128
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullComplex128(t, blank, "UnmarshalText() empty")
+}
+
+func TestComplex128YAMLMsgpack(t *testing.T) {
+	data, err := yaml.Marshal(Complex128From(complex128Value))
+	maybePanic(err)
+	var y Complex128
+	err = yaml.Unmarshal(data, &y)
+	maybePanic(err)
+	assertComplex128(t, y, "yaml round trip")
+
+	data, err = msgpack.Marshal(Complex128From(complex128Value))
+	maybePanic(err)
+	var m Complex128
+	err = msgpack.Unmarshal(data, &m)
+	maybePanic(err)
+	assertComplex128(t, m, "msgpack round trip")
+
+	data, err = msgpack.Marshal(NewComplex128(0, false))
+	maybePanic(err)
+	m = Complex128From(complex128Value)
+	err = msgpack.Unmarshal(data, &m)
+	maybePanic(err)
+	assertNullComplex128(t, m, "msgpack null round trip")
+}
+
+func TestComplex128ScanValue(t *testing.T) {
+	var c Complex128
+	err := c.Scan(complex128Text)
+	maybePanic(err)
+	assertComplex128(t, c, "scanned string")
+	if v, err := c.Value(); v != complex128Text || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var b Complex128
+	err = b.Scan([]byte("-1-1i"))
+	maybePanic(err)
+	if !b.Valid || b.Complex128 != complex(-1, -1) {
+		t.Errorf("bad scanned []byte: %v", b)
+	}
+
+	var f Complex128
+	err = f.Scan(1.5)
+	maybePanic(err)
+	if !f.Valid || f.Complex128 != 1.5 {
+		t.Errorf("bad scanned float64: %v", f)
+	}
+
+	var null Complex128
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullComplex128(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	wrong := Complex128From(complex128Value)
+	if err := wrong.Scan("hello"); err == nil {
+		t.Error("expected error")
+	}
+	assertNullComplex128(t, wrong, "scanned wrong")
+}
+
+func TestComplex128String(t *testing.T) {
+	if s := Complex128From(complex128Value).String(); s != complex128Text {
+		t.Errorf("bad String(): %q", s)
+	}
+	if s := fmt.Sprint(NewComplex128(0, false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestComplex128ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Complex128From(complex128Value), "valid")
+	assertValueOrNil(t, NewComplex128(0, false), "null")
+}
+
+func TestComplex128MustValue(t *testing.T) {
+	v := Complex128From(complex128Value)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewComplex128(0, false).MustValue() }, "Complex128")
+}
+
+func assertComplex128(t *testing.T, c Complex128, from string) {
+	if c.Complex128 != complex128Value {
+		t.Errorf("bad %s complex128: %v ≠ %v\n", from, c.Complex128, complex128Value)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullComplex128(t *testing.T, c Complex128, from string) {
+	if c.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,366 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Complex64 is a nullable complex64.
+// It marshals to a two-element JSON array of its real and imaginary parts,
+// such as [1,-2], and is stored in the database in the strconv.FormatComplex
+// form, such as "(1-2i)".
+type Complex64 struct {
+	Complex64 complex64
+	Valid     bool
+}
+
+// NewComplex64 creates a new Complex64
+func NewComplex64(c complex64, valid bool) Complex64 {
+	return Complex64{
+		Complex64: c,
+		Valid:     valid,
+	}
+}
+
+// Complex64From creates a new Complex64 that will always be valid.
+func Complex64From(c complex64) Complex64 {
+	return NewComplex64(c, true)
+}
+
+// Complex64FromPtr creates a new Complex64 that will be null if c is nil.
+func Complex64FromPtr(c *complex64) Complex64 {
+	if c == nil {
+		return NewComplex64(0, false)
+	}
+	return NewComplex64(*c, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a two-element array [re, im], whose elements are decoded like a
+// Float32, so NaN and Infinity are rejected.
+func (c *Complex64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		c.Complex64 = 0
+		c.Valid = false
+		return nil
+	}
+
+	x, err := parseJSONComplex(data, 32, "Complex64")
+	if err != nil {
+		c.Complex64, c.Valid = 0, false
+		return err
+	}
+	c.Complex64 = complex64(x)
+	c.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the strconv.ParseComplex forms, such as "(1-2i)" or "1-2i".
+func (c *Complex64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		c.Complex64 = 0
+		c.Valid = false
+		return nil
+	}
+	x, err := strconv.ParseComplex(string(text), 64)
+	c.Complex64, c.Valid = complex64(x), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// A NaN or infinite part cannot be represented in JSON and returns an error.
+// Use MarshalJSONWith and MarshalOptions.NonFiniteAsNull to encode it as null.
+func (c Complex64) MarshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return NullBytes, nil
+	}
+	if isNonFiniteComplex(complex128(c.Complex64)) {
+		return nil, fmt.Errorf("json: cannot marshal non-finite value %v in null.Complex64", c.Complex64)
+	}
+	b := appendJSONFloat([]byte{'['}, float64(real(c.Complex64)), 32)
+	b = append(b, ',')
+	b = appendJSONFloat(b, float64(imag(c.Complex64)), 32)
+	return append(b, ']'), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Complex64 as chosen by opts.
+// With opts.NonFiniteAsNull, a value with a NaN or infinite part is encoded as null too.
+func (c Complex64) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	if opts.NonFiniteAsNull && c.Valid && isNonFiniteComplex(complex128(c.Complex64)) {
+		return opts.nullJSON(), nil
+	}
+	return opts.marshalJSON(c)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It uses the strconv.FormatComplex form, such as "(1-2i)".
+func (c Complex64) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatComplex(complex128(c.Complex64), 'g', -1, 64)), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Complex64 is null.
+func (c Complex64) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, c, c.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (c *Complex64) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, c)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Complex64 is null, and otherwise a [re, im] sequence.
+func (c Complex64) MarshalYAML() (interface{}, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return []float32{real(c.Complex64), imag(c.Complex64)}, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It expects a [re, im] sequence. A YAML null will be null.
+func (c *Complex64) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *[]float32
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return c.setParts(v)
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Complex64 is null, and otherwise a [re, im] array.
+func (c Complex64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !c.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode([]float32{real(c.Complex64), imag(c.Complex64)})
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (c *Complex64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *[]float32
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	return c.setParts(v)
+}
+
+// setParts sets this Complex64 from a decoded [re, im] pair, or to null if parts is nil.
+func (c *Complex64) setParts(parts *[]float32) error {
+	if parts == nil {
+		c.Complex64 = 0
+		c.Valid = false
+		return nil
+	}
+	if len(*parts) != 2 {
+		return fmt.Errorf("null: expected a [re, im] pair for null.Complex64, got %d values", len(*parts))
+	}
+	c.Complex64 = complex((*parts)[0], (*parts)[1])
+	c.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Complex64 is encoded as a single byte.
+func (c Complex64) MarshalBinary() ([]byte, error) {
+	if !c.Valid {
+		return []byte{encodedNull}, nil
+	}
+	b := binary.BigEndian.AppendUint32([]byte{encodedValid}, math.Float32bits(real(c.Complex64)))
+	return binary.BigEndian.AppendUint32(b, math.Float32bits(imag(c.Complex64))), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *Complex64) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Complex64")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		c.Complex64 = 0
+		c.Valid = false
+		return nil
+	}
+	value, err = decodeFixed(value, 8, "Complex64")
+	if err != nil {
+		return err
+	}
+	re := math.Float32frombits(binary.BigEndian.Uint32(value))
+	im := math.Float32frombits(binary.BigEndian.Uint32(value[4:]))
+	c.Complex64 = complex(re, im)
+	c.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (c Complex64) GobEncode() ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (c *Complex64) GobDecode(data []byte) error {
+	return c.UnmarshalBinary(data)
+}
+
+// SetValid changes this Complex64's value and also sets it to be non-null.
+func (c *Complex64) SetValid(n complex64) {
+	c.Complex64 = n
+	c.Valid = true
+}
+
+// SetNull sets this Complex64 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (c *Complex64) SetNull() {
+	c.Complex64 = 0
+	c.Valid = false
+}
+
+// Ptr returns a pointer to this Complex64's value, or a nil pointer if this Complex64 is null.
+func (c Complex64) Ptr() *complex64 {
+	if !c.Valid {
+		return nil
+	}
+	return &c.Complex64
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (c Complex64) ValueOrZero() complex64 {
+	if !c.Valid {
+		return 0
+	}
+	return c.Complex64
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (c Complex64) ValueOr(def complex64) complex64 {
+	if !c.Valid {
+		return def
+	}
+	return c.Complex64
+}
+
+// Or returns this Complex64 if it is valid, otherwise other.
+func (c Complex64) Or(other Complex64) Complex64 {
+	if !c.Valid {
+		return other
+	}
+	return c
+}
+
+// MustValue returns the inner value, and panics if this Complex64 is null.
+func (c Complex64) MustValue() complex64 {
+	if !c.Valid {
+		panic("null.Complex64: MustValue called on invalid value")
+	}
+	return c.Complex64
+}
+
+// IsZero returns true if this Complex64 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (c Complex64) IsZero() bool {
+	return !c.Valid
+}
+
+// IsNull returns true if this Complex64 is null, the same as !Valid.
+func (c Complex64) IsNull() bool {
+	return !c.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Complex64, or nil if it is null, for
+// a *Complex64 field tagged omitempty that should leave nulls out.
+func (c Complex64) OmitEmpty() *Complex64 {
+	if !c.Valid {
+		return nil
+	}
+	return &c
+}
+
+// Equal returns true if both Complex64s are null or both hold the same value.
+func (c Complex64) Equal(other Complex64) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.Complex64 == other.Complex64)
+}
+
+// String implements fmt.Stringer.
+// It returns the strconv.FormatComplex form, such as "(1-2i)", or NullDisplay if this Complex64 is null.
+func (c Complex64) String() string {
+	if !c.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatComplex(complex128(c.Complex64), 'g', -1, 64)
+}
+
+// Scan implements the Scanner interface.
+// It accepts the strconv.ParseComplex forms as a string, []byte or json.Number,
+// and a float64 or int64 as the real part alone.
+func (c *Complex64) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var x complex128
+	var err error
+	switch v := value.(type) {
+	case string:
+		x, err = strconv.ParseComplex(v, 64)
+	case []byte:
+		x, err = strconv.ParseComplex(string(v), 64)
+	case json.Number:
+		x, err = strconv.ParseComplex(string(v), 64)
+	case float64:
+		x = complex(v, 0)
+	case int64:
+		x = complex(float64(v), 0)
+	case nil:
+		c.Complex64, c.Valid = 0, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		c.Complex64, c.Valid = 0, false
+		return scanError("Complex64", value, nil, err)
+	}
+	c.Complex64 = complex64(x)
+	c.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the strconv.FormatComplex form, such as "(1-2i)".
+func (c Complex64) Value() (driver.Value, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return strconv.FormatComplex(complex128(c.Complex64), 'g', -1, 64), nil
+}
+
+// ValueOrNil returns nil if this Complex64 is null, otherwise the same value as Value.
+func (c Complex64) ValueOrNil() interface{} {
+	if !c.Valid {
+		return nil
+	}
+	return strconv.FormatComplex(complex128(c.Complex64), 'g', -1, 64)
+}
+
+// Randomize for sqlboiler
+func (c *Complex64) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		c.Complex64 = 0
+		c.Valid = false
+	} else {
+		c.Complex64 = complex(float32(nextInt()%10), float32(nextInt()%10)-5)
+		c.Valid = true
+	}
+}
@@ -0,0 +1,219 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	complex64Value = complex64(complex(1.5, -2.25))
+	complex64JSON  = []byte(`[1.5,-2.25]`)
+	complex64Text  = "(1.5-2.25i)"
+)
+
+func TestComplex64From(t *testing.T) {
+	assertComplex64(t, Complex64From(complex64Value), "Complex64From()")
+
+	zero := Complex64From(0)
+	if !zero.Valid {
+		t.Error("Complex64From(0)", "is invalid, but should be valid")
+	}
+
+	v := complex64Value
+	assertComplex64(t, Complex64FromPtr(&v), "Complex64FromPtr()")
+	assertNullComplex64(t, Complex64FromPtr(nil), "Complex64FromPtr(nil)")
+}
+
+func TestUnmarshalComplex64(t *testing.T) {
+	var c Complex64
+	err := json.Unmarshal(complex64JSON, &c)
+	maybePanic(err)
+	assertComplex64(t, c, "array json")
+
+	var quoted Complex64
+	err = json.Unmarshal([]byte(`["1.5", "-2.25"]`), &quoted)
+	maybePanic(err)
+	assertComplex64(t, quoted, "array of strings json")
+
+	var null Complex64
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullComplex64(t, null, "null json")
+
+	for _, data := range []string{`[1]`, `[1,2,3]`, `[1,null]`, `[1,""]`, `[1,true]`, `{"re":1}`, `"(1+2i)"`, `1`} {
+		bad := Complex64From(complex64Value)
+		if err := json.Unmarshal([]byte(data), &bad); err == nil {
+			t.Errorf("%s: expected error", data)
+		}
+		assertNullComplex64(t, bad, data)
+	}
+
+	var nan Complex64
+	if err := nan.UnmarshalJSON([]byte(`[NaN,1]`)); err == nil {
+		t.Error("expected error for a NaN part")
+	}
+
+	var overflow Complex64
+	if err := json.Unmarshal([]byte(`[1e39,0]`), &overflow); err == nil {
+		t.Error("expected error for a part that overflows float32")
+	}
+}
+
+func TestMarshalComplex64(t *testing.T) {
+	data, err := json.Marshal(Complex64From(complex64Value))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(complex64JSON), "non-empty json marshal")
+
+	data, err = json.Marshal(Complex64From(complex(0, -1)))
+	maybePanic(err)
+	assertJSONEquals(t, data, "[0,-1]", "negative imaginary json marshal")
+
+	data, err = json.Marshal(NewComplex64(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestComplex64JSONRoundTrip(t *testing.T) {
+	for _, x := range []complex64{0, 1i, -1i, complex(-3, -4), complex(3e38, -1e-38), complex(0.1, 0.2)} {
+		data, err := json.Marshal(Complex64From(x))
+		maybePanic(err)
+		var out Complex64
+		err = json.Unmarshal(data, &out)
+		maybePanic(err)
+		if !out.Valid || out.Complex64 != x {
+			t.Errorf("bad round trip of %v through %s: %v", x, data, out)
+		}
+	}
+}
+
+func TestMarshalComplex64NonFinite(t *testing.T) {
+	for _, x := range []complex64{complex(float32(math.NaN()), 0), complex(0, float32(math.Inf(-1))), complex(float32(math.Inf(1)), 1)} {
+		v := Complex64From(x)
+		if _, err := json.Marshal(v); err == nil {
+			t.Errorf("%v: expected error", x)
+		}
+		data, err := v.MarshalJSONWith(MarshalOptions{NonFiniteAsNull: true})
+		maybePanic(err)
+		assertJSONEquals(t, data, "null", "non-finite with NonFiniteAsNull")
+	}
+}
+
+func TestComplex64Text(t *testing.T) {
+	data, err := Complex64From(complex64Value).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, complex64Text, "non-empty text marshal")
+
+	data, err = NewComplex64(0, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var c Complex64
+	err = c.UnmarshalText([]byte(complex64Text))
+	maybePanic(err)
+	assertComplex64(t, c, "UnmarshalText()")
+
+	var blank Complex64
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullComplex64(t, blank, "UnmarshalText() empty")
+}
+
+func TestComplex64YAMLMsgpack(t *testing.T) {
+	data, err := yaml.Marshal(Complex64From(complex64Value))
+	maybePanic(err)
+	var y Complex64
+	err = yaml.Unmarshal(data, &y)
+	maybePanic(err)
+	assertComplex64(t, y, "yaml round trip")
+
+	data, err = msgpack.Marshal(Complex64From(complex64Value))
+	maybePanic(err)
+	var m Complex64
+	err = msgpack.Unmarshal(data, &m)
+	maybePanic(err)
+	assertComplex64(t, m, "msgpack round trip")
+
+	data, err = msgpack.Marshal(NewComplex64(0, false))
+	maybePanic(err)
+	m = Complex64From(complex64Value)
+	err = msgpack.Unmarshal(data, &m)
+	maybePanic(err)
+	assertNullComplex64(t, m, "msgpack null round trip")
+}
+
+func TestComplex64ScanValue(t *testing.T) {
+	var c Complex64
+	err := c.Scan(complex64Text)
+	maybePanic(err)
+	assertComplex64(t, c, "scanned string")
+	if v, err := c.Value(); v != complex64Text || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var b Complex64
+	err = b.Scan([]byte("-1-1i"))
+	maybePanic(err)
+	if !b.Valid || b.Complex64 != complex(-1, -1) {
+		t.Errorf("bad scanned []byte: %v", b)
+	}
+
+	var f Complex64
+	err = f.Scan(1.5)
+	maybePanic(err)
+	if !f.Valid || f.Complex64 != 1.5 {
+		t.Errorf("bad scanned float64: %v", f)
+	}
+
+	var null Complex64
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullComplex64(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	wrong := Complex64From(complex64Value)
+	if err := wrong.Scan("hello"); err == nil {
+		t.Error("expected error")
+	}
+	assertNullComplex64(t, wrong, "scanned wrong")
+}
+
+func TestComplex64String(t *testing.T) {
+	if s := Complex64From(complex64Value).String(); s != complex64Text {
+		t.Errorf("bad String(): %q", s)
+	}
+	if s := fmt.Sprint(NewComplex64(0, false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestComplex64ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Complex64From(complex64Value), "valid")
+	assertValueOrNil(t, NewComplex64(0, false), "null")
+}
+
+func TestComplex64MustValue(t *testing.T) {
+	v := Complex64From(complex64Value)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewComplex64(0, false).MustValue() }, "Complex64")
+}
+
+func assertComplex64(t *testing.T, c Complex64, from string) {
+	if c.Complex64 != complex64Value {
+		t.Errorf("bad %s complex64: %v ≠ %v\n", from, c.Complex64, complex64Value)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullComplex64(t *testing.T, c Complex64, from string) {
+	if c.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
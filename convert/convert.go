@@ -10,10 +10,12 @@ package convert
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -178,8 +180,7 @@ func ConvertAssign(dest, src interface{}) error {
 		s := asString(src)
 		i64, err := strconv.ParseInt(s, 10, dv.Type().Bits())
 		if err != nil {
-			err = strconvErr(err)
-			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %v", src, s, dv.Kind(), err)
+			return newNumError(src, s, dv.Kind(), err)
 		}
 		dv.SetInt(i64)
 		return nil
@@ -187,8 +188,11 @@ func ConvertAssign(dest, src interface{}) error {
 		s := asString(src)
 		u64, err := strconv.ParseUint(s, 10, dv.Type().Bits())
 		if err != nil {
-			err = strconvErr(err)
-			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %v", src, s, dv.Kind(), err)
+			if n, ierr := strconv.ParseInt(s, 10, 64); n < 0 || errors.Is(ierr, strconv.ErrRange) && strings.HasPrefix(s, "-") {
+				// a negative integer is out of range, not malformed
+				err = &strconv.NumError{Func: "ParseUint", Num: s, Err: strconv.ErrRange}
+			}
+			return newNumError(src, s, dv.Kind(), err)
 		}
 		dv.SetUint(u64)
 		return nil
@@ -196,8 +200,7 @@ func ConvertAssign(dest, src interface{}) error {
 		s := asString(src)
 		f64, err := strconv.ParseFloat(s, dv.Type().Bits())
 		if err != nil {
-			err = strconvErr(err)
-			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %v", src, s, dv.Kind(), err)
+			return newNumError(src, s, dv.Kind(), err)
 		}
 		dv.SetFloat(f64)
 		return nil
@@ -206,6 +209,25 @@ func ConvertAssign(dest, src interface{}) error {
 	return fmt.Errorf("unsupported Scan, storing driver.Value type %T into type %T", src, dest)
 }
 
+// numError is the error for a failed numeric conversion. Its message is the
+// same as database/sql's, while Unwrap returns the *strconv.NumError, so that
+// callers can tell a value out of range from a malformed one.
+type numError struct {
+	msg string
+	err error
+}
+
+func (e *numError) Error() string { return e.msg }
+
+func (e *numError) Unwrap() error { return e.err }
+
+func newNumError(src interface{}, s string, kind reflect.Kind, err error) error {
+	return &numError{
+		msg: fmt.Sprintf("converting driver.Value type %T (%q) to a %s: %v", src, s, kind, strconvErr(err)),
+		err: err,
+	}
+}
+
 func strconvErr(err error) error {
 	if ne, ok := err.(*strconv.NumError); ok {
 		return ne.Err
@@ -229,6 +251,8 @@ func asString(src interface{}) string {
 		return v
 	case []byte:
 		return string(v)
+	case json.Number:
+		return string(v)
 	}
 	rv := reflect.ValueOf(src)
 	switch rv.Kind() {
@@ -9,6 +9,7 @@ package convert
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"runtime"
@@ -118,6 +119,9 @@ var conversionTests = []conversionTest{
 	{s: "256", d: &scanuint8, wanterr: "converting driver.Value type string (\"256\") to a uint8: value out of range"},
 	{s: "256", d: &scanuint16, wantuint: 256},
 	{s: "-1", d: &scanint, wantint: -1},
+	{s: "-1", d: &scanuint8, wanterr: "converting driver.Value type string (\"-1\") to a uint8: value out of range"},
+	{s: "-99999999999999999999", d: &scanuint8, wanterr: "converting driver.Value type string (\"-99999999999999999999\") to a uint8: value out of range"},
+	{s: "-0", d: &scanuint8, wanterr: "converting driver.Value type string (\"-0\") to a uint8: invalid syntax"},
 	{s: "foo", d: &scanint, wanterr: "converting driver.Value type string (\"foo\") to a int: invalid syntax"},
 
 	// int64 to smaller integers
@@ -126,6 +130,16 @@ var conversionTests = []conversionTest{
 	{s: int64(256), d: &scanuint16, wantuint: 256},
 	{s: int64(65536), d: &scanuint16, wanterr: "converting driver.Value type int64 (\"65536\") to a uint16: value out of range"},
 
+	// json.Number, from a json.Decoder with UseNumber
+	{s: json.Number("42"), d: &scanint, wantint: 42},
+	{s: json.Number("-128"), d: &scanint8, wantint: -128},
+	{s: json.Number("128"), d: &scanint8, wanterr: "converting driver.Value type json.Number (\"128\") to a int8: value out of range"},
+	{s: json.Number("1.5"), d: &scanint, wanterr: "converting driver.Value type json.Number (\"1.5\") to a int: invalid syntax"},
+	{s: json.Number("65535"), d: &scanuint16, wantuint: 65535},
+	{s: json.Number("-1"), d: &scanuint16, wanterr: "converting driver.Value type json.Number (\"-1\") to a uint16: value out of range"},
+	{s: json.Number("1.5e3"), d: &scanf64, wantf64: 1500},
+	{s: json.Number("42"), d: &scanstr, wantstr: "42"},
+
 	// True bools
 	{s: true, d: &scanbool, wantbool: true},
 	{s: "True", d: &scanbool, wantbool: true},
@@ -0,0 +1,69 @@
+package null
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// CSVRecord returns the exported fields of the struct v, or of the struct v
+// points to, as a record for encoding/csv. Fields tagged `csv:"-"` are left
+// out.
+//
+// Fields with a MarshalText method use it. For the types in this package
+// that means a null is an empty cell, and UnmarshalText reads an empty cell
+// back as null. Nulls of the types without MarshalText, such as Null[T], are
+// empty too, and valid values use their String method. A nil pointer field is
+// an empty cell, and a non-nil one is formatted as the value it points to.
+// Any other field is formatted with fmt.Sprint.
+func CSVRecord(v interface{}) ([]string, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("null: cannot make a CSV record from a nil %T", v)
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: cannot make a CSV record from %T, it is not a struct", v)
+	}
+
+	rt := rv.Type()
+	record := make([]string, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" || field.Tag.Get("csv") == "-" {
+			continue
+		}
+		cell, err := csvCell(rv.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("null: cannot make a CSV cell from field %s: %w", field.Name, err)
+		}
+		record = append(record, cell)
+	}
+	return record, nil
+}
+
+// csvCell formats a single field for CSVRecord.
+func csvCell(f reflect.Value) (string, error) {
+	if f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return "", nil
+		}
+		f = f.Elem()
+	}
+	x := f.Interface()
+	if m, ok := x.(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err
+	}
+	if f.Kind() == reflect.Struct {
+		if valid := f.FieldByName("Valid"); valid.Kind() == reflect.Bool && !valid.Bool() {
+			return "", nil
+		}
+	}
+	if s, ok := x.(fmt.Stringer); ok {
+		return s.String(), nil
+	}
+	return fmt.Sprint(x), nil
+}
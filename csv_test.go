@@ -0,0 +1,173 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type csvRecord struct {
+	Bool     Bool
+	Byte     Byte
+	Date     Date
+	Decimal  Decimal
+	Duration Duration
+	Float32  Float32
+	Float64  Float64
+	Int      Int
+	Int8     Int8
+	Int16    Int16
+	Int32    Int32
+	Int64    Int64
+	IP       IP
+	Rune     Rune
+	String   String
+	Time     Time
+	UUID     UUID
+	Uint     Uint
+	Uint8    Uint8
+	Uint16   Uint16
+	Uint32   Uint32
+	Uint64   Uint64
+	Skipped  String `csv:"-"`
+	private  String
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	valid := csvRecord{
+		Bool:     BoolFrom(true),
+		Byte:     ByteFrom('b'),
+		Date:     DateFrom(dateValue),
+		Decimal:  DecimalFrom(decimalValue),
+		Duration: DurationFrom(durationValue),
+		Float32:  Float32From(1.5),
+		Float64:  Float64From(-2.25),
+		Int:      IntFrom(-1),
+		Int8:     Int8From(8),
+		Int16:    Int16From(16),
+		Int32:    Int32From(32),
+		Int64:    Int64From(64),
+		IP:       IPFrom(ipValue),
+		Rune:     RuneFrom(runeValue),
+		String:   StringFrom("a, \"quoted\"\nstring"),
+		Time:     TimeFrom(timeValue),
+		UUID:     UUIDFrom(uuidValue),
+		Uint:     UintFrom(1),
+		Uint8:    Uint8From(8),
+		Uint16:   Uint16From(16),
+		Uint32:   Uint32From(32),
+		Uint64:   Uint64From(64),
+	}
+	var null csvRecord
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, in := range []csvRecord{valid, null} {
+		record, err := CSVRecord(&in)
+		maybePanic(err)
+		maybePanic(w.Write(record))
+	}
+	w.Flush()
+	maybePanic(w.Error())
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	maybePanic(err)
+	if len(records) != 2 {
+		t.Fatalf("bad record count: %d", len(records))
+	}
+	for _, cell := range records[1] {
+		if cell != "" {
+			t.Errorf("null should be an empty cell, got %q", cell)
+		}
+	}
+
+	for i, want := range []csvRecord{valid, null} {
+		got := readCSVRecord(t, records[i])
+		wv, gv := reflect.ValueOf(want), reflect.ValueOf(got)
+		for j := 0; j < wv.NumField(); j++ {
+			name := wv.Type().Field(j).Name
+			if name == "Skipped" || name == "private" {
+				continue
+			}
+			equal := gv.Field(j).MethodByName("Equal").Call([]reflect.Value{wv.Field(j)})[0].Bool()
+			if !equal {
+				t.Errorf("bad %s round trip: %v ≠ %v", name, gv.Field(j), wv.Field(j))
+			}
+		}
+	}
+}
+
+// readCSVRecord decodes a record written by CSVRecord with UnmarshalText.
+func readCSVRecord(t *testing.T, record []string) csvRecord {
+	var out csvRecord
+	v := reflect.ValueOf(&out).Elem()
+	n := 0
+	for i := 0; i < v.NumField(); i++ {
+		if name := v.Type().Field(i).Name; name == "Skipped" || name == "private" {
+			continue
+		}
+		u := v.Field(i).Addr().Interface().(encoding.TextUnmarshaler)
+		if err := u.UnmarshalText([]byte(record[n])); err != nil {
+			t.Errorf("bad %s cell %q: %v", v.Type().Field(i).Name, record[n], err)
+		}
+		n++
+	}
+	if n != len(record) {
+		t.Errorf("bad record length: %d ≠ %d", len(record), n)
+	}
+	return out
+}
+
+func TestCSVRecord(t *testing.T) {
+	type mixed struct {
+		Name  string
+		Count int
+		Tags  StringSlice
+		Score Null[float64]
+		Seen  Null[time.Duration]
+	}
+
+	record, err := CSVRecord(mixed{
+		Name:  "a",
+		Count: 2,
+		Tags:  StringSliceFrom([]string{"x"}),
+		Score: NullFrom(1.5),
+		Seen:  NewNull(time.Second, false),
+	})
+	maybePanic(err)
+	want := []string{"a", "2", `["x"]`, "1.5", ""}
+	if !reflect.DeepEqual(record, want) {
+		t.Errorf("bad CSVRecord(): %q ≠ %q", record, want)
+	}
+
+	if _, err := CSVRecord(5); err == nil {
+		t.Error("expected error for a non-struct")
+	}
+	if _, err := CSVRecord((*mixed)(nil)); err == nil {
+		t.Error("expected error for a nil pointer")
+	}
+}
+
+func TestCSVRecordPointers(t *testing.T) {
+	type pointers struct {
+		Name  *String
+		Count *int
+		Score *Float64
+	}
+
+	record, err := CSVRecord(pointers{})
+	maybePanic(err)
+	if want := []string{"", "", ""}; !reflect.DeepEqual(record, want) {
+		t.Errorf("bad CSVRecord() of nil pointers: %q ≠ %q", record, want)
+	}
+
+	name, count, null := StringFrom("a"), 2, NewFloat64(0, false)
+	record, err = CSVRecord(pointers{Name: &name, Count: &count, Score: &null})
+	maybePanic(err)
+	if want := []string{"a", "2", ""}; !reflect.DeepEqual(record, want) {
+		t.Errorf("bad CSVRecord() of pointers: %q ≠ %q", record, want)
+	}
+}
@@ -0,0 +1,260 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/randomize"
+)
+
+// Date is a nullable calendar date, for SQL DATE columns. It marshals to and
+// from text and JSON as "2006-01-02". The time of day and zone are discarded:
+// the date is kept as midnight UTC of the calendar date in the original zone.
+type Date struct {
+	Date  time.Time
+	Valid bool
+}
+
+// NewDate creates a new Date from the calendar date of t in t's location.
+func NewDate(t time.Time, valid bool) Date {
+	return Date{
+		Date:  truncateDate(t),
+		Valid: valid,
+	}
+}
+
+// DateFrom creates a new Date that will always be valid.
+func DateFrom(t time.Time) Date {
+	return NewDate(t, true)
+}
+
+// DateFromPtr creates a new Date that will be null if t is nil.
+func DateFromPtr(t *time.Time) Date {
+	if t == nil {
+		return NewDate(time.Time{}, false)
+	}
+	return NewDate(*t, true)
+}
+
+// ParseDate parses a date such as "2020-01-31" into a valid Date, as UnmarshalText
+// does, but returns an error rather than a null Date for empty text.
+func ParseDate(s string) (Date, error) {
+	return parseText[Date]("Date", s)
+}
+
+// MustDate is like ParseDate, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustDate(s string) Date {
+	v, err := ParseDate(s)
+	return mustParse("MustDate", s, v, err)
+}
+
+// truncateDate returns midnight UTC of the calendar date of t in t's location.
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a JSON string such as "2006-01-02". A blank string will be null.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		d.Date = time.Time{}
+		d.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		d.Date, d.Valid = time.Time{}, false
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Date", data)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It expects text such as "2006-01-02". Blank text will be null.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		d.Date = time.Time{}
+		d.Valid = false
+		return nil
+	}
+
+	var err error
+	d.Date, err = time.Parse(time.DateOnly, string(text))
+	d.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return NullBytes, nil
+	}
+	return []byte(`"` + d.Date.Format(time.DateOnly) + `"`), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Date as chosen by opts.
+func (d Date) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(d)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Date.Format(time.DateOnly)), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Date is null.
+func (d Date) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, d, d.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// SetValid changes this Date's value to the calendar date of t and also sets it to be non-null.
+func (d *Date) SetValid(t time.Time) {
+	d.Date = truncateDate(t)
+	d.Valid = true
+}
+
+// SetNull sets this Date to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (d *Date) SetNull() {
+	d.Date = time.Time{}
+	d.Valid = false
+}
+
+// Ptr returns a pointer to this Date's value, or a nil pointer if this Date is null.
+func (d Date) Ptr() *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Date
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Date) ValueOrZero() time.Time {
+	if !d.Valid {
+		return time.Time{}
+	}
+	return d.Date
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (d Date) ValueOr(def time.Time) time.Time {
+	if !d.Valid {
+		return def
+	}
+	return d.Date
+}
+
+// Or returns this Date if it is valid, otherwise other.
+func (d Date) Or(other Date) Date {
+	if !d.Valid {
+		return other
+	}
+	return d
+}
+
+// MustValue returns the inner value, and panics if this Date is null.
+func (d Date) MustValue() time.Time {
+	if !d.Valid {
+		panic("null.Date: MustValue called on invalid value")
+	}
+	return d.Date
+}
+
+// IsZero returns true if this Date is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (d Date) IsZero() bool {
+	return !d.Valid
+}
+
+// IsNull returns true if this Date is null, the same as !Valid.
+func (d Date) IsNull() bool {
+	return !d.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Date, or nil if it is null, for
+// a *Date field tagged omitempty that should leave nulls out.
+func (d Date) OmitEmpty() *Date {
+	if !d.Valid {
+		return nil
+	}
+	return &d
+}
+
+// Equal returns true if both Dates are null or both hold the same calendar date.
+func (d Date) Equal(other Date) bool {
+	return d.Valid == other.Valid && (!d.Valid || truncateDate(d.Date).Equal(truncateDate(other.Date)))
+}
+
+// String implements fmt.Stringer.
+// It returns the date as "2006-01-02", or NullDisplay if this Date is null.
+func (d Date) String() string {
+	if !d.Valid {
+		return NullDisplay
+	}
+	return d.Date.Format(time.DateOnly)
+}
+
+// Scan implements the Scanner interface.
+// It accepts a time.Time, keeping only its calendar date in its own location
+// and storing it as midnight UTC, as for drivers that scan DATE columns as
+// full timestamps. It also accepts a string or []byte in any of the layouts
+// accepted by Time.Scan.
+func (d *Date) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	if value == nil {
+		d.Date, d.Valid = time.Time{}, false
+		return nil
+	}
+	t, err := scanTime(value)
+	if err != nil {
+		d.Date, d.Valid = time.Time{}, false
+		return scanError("Date", value, nil, err)
+	}
+	d.Date, d.Valid = truncateDate(t), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the date as a time.Time at midnight UTC.
+func (d Date) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return truncateDate(d.Date), nil
+}
+
+// ValueOrNil returns nil if this Date is null, otherwise the same value as Value.
+func (d Date) ValueOrNil() interface{} {
+	if !d.Valid {
+		return nil
+	}
+	return truncateDate(d.Date)
+}
+
+// Randomize for sqlboiler
+func (d *Date) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		d.Date = time.Time{}
+		d.Valid = false
+	} else {
+		d.Date = truncateDate(randomize.Date(nextInt))
+		d.Valid = true
+	}
+}
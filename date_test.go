@@ -0,0 +1,236 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	dateString = "2012-12-21"
+	dateJSON   = []byte(`"` + dateString + `"`)
+	dateValue  = time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)
+)
+
+func TestDateFrom(t *testing.T) {
+	d := DateFrom(timeValue)
+	assertDate(t, d, "DateFrom()")
+
+	ptr := DateFromPtr(&timeValue)
+	assertDate(t, ptr, "DateFromPtr()")
+
+	null := DateFromPtr(nil)
+	assertNullDate(t, null, "DateFromPtr(nil)")
+}
+
+func TestDateFromZones(t *testing.T) {
+	// the calendar date is taken in the time's own zone, not UTC
+	western := time.Date(2012, 12, 21, 23, 30, 0, 0, time.FixedZone("UTC-10", -10*60*60))
+	assertDate(t, DateFrom(western), "late in a western zone")
+
+	eastern := time.Date(2012, 12, 21, 0, 30, 0, 0, time.FixedZone("UTC+14", 14*60*60))
+	assertDate(t, DateFrom(eastern), "early in an eastern zone")
+}
+
+func TestDateDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available:", err)
+	}
+
+	tests := []struct {
+		in   time.Time
+		want string
+	}{
+		// the day before, during and after the spring forward transition
+		{in: time.Date(2021, 3, 13, 23, 30, 0, 0, loc), want: "2021-03-13"},
+		{in: time.Date(2021, 3, 14, 0, 30, 0, 0, loc), want: "2021-03-14"},
+		{in: time.Date(2021, 3, 14, 3, 30, 0, 0, loc), want: "2021-03-14"},
+		{in: time.Date(2021, 3, 14, 23, 59, 59, 0, loc), want: "2021-03-14"},
+		// around the fall back transition, when 1:30 happens twice
+		{in: time.Date(2021, 11, 7, 0, 30, 0, 0, loc), want: "2021-11-07"},
+		{in: time.Date(2021, 11, 7, 1, 30, 0, 0, loc).Add(time.Hour), want: "2021-11-07"},
+		{in: time.Date(2021, 11, 7, 23, 30, 0, 0, loc), want: "2021-11-07"},
+	}
+
+	for _, test := range tests {
+		d := DateFrom(test.in)
+		if s := d.String(); s != test.want {
+			t.Errorf("%v: bad date: %s ≠ %s", test.in, s, test.want)
+		}
+
+		var scanned Date
+		err := scanned.Scan(test.in)
+		maybePanic(err)
+		if !scanned.Equal(d) {
+			t.Errorf("%v: bad scanned date: %v ≠ %v", test.in, scanned, d)
+		}
+
+		v, err := d.Value()
+		maybePanic(err)
+		if vt := v.(time.Time); vt.Location() != time.UTC || vt.Format(time.DateOnly) != test.want {
+			t.Errorf("%v: bad value: %v", test.in, vt)
+		}
+	}
+}
+
+func TestUnmarshalDate(t *testing.T) {
+	var d Date
+	err := json.Unmarshal(dateJSON, &d)
+	maybePanic(err)
+	assertDate(t, d, "date json")
+
+	var null Date
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDate(t, null, "null json")
+
+	var blank Date
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullDate(t, blank, "blank json string")
+
+	var withTime Date
+	err = json.Unmarshal(timeJSON, &withTime)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullDate(t, withTime, "timestamp json")
+
+	var badType Date
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullDate(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalDate(t *testing.T) {
+	var d Date
+	err := d.UnmarshalText([]byte(dateString))
+	maybePanic(err)
+	assertDate(t, d, "UnmarshalText() date")
+
+	var blank Date
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDate(t, blank, "UnmarshalText() empty date")
+}
+
+func TestMarshalDate(t *testing.T) {
+	d := DateFrom(timeValue)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(dateJSON), "non-empty json marshal")
+
+	data, err = d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, dateString, "non-empty text marshal")
+
+	null := NewDate(time.Time{}, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDateEqual(t *testing.T) {
+	if !NewDate(time.Time{}, false).Equal(NewDate(timeValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if DateFrom(timeValue).Equal(NewDate(time.Time{}, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	if !DateFrom(timeValue).Equal(DateFrom(timeValue.Add(time.Hour))) {
+		t.Error("Equal() should be true for times on the same date")
+	}
+	if !DateFrom(timeValue).Equal(Date{Date: timeValue, Valid: true}) {
+		t.Error("Equal() should ignore the time of day of an unnormalized Date")
+	}
+	if DateFrom(timeValue).Equal(DateFrom(timeValue.AddDate(0, 0, 1))) {
+		t.Error("Equal() should be false for different dates")
+	}
+}
+
+func TestDateScanValue(t *testing.T) {
+	var d Date
+	err := d.Scan(timeValue)
+	maybePanic(err)
+	assertDate(t, d, "scanned time")
+	if v, err := d.Value(); v != dateValue || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var s Date
+	err = s.Scan(dateString)
+	maybePanic(err)
+	assertDate(t, s, "scanned string")
+
+	var b Date
+	err = b.Scan([]byte("2012-12-21 21:21:21"))
+	maybePanic(err)
+	assertDate(t, b, "scanned []byte datetime")
+
+	var null Date
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDate(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Date
+	err = wrong.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDate(t, wrong, "scanned wrong")
+}
+
+func TestDateScanTruncatesTime(t *testing.T) {
+	pst := time.FixedZone("PST", -8*60*60)
+	full := time.Date(2012, 12, 21, 23, 59, 59, 999999999, pst)
+
+	var d Date
+	err := d.Scan(full)
+	maybePanic(err)
+	want := time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)
+	if !d.Valid || !d.Date.Equal(want) || d.Date.Location() != time.UTC {
+		t.Errorf("bad scanned timestamp: %v, want %v", d.Date, want)
+	}
+	if v, err := d.Value(); v != want || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+}
+
+func TestDateMustValue(t *testing.T) {
+	v := DateFrom(dateValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewDate(time.Time{}, false).MustValue() }, "Date")
+}
+
+func TestDateSetNull(t *testing.T) {
+	change := DateFrom(dateValue)
+	change.SetNull()
+	assertNullDate(t, change, "SetNull()")
+	if !change.Date.IsZero() {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Date)
+	}
+}
+
+func assertDate(t *testing.T, d Date, from string) {
+	if d.Date != dateValue {
+		t.Errorf("bad %v date: %v ≠ %v\n", from, d.Date, dateValue)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDate(t *testing.T, d Date, from string) {
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,367 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Decimal is a nullable decimal.Decimal. It should be used for exact values
+// such as money, stored in numeric or decimal columns.
+type Decimal struct {
+	Decimal decimal.Decimal
+	Valid   bool
+}
+
+// NewDecimal creates a new Decimal
+func NewDecimal(d decimal.Decimal, valid bool) Decimal {
+	return Decimal{
+		Decimal: d,
+		Valid:   valid,
+	}
+}
+
+// DecimalFrom creates a new Decimal that will always be valid.
+func DecimalFrom(d decimal.Decimal) Decimal {
+	return NewDecimal(d, true)
+}
+
+// DecimalFromPtr creates a new Decimal that will be null if d is nil.
+func DecimalFromPtr(d *decimal.Decimal) Decimal {
+	if d == nil {
+		return NewDecimal(decimal.Zero, false)
+	}
+	return NewDecimal(*d, true)
+}
+
+// ParseDecimal parses a decimal number such as "12.34" into a valid Decimal, as UnmarshalText
+// does, but returns an error rather than a null Decimal for empty text.
+func ParseDecimal(s string) (Decimal, error) {
+	return parseText[Decimal]("Decimal", s)
+}
+
+// MustDecimal is like ParseDecimal, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustDecimal(s string) Decimal {
+	v, err := ParseDecimal(s)
+	return mustParse("MustDecimal", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts both a bare number and a quoted string, an empty string will be null.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) || bytes.Equal(data, []byte(`""`)) {
+		d.Decimal = decimal.Zero
+		d.Valid = false
+		return nil
+	}
+
+	err := checkBigExponent(strings.Trim(string(data), `"`), "Decimal")
+	if err == nil {
+		err = d.Decimal.UnmarshalJSON(data)
+	}
+	if err != nil {
+		d.Decimal = decimal.Zero
+		d.Valid = false
+		return err
+	}
+
+	d.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		d.Valid = false
+		return nil
+	}
+
+	err := checkBigExponent(string(text), "Decimal")
+	if err == nil {
+		err = d.Decimal.UnmarshalText(text)
+	}
+	if err != nil {
+		d.Decimal = decimal.Zero
+		d.Valid = false
+		return err
+	}
+
+	d.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// Valid values are encoded as a bare number to avoid losing precision.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return NullBytes, nil
+	}
+	return []byte(d.Decimal.String()), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Decimal as chosen by opts.
+func (d Decimal) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(d)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Decimal.String()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Decimal is null.
+func (d Decimal) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, d, d.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (d *Decimal) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Decimal is null.
+func (d Decimal) MarshalYAML() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Decimal.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (d *Decimal) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return d.UnmarshalText(nil)
+	}
+	return d.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Decimal is null.
+func (d Decimal) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !d.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(d.Decimal.String())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (d *Decimal) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return d.UnmarshalText(nil)
+	}
+	return d.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Decimal is encoded as a single byte.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	if !d.Valid {
+		return []byte{encodedNull}, nil
+	}
+	b, err := d.Decimal.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, b), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Decimal")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		d.Decimal = decimal.Zero
+		d.Valid = false
+		return nil
+	}
+	b, err := decodeLengthPrefixed(value, "Decimal")
+	if err != nil {
+		return err
+	}
+	if err := d.Decimal.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (d Decimal) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (d *Decimal) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// SetValid changes this Decimal's value and also sets it to be non-null.
+func (d *Decimal) SetValid(n decimal.Decimal) {
+	d.Decimal = n
+	d.Valid = true
+}
+
+// SetNull sets this Decimal to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (d *Decimal) SetNull() {
+	d.Decimal = decimal.Zero
+	d.Valid = false
+}
+
+// Ptr returns a pointer to this Decimal's value, or a nil pointer if this Decimal is null.
+func (d Decimal) Ptr() *decimal.Decimal {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Decimal
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Decimal) ValueOrZero() decimal.Decimal {
+	if !d.Valid {
+		return decimal.Zero
+	}
+	return d.Decimal
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (d Decimal) ValueOr(def decimal.Decimal) decimal.Decimal {
+	if !d.Valid {
+		return def
+	}
+	return d.Decimal
+}
+
+// Or returns this Decimal if it is valid, otherwise other.
+func (d Decimal) Or(other Decimal) Decimal {
+	if !d.Valid {
+		return other
+	}
+	return d
+}
+
+// MustValue returns the inner value, and panics if this Decimal is null.
+func (d Decimal) MustValue() decimal.Decimal {
+	if !d.Valid {
+		panic("null.Decimal: MustValue called on invalid value")
+	}
+	return d.Decimal
+}
+
+// IsZero returns true if this Decimal is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (d Decimal) IsZero() bool {
+	return !d.Valid
+}
+
+// IsNull returns true if this Decimal is null, the same as !Valid.
+func (d Decimal) IsNull() bool {
+	return !d.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Decimal, or nil if it is null, for
+// a *Decimal field tagged omitempty that should leave nulls out.
+func (d Decimal) OmitEmpty() *Decimal {
+	if !d.Valid {
+		return nil
+	}
+	return &d
+}
+
+// Equal returns true if both Decimals are null or both hold the same value.
+// Values are compared numerically, so 1.5 and 1.50 are equal.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Valid == other.Valid && (!d.Valid || d.Decimal.Equal(other.Decimal))
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Decimal is null.
+func (d Decimal) String() string {
+	if !d.Valid {
+		return NullDisplay
+	}
+	return d.Decimal.String()
+}
+
+// Scan implements the Scanner interface.
+// It accepts the values decimal.Decimal.Scan does, and a json.Number.
+func (d *Decimal) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	if value == nil {
+		d.Decimal, d.Valid = decimal.Zero, false
+		return nil
+	}
+
+	src := value
+	var err error
+	switch v := value.(type) {
+	case json.Number:
+		src = string(v)
+		err = checkBigExponent(string(v), "Decimal")
+	case string:
+		err = checkBigExponent(v, "Decimal")
+	case []byte:
+		err = checkBigExponent(string(v), "Decimal")
+	}
+	if err == nil {
+		err = d.Decimal.Scan(src)
+	}
+	if err != nil {
+		d.Decimal, d.Valid = decimal.Zero, false
+		return scanError("Decimal", value, nil, err)
+	}
+
+	d.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (d Decimal) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Decimal.String(), nil
+}
+
+// ValueOrNil returns nil if this Decimal is null, otherwise the same value as Value.
+func (d Decimal) ValueOrNil() interface{} {
+	if !d.Valid {
+		return nil
+	}
+	return d.Decimal.String()
+}
+
+// Randomize for sqlboiler
+func (d *Decimal) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		d.Decimal = decimal.Zero
+		d.Valid = false
+	} else {
+		d.Decimal = decimal.New(nextInt()%10000, -2)
+		d.Valid = true
+	}
+}
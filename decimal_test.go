@@ -0,0 +1,284 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	decimalString     = "12.34"
+	decimalJSON       = []byte(decimalString)
+	decimalStringJSON = []byte(`"` + decimalString + `"`)
+	decimalValue      = decimal.RequireFromString(decimalString)
+)
+
+func TestDecimalFrom(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	assertDecimal(t, d, "DecimalFrom()")
+
+	zero := DecimalFrom(decimal.Zero)
+	if !zero.Valid {
+		t.Error("DecimalFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestDecimalFromPtr(t *testing.T) {
+	v := decimalValue
+	d := DecimalFromPtr(&v)
+	assertDecimal(t, d, "DecimalFromPtr()")
+
+	null := DecimalFromPtr(nil)
+	assertNullDecimal(t, null, "DecimalFromPtr(nil)")
+}
+
+func TestUnmarshalDecimal(t *testing.T) {
+	var d Decimal
+	err := json.Unmarshal(decimalJSON, &d)
+	maybePanic(err)
+	assertDecimal(t, d, "decimal json")
+
+	var sd Decimal
+	err = json.Unmarshal(decimalStringJSON, &sd)
+	maybePanic(err)
+	assertDecimal(t, sd, "decimal string json")
+
+	var null Decimal
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDecimal(t, null, "null json")
+
+	var blank Decimal
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullDecimal(t, blank, "blank json string")
+
+	var badType Decimal
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullDecimal(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalDecimal(t *testing.T) {
+	var d Decimal
+	err := d.UnmarshalText([]byte(decimalString))
+	maybePanic(err)
+	assertDecimal(t, d, "UnmarshalText() decimal")
+
+	var blank Decimal
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDecimal(t, blank, "UnmarshalText() empty decimal")
+}
+
+func TestMarshalDecimal(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, decimalString, "non-empty json marshal")
+
+	// invalid values should be encoded as null
+	null := NewDecimal(decimal.Zero, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalDecimalText(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	data, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, decimalString, "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewDecimal(decimal.Zero, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDecimalPointer(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	ptr := d.Ptr()
+	if !ptr.Equal(decimalValue) {
+		t.Errorf("bad %s decimal: %#v ≠ %s\n", "pointer", ptr, decimalValue)
+	}
+
+	null := NewDecimal(decimal.Zero, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s decimal: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestDecimalIsZero(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	if d.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewDecimal(decimal.Zero, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewDecimal(decimal.Zero, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestDecimalSetValid(t *testing.T) {
+	change := NewDecimal(decimal.Zero, false)
+	assertNullDecimal(t, change, "SetValid()")
+	change.SetValid(decimalValue)
+	assertDecimal(t, change, "SetValid()")
+}
+
+func TestDecimalSetNull(t *testing.T) {
+	change := DecimalFrom(decimalValue)
+	change.SetNull()
+	assertNullDecimal(t, change, "SetNull()")
+	if !change.Decimal.Equal(decimal.Zero) {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Decimal)
+	}
+}
+
+func TestDecimalEqual(t *testing.T) {
+	if !NewDecimal(decimal.Zero, false).Equal(NewDecimal(decimalValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if DecimalFrom(decimalValue).Equal(NewDecimal(decimal.Zero, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	if !DecimalFrom(decimalValue).Equal(DecimalFrom(decimal.RequireFromString("12.340"))) {
+		t.Error("Equal() should be true for numerically equal values")
+	}
+	if DecimalFrom(decimalValue).Equal(DecimalFrom(decimal.RequireFromString("12.35"))) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestDecimalScanValue(t *testing.T) {
+	var d Decimal
+	err := d.Scan([]byte(decimalString))
+	maybePanic(err)
+	assertDecimal(t, d, "scanned []byte")
+
+	var s Decimal
+	err = s.Scan(decimalString)
+	maybePanic(err)
+	assertDecimal(t, s, "scanned string")
+
+	var f Decimal
+	err = f.Scan(12.34)
+	maybePanic(err)
+	assertDecimal(t, f, "scanned float64")
+
+	var null Decimal
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDecimal(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Decimal
+	err = wrong.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDecimal(t, wrong, "scanned wrong")
+}
+
+func TestDecimalPrecisionRoundTrip(t *testing.T) {
+	// more digits than a float64 can hold
+	precise := "12345678901234567890.123456789012345678"
+	d := DecimalFrom(decimal.RequireFromString(precise))
+
+	v, err := d.Value()
+	maybePanic(err)
+	if v != precise {
+		t.Errorf("bad value: %v ≠ %s", v, precise)
+	}
+
+	var scanned Decimal
+	err = scanned.Scan([]byte(v.(string)))
+	maybePanic(err)
+	if !scanned.Equal(d) || scanned.Decimal.String() != precise {
+		t.Errorf("bad round trip: %s ≠ %s", scanned.Decimal, precise)
+	}
+
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, precise, "precise json marshal")
+}
+
+func TestDecimalExponentLimit(t *testing.T) {
+	var ok Decimal
+	maybePanic(ok.UnmarshalText([]byte("1e1000")))
+	if !ok.Valid || !ok.Decimal.Equal(decimal.New(1, 1000)) {
+		t.Errorf("1e1000 should be within the limit, got %v", ok)
+	}
+
+	for _, s := range []string{"1e1001", "1e-1001", "1e1000000", `"1e1000000"`} {
+		d := DecimalFrom(decimalValue)
+		err := json.Unmarshal([]byte(s), &d)
+		if err == nil || !strings.Contains(err.Error(), "limited to ±1000 by BigExponentLimit") {
+			t.Errorf("%s: expected an exponent limit error, got %v", s, err)
+		}
+		assertNullDecimal(t, d, s)
+
+		unquoted := strings.Trim(s, `"`)
+		if err := d.UnmarshalText([]byte(unquoted)); err == nil {
+			t.Errorf("%s: expected an exponent limit error from UnmarshalText", s)
+		}
+		for _, v := range []interface{}{unquoted, []byte(unquoted), json.Number(unquoted)} {
+			if err := d.Scan(v); err == nil {
+				t.Errorf("%s: expected an exponent limit error from Scan(%T)", s, v)
+			}
+		}
+	}
+}
+
+func TestDecimalString(t *testing.T) {
+	v := DecimalFrom(decimalValue)
+	if s := v.String(); s != "12.34" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewDecimal(decimal.Zero, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestDecimalValueOrNil(t *testing.T) {
+	assertValueOrNil(t, DecimalFrom(decimalValue), "valid")
+	assertValueOrNil(t, NewDecimal(decimal.Zero, false), "null")
+}
+
+func TestDecimalMustValue(t *testing.T) {
+	v := DecimalFrom(decimalValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewDecimal(decimal.Zero, false).MustValue() }, "Decimal")
+}
+
+func assertDecimal(t *testing.T, d Decimal, from string) {
+	if !d.Decimal.Equal(decimalValue) {
+		t.Errorf("bad %s decimal: %s ≠ %s\n", from, d.Decimal, decimalValue)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDecimal(t *testing.T, d Decimal, from string) {
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
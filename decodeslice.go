@@ -0,0 +1,200 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// The UnmarshalXSlice functions decode a JSON array of nullable values, such
+// as [1,null,"2"], as json.Unmarshal would into a []X, but without going
+// through reflection for each element. Each element is decoded with the type's
+// own UnmarshalJSON, so the same inputs are accepted and null elements are
+// null. A JSON null gives a nil slice and an empty array an empty one. An error
+// names the index of the first element that failed to decode.
+
+// UnmarshalBoolSlice decodes a JSON array into a []Bool.
+func UnmarshalBoolSlice(data []byte) ([]Bool, error) {
+	return unmarshalSlice[Bool](data, "Bool")
+}
+
+// UnmarshalIntSlice decodes a JSON array into a []Int.
+func UnmarshalIntSlice(data []byte) ([]Int, error) {
+	return unmarshalSlice[Int](data, "Int")
+}
+
+// UnmarshalInt8Slice decodes a JSON array into a []Int8.
+func UnmarshalInt8Slice(data []byte) ([]Int8, error) {
+	return unmarshalSlice[Int8](data, "Int8")
+}
+
+// UnmarshalInt16Slice decodes a JSON array into a []Int16.
+func UnmarshalInt16Slice(data []byte) ([]Int16, error) {
+	return unmarshalSlice[Int16](data, "Int16")
+}
+
+// UnmarshalInt32Slice decodes a JSON array into a []Int32.
+func UnmarshalInt32Slice(data []byte) ([]Int32, error) {
+	return unmarshalSlice[Int32](data, "Int32")
+}
+
+// UnmarshalInt64Slice decodes a JSON array into a []Int64.
+func UnmarshalInt64Slice(data []byte) ([]Int64, error) {
+	return unmarshalSlice[Int64](data, "Int64")
+}
+
+// UnmarshalUintSlice decodes a JSON array into a []Uint.
+func UnmarshalUintSlice(data []byte) ([]Uint, error) {
+	return unmarshalSlice[Uint](data, "Uint")
+}
+
+// UnmarshalUint8Slice decodes a JSON array into a []Uint8.
+func UnmarshalUint8Slice(data []byte) ([]Uint8, error) {
+	return unmarshalSlice[Uint8](data, "Uint8")
+}
+
+// UnmarshalUint16Slice decodes a JSON array into a []Uint16.
+func UnmarshalUint16Slice(data []byte) ([]Uint16, error) {
+	return unmarshalSlice[Uint16](data, "Uint16")
+}
+
+// UnmarshalUint32Slice decodes a JSON array into a []Uint32.
+func UnmarshalUint32Slice(data []byte) ([]Uint32, error) {
+	return unmarshalSlice[Uint32](data, "Uint32")
+}
+
+// UnmarshalUint64Slice decodes a JSON array into a []Uint64.
+func UnmarshalUint64Slice(data []byte) ([]Uint64, error) {
+	return unmarshalSlice[Uint64](data, "Uint64")
+}
+
+// UnmarshalFloat32Slice decodes a JSON array into a []Float32.
+func UnmarshalFloat32Slice(data []byte) ([]Float32, error) {
+	return unmarshalSlice[Float32](data, "Float32")
+}
+
+// UnmarshalFloat64Slice decodes a JSON array into a []Float64.
+func UnmarshalFloat64Slice(data []byte) ([]Float64, error) {
+	return unmarshalSlice[Float64](data, "Float64")
+}
+
+// UnmarshalStringSlice decodes a JSON array into a []String.
+func UnmarshalStringSlice(data []byte) ([]String, error) {
+	return unmarshalSlice[String](data, "String")
+}
+
+// unmarshalSlice decodes the JSON array data into a []T, calling UnmarshalJSON
+// on each element in turn.
+func unmarshalSlice[T any, P interface {
+	*T
+	json.Unmarshaler
+}](data []byte, typ string) ([]T, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, NullBytes) {
+		return nil, nil
+	}
+	if len(data) < 2 || data[0] != '[' {
+		return nil, fmt.Errorf("null: expected a JSON array for []null.%s", typ)
+	}
+
+	// an upper bound on the element count, so that out is allocated once
+	out := make([]T, 0, bytes.Count(data, []byte{','})+1)
+	i := skipJSONSpace(data, 1)
+	if i < len(data) && data[i] == ']' {
+		if skipJSONSpace(data, i+1) != len(data) {
+			return nil, fmt.Errorf("null: unexpected data after JSON array for []null.%s", typ)
+		}
+		return out, nil
+	}
+	for {
+		end, err := jsonValueEnd(data, i)
+		if err != nil {
+			return nil, fmt.Errorf("null: invalid element %d for []null.%s: %w", len(out), typ, err)
+		}
+		out = append(out, *new(T))
+		if err := P(&out[len(out)-1]).UnmarshalJSON(data[i:end]); err != nil {
+			return nil, fmt.Errorf("null: invalid element %d for []null.%s: %w", len(out)-1, typ, err)
+		}
+
+		i = skipJSONSpace(data, end)
+		if i == len(data) {
+			return nil, fmt.Errorf("null: unterminated JSON array for []null.%s", typ)
+		}
+		switch data[i] {
+		case ',':
+			i = skipJSONSpace(data, i+1)
+		case ']':
+			if skipJSONSpace(data, i+1) != len(data) {
+				return nil, fmt.Errorf("null: unexpected data after JSON array for []null.%s", typ)
+			}
+			return out, nil
+		default:
+			return nil, fmt.Errorf("null: expected a comma after element %d for []null.%s", len(out)-1, typ)
+		}
+	}
+}
+
+// skipJSONSpace returns the index of the first byte from i on in data that is
+// not JSON whitespace.
+func skipJSONSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// jsonValueEnd returns the index just past the JSON value starting at i in
+// data. It only finds the boundary, skipping over strings and nested arrays
+// and objects; the element's UnmarshalJSON checks the value itself.
+func jsonValueEnd(data []byte, i int) (int, error) {
+	if i >= len(data) {
+		return 0, fmt.Errorf("unexpected end of JSON input")
+	}
+	depth := 0
+	for j := i; j < len(data); j++ {
+		switch c := data[j]; c {
+		case '"':
+			j++
+			for ; j < len(data) && data[j] != '"'; j++ {
+				if data[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(data) {
+				return 0, fmt.Errorf("unterminated JSON string")
+			}
+			if depth == 0 {
+				return j + 1, nil
+			}
+		case '[', '{':
+			depth++
+		case ']', '}':
+			if depth == 0 {
+				if j == i {
+					return 0, fmt.Errorf("unexpected %q", c)
+				}
+				return j, nil
+			}
+			depth--
+			if depth == 0 {
+				return j + 1, nil
+			}
+		case ',', ' ', '\t', '\n', '\r':
+			if depth == 0 {
+				if j == i {
+					return 0, fmt.Errorf("unexpected %q", c)
+				}
+				return j, nil
+			}
+		}
+	}
+	if depth != 0 {
+		return 0, fmt.Errorf("unexpected end of JSON input")
+	}
+	return len(data), nil
+}
@@ -0,0 +1,112 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalInt64Slice(t *testing.T) {
+	got, err := UnmarshalInt64Slice([]byte(` [1, null,"-2" ,"",9223372036854775807] `))
+	maybePanic(err)
+	want := []Int64{Int64From(1), NewInt64(0, false), Int64From(-2), NewInt64(0, false), Int64From(9223372036854775807)}
+	if len(got) != len(want) {
+		t.Fatalf("bad length: %v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("bad element %d: %#v ≠ %#v", i, got[i], want[i])
+		}
+	}
+
+	empty, err := UnmarshalInt64Slice([]byte(`[ ]`))
+	maybePanic(err)
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("bad empty array: %#v", empty)
+	}
+
+	null, err := UnmarshalInt64Slice(nullJSON)
+	maybePanic(err)
+	if null != nil {
+		t.Errorf("bad null: %#v", null)
+	}
+}
+
+func TestUnmarshalSliceMatchesJSON(t *testing.T) {
+	// every helper must decode the same way json.Unmarshal does
+	assertSliceMatchesJSON(t, `[true,null,false,"yes",1]`, UnmarshalBoolSlice)
+	assertSliceMatchesJSON(t, `[1,null,"2",-3]`, UnmarshalIntSlice)
+	assertSliceMatchesJSON(t, `[255,null,0]`, UnmarshalUint8Slice)
+	assertSliceMatchesJSON(t, `[1.5,null,"-2e3",0]`, UnmarshalFloat64Slice)
+	assertSliceMatchesJSON(t, `["a, b",null,"","q\"[]{}\\",""]`, UnmarshalStringSlice)
+}
+
+func assertSliceMatchesJSON[T any](t *testing.T, in string, decode func([]byte) ([]T, error)) {
+	var want []T
+	maybePanic(json.Unmarshal([]byte(in), &want))
+	got, err := decode([]byte(in))
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", in, err)
+		return
+	}
+	wantJSON, _ := json.Marshal(want)
+	gotJSON, _ := json.Marshal(got)
+	if string(wantJSON) != string(gotJSON) {
+		t.Errorf("%s: %s ≠ %s", in, gotJSON, wantJSON)
+	}
+}
+
+func TestUnmarshalSliceErrors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`{"a":1}`, "expected a JSON array"},
+		{`1`, "expected a JSON array"},
+		{`[1,2`, "unterminated JSON array"},
+		{`[1,2]x`, "unexpected data after JSON array"},
+		{`[1 2]`, "expected a comma after element 0"},
+		{`[1,,2]`, "invalid element 1"},
+		{`[1,]`, "invalid element 1"},
+		{`[1,"x"]`, "invalid element 1"},
+		{`[1,1.5]`, "invalid element 1"},
+		{`[[1]]`, "invalid element 0"},
+		{`[128]`, "invalid element 0"},
+	}
+	for _, test := range tests {
+		var err error
+		if test.in == `[128]` {
+			_, err = UnmarshalInt8Slice([]byte(test.in))
+		} else {
+			_, err = UnmarshalInt64Slice([]byte(test.in))
+		}
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: expected error containing %q, got %v", test.in, test.want, err)
+		}
+	}
+
+	if _, err := UnmarshalStringSlice([]byte(`["a`)); err == nil {
+		t.Error("expected error for an unterminated string")
+	}
+}
+
+var int64SliceJSON = []byte(`[1234567890,null,"42",-7,null,0,9223372036854775807,-9223372036854775808,13,null]`)
+
+func BenchmarkUnmarshalInt64Slice(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		if _, err := UnmarshalInt64Slice(int64SliceJSON); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalInt64SliceReflect(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		var out []Int64
+		if err := json.Unmarshal(int64SliceJSON, &out); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Duration is a nullable time.Duration.
+// It marshals to the time.Duration.String() form, such as "1h30m0s".
+type Duration struct {
+	Duration time.Duration
+	Valid    bool
+}
+
+// NewDuration creates a new Duration
+func NewDuration(d time.Duration, valid bool) Duration {
+	return Duration{
+		Duration: d,
+		Valid:    valid,
+	}
+}
+
+// DurationFrom creates a new Duration that will always be valid.
+func DurationFrom(d time.Duration) Duration {
+	return NewDuration(d, true)
+}
+
+// DurationFromPtr creates a new Duration that will be null if d is nil.
+func DurationFromPtr(d *time.Duration) Duration {
+	if d == nil {
+		return NewDuration(0, false)
+	}
+	return NewDuration(*d, true)
+}
+
+// ParseDuration parses a duration such as "1h30m" into a valid Duration, as UnmarshalText
+// does, but returns an error rather than a null Duration for empty text.
+func ParseDuration(s string) (Duration, error) {
+	return parseText[Duration]("Duration", s)
+}
+
+// MustDuration is like ParseDuration, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustDuration(s string) Duration {
+	v, err := ParseDuration(s)
+	return mustParse("MustDuration", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a duration string such as "1h30m", or an integer count of
+// nanoseconds, bare or in a string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		d.Valid = false
+		d.Duration = 0
+		return nil
+	}
+
+	var err error
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err != nil {
+		d.Duration, d.Valid = 0, false
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		// Unmarshal again, directly to int64, to avoid intermediate float64
+		var n int64
+		err = json.Unmarshal(data, &n)
+		d.Duration = time.Duration(n)
+	case string:
+		if len(x) == 0 {
+			d.Valid = false
+			return nil
+		}
+		d.Duration, err = parseDuration(x)
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Duration", data)
+	}
+	d.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts a duration string such as "1h30m", or an integer count of nanoseconds.
+func (d *Duration) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		d.Valid = false
+		return nil
+	}
+	var err error
+	d.Duration, err = parseDuration(string(text))
+	d.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return NullBytes, nil
+	}
+	return []byte(`"` + d.Duration.String() + `"`), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Duration as chosen by opts.
+func (d Duration) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(d)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Duration.String()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Duration is null.
+func (d Duration) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, d, d.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (d *Duration) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Duration is null.
+func (d Duration) MarshalYAML() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Duration.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It accepts a duration string such as "1h30m", or an integer count of nanoseconds.
+// A YAML null or empty string will be null.
+func (d *Duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil || len(*v) == 0 {
+		d.Duration = 0
+		d.Valid = false
+		return nil
+	}
+	var err error
+	d.Duration, err = parseDuration(*v)
+	d.Valid = err == nil
+	return err
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Duration is null.
+func (d Duration) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !d.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeInt(int64(d.Duration))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (d *Duration) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *time.Duration
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		d.Duration = 0
+		d.Valid = false
+		return nil
+	}
+	d.Duration = *v
+	d.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Duration is encoded as a single byte.
+func (d Duration) MarshalBinary() ([]byte, error) {
+	if !d.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendVarint([]byte{encodedValid}, int64(d.Duration)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Duration) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Duration")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		d.Duration = 0
+		d.Valid = false
+		return nil
+	}
+	x, err := decodeVarint(value, "Duration")
+	if err != nil {
+		return err
+	}
+	d.Duration = time.Duration(x)
+	d.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (d Duration) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (d *Duration) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// SetValid changes this Duration's value and also sets it to be non-null.
+func (d *Duration) SetValid(n time.Duration) {
+	d.Duration = n
+	d.Valid = true
+}
+
+// SetNull sets this Duration to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (d *Duration) SetNull() {
+	d.Duration = 0
+	d.Valid = false
+}
+
+// Ptr returns a pointer to this Duration's value, or a nil pointer if this Duration is null.
+func (d Duration) Ptr() *time.Duration {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Duration
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Duration) ValueOrZero() time.Duration {
+	if !d.Valid {
+		return 0
+	}
+	return d.Duration
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (d Duration) ValueOr(def time.Duration) time.Duration {
+	if !d.Valid {
+		return def
+	}
+	return d.Duration
+}
+
+// Or returns this Duration if it is valid, otherwise other.
+func (d Duration) Or(other Duration) Duration {
+	if !d.Valid {
+		return other
+	}
+	return d
+}
+
+// MustValue returns the inner value, and panics if this Duration is null.
+func (d Duration) MustValue() time.Duration {
+	if !d.Valid {
+		panic("null.Duration: MustValue called on invalid value")
+	}
+	return d.Duration
+}
+
+// IsZero returns true if this Duration is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (d Duration) IsZero() bool {
+	return !d.Valid
+}
+
+// IsNull returns true if this Duration is null, the same as !Valid.
+func (d Duration) IsNull() bool {
+	return !d.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Duration, or nil if it is null, for
+// a *Duration field tagged omitempty that should leave nulls out.
+func (d Duration) OmitEmpty() *Duration {
+	if !d.Valid {
+		return nil
+	}
+	return &d
+}
+
+// Equal returns true if both Durations are null or both hold the same value.
+func (d Duration) Equal(other Duration) bool {
+	return d.Valid == other.Valid && (!d.Valid || d.Duration == other.Duration)
+}
+
+// String implements fmt.Stringer.
+// It returns the time.Duration.String() form, such as "1h30m0s", or NullDisplay if this Duration is null.
+func (d Duration) String() string {
+	if !d.Valid {
+		return NullDisplay
+	}
+	return d.Duration.String()
+}
+
+// Scan implements the Scanner interface.
+// It accepts an int64 count of nanoseconds, or a string, []byte or json.Number
+// in either the time.ParseDuration form or as an integer count of nanoseconds.
+func (d *Duration) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case int64:
+		d.Duration = time.Duration(x)
+	case string:
+		d.Duration, err = parseDuration(x)
+	case []byte:
+		d.Duration, err = parseDuration(string(x))
+	case json.Number:
+		d.Duration, err = parseDuration(string(x))
+	case nil:
+		d.Duration, d.Valid = 0, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	d.Valid = err == nil
+	return scanError("Duration", value, nil, err)
+}
+
+// parseDuration parses s as an integer count of nanoseconds, or otherwise as a
+// duration string such as "1h30m", for the string forms every decoder accepts.
+func parseDuration(s string) (time.Duration, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(n), nil
+	}
+	return time.ParseDuration(s)
+}
+
+// Value implements the driver Valuer interface.
+func (d Duration) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return int64(d.Duration), nil
+}
+
+// ValueOrNil returns nil if this Duration is null, otherwise the same value as Value.
+func (d Duration) ValueOrNil() interface{} {
+	if !d.Valid {
+		return nil
+	}
+	return int64(d.Duration)
+}
+
+// Randomize for sqlboiler
+func (d *Duration) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		d.Duration = 0
+		d.Valid = false
+	} else {
+		d.Duration = time.Duration(nextInt()%3600) * time.Second
+		d.Valid = true
+	}
+}
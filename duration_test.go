@@ -0,0 +1,273 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+)
+
+var (
+	durationValue        = 90 * time.Minute
+	durationJSON         = []byte(`"1h30m0s"`)
+	durationNanosJSON    = []byte(`5400000000000`)
+	durationShortJSON    = []byte(`"1h30m"`)
+	durationNegativeJSON = []byte(`"-1h30m0s"`)
+)
+
+func TestDurationFrom(t *testing.T) {
+	d := DurationFrom(durationValue)
+	assertDuration(t, d, "DurationFrom()")
+
+	zero := DurationFrom(0)
+	if !zero.Valid {
+		t.Error("DurationFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestDurationFromPtr(t *testing.T) {
+	v := durationValue
+	d := DurationFromPtr(&v)
+	assertDuration(t, d, "DurationFromPtr()")
+
+	null := DurationFromPtr(nil)
+	assertNullDuration(t, null, "DurationFromPtr(nil)")
+}
+
+func TestUnmarshalDuration(t *testing.T) {
+	var d Duration
+	err := json.Unmarshal(durationJSON, &d)
+	maybePanic(err)
+	assertDuration(t, d, "duration json")
+
+	var short Duration
+	err = json.Unmarshal(durationShortJSON, &short)
+	maybePanic(err)
+	assertDuration(t, short, "short duration json")
+
+	var nanos Duration
+	err = json.Unmarshal(durationNanosJSON, &nanos)
+	maybePanic(err)
+	assertDuration(t, nanos, "nanoseconds json")
+
+	var negative Duration
+	err = json.Unmarshal(durationNegativeJSON, &negative)
+	maybePanic(err)
+	if !negative.Valid || negative.Duration != -durationValue {
+		t.Errorf("bad negative duration: %#v", negative)
+	}
+
+	var zero Duration
+	err = json.Unmarshal([]byte(`0`), &zero)
+	maybePanic(err)
+	if !zero.Valid || zero.Duration != 0 {
+		t.Errorf("bad zero duration: %#v", zero)
+	}
+
+	var null Duration
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDuration(t, null, "null json")
+
+	var blank Duration
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullDuration(t, blank, "blank json string")
+
+	var badType Duration
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullDuration(t, badType, "wrong type json")
+
+	var badString Duration
+	err = json.Unmarshal(stringJSON, &badString)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullDuration(t, badString, "bad duration string json")
+}
+
+func TestTextUnmarshalDuration(t *testing.T) {
+	var d Duration
+	err := d.UnmarshalText([]byte("1h30m"))
+	maybePanic(err)
+	assertDuration(t, d, "UnmarshalText() duration")
+
+	var blank Duration
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDuration(t, blank, "UnmarshalText() empty duration")
+}
+
+func TestMarshalDuration(t *testing.T) {
+	d := DurationFrom(durationValue)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(durationJSON), "non-empty json marshal")
+
+	negative := DurationFrom(-durationValue)
+	data, err = json.Marshal(negative)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(durationNegativeJSON), "negative json marshal")
+
+	zero := DurationFrom(0)
+	data, err = json.Marshal(zero)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0s"`, "zero json marshal")
+
+	// invalid values should be encoded as null
+	null := NewDuration(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalDurationText(t *testing.T) {
+	d := DurationFrom(durationValue)
+	data, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "1h30m0s", "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewDuration(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDurationPointer(t *testing.T) {
+	d := DurationFrom(durationValue)
+	ptr := d.Ptr()
+	if *ptr != durationValue {
+		t.Errorf("bad %s duration: %#v ≠ %s\n", "pointer", ptr, durationValue)
+	}
+
+	null := NewDuration(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s duration: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestDurationIsZero(t *testing.T) {
+	d := DurationFrom(durationValue)
+	if d.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewDuration(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewDuration(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestDurationSetValid(t *testing.T) {
+	change := NewDuration(0, false)
+	assertNullDuration(t, change, "SetValid()")
+	change.SetValid(durationValue)
+	assertDuration(t, change, "SetValid()")
+}
+
+func TestDurationSetNull(t *testing.T) {
+	change := DurationFrom(durationValue)
+	change.SetNull()
+	assertNullDuration(t, change, "SetNull()")
+	if change.Duration != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Duration)
+	}
+}
+
+func TestDurationScanValue(t *testing.T) {
+	var d Duration
+	err := d.Scan(int64(durationValue))
+	maybePanic(err)
+	assertDuration(t, d, "scanned int64")
+	if v, err := d.Value(); v != int64(durationValue) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var s Duration
+	err = s.Scan("1h30m")
+	maybePanic(err)
+	assertDuration(t, s, "scanned string")
+
+	var b Duration
+	err = b.Scan([]byte("5400000000000"))
+	maybePanic(err)
+	assertDuration(t, b, "scanned []byte nanoseconds")
+
+	var null Duration
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDuration(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Duration
+	err = wrong.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDuration(t, wrong, "scanned wrong")
+}
+
+func TestDurationNanosecondString(t *testing.T) {
+	const nanos = "5400000000000"
+
+	var j Duration
+	maybePanic(json.Unmarshal([]byte(`"`+nanos+`"`), &j))
+	assertDuration(t, j, "json string nanoseconds")
+
+	var txt Duration
+	maybePanic(txt.UnmarshalText([]byte(nanos)))
+	assertDuration(t, txt, "text nanoseconds")
+
+	var s Duration
+	maybePanic(s.Scan(nanos))
+	assertDuration(t, s, "scanned string nanoseconds")
+}
+
+func TestDurationString(t *testing.T) {
+	v := DurationFrom(durationValue)
+	if s := v.String(); s != "1h30m0s" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewDuration(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestDurationValueOrNil(t *testing.T) {
+	assertValueOrNil(t, DurationFrom(durationValue), "valid")
+	assertValueOrNil(t, NewDuration(0, false), "null")
+}
+
+func TestDurationMustValue(t *testing.T) {
+	v := DurationFrom(durationValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewDuration(0, false).MustValue() }, "Duration")
+}
+
+func assertDuration(t *testing.T, d Duration, from string) {
+	if d.Duration != durationValue {
+		t.Errorf("bad %s duration: %s ≠ %s\n", from, d.Duration, durationValue)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDuration(t *testing.T, d Duration, from string) {
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
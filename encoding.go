@@ -0,0 +1,75 @@
+package null
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Every type shares the same compact encoding: a single validity byte
+// followed, for valid values only, by the encoded value.
+const (
+	encodedNull  byte = 0
+	encodedValid byte = 1
+)
+
+// decodeHeader checks the validity byte at the start of data and returns the
+// encoded value that follows it. A null value must not be followed by any data.
+func decodeHeader(data []byte, typ string) (value []byte, valid bool, err error) {
+	if len(data) == 0 {
+		return nil, false, fmt.Errorf("null: cannot decode empty data into null.%s", typ)
+	}
+	switch data[0] {
+	case encodedNull:
+		if len(data) != 1 {
+			return nil, false, fmt.Errorf("null: unexpected data after null value for null.%s", typ)
+		}
+		return nil, false, nil
+	case encodedValid:
+		return data[1:], true, nil
+	}
+	return nil, false, fmt.Errorf("null: invalid validity byte %d for null.%s", data[0], typ)
+}
+
+// decodeVarint decodes a value that must consist of exactly one varint.
+func decodeVarint(data []byte, typ string) (int64, error) {
+	x, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return 0, fmt.Errorf("null: invalid varint data for null.%s", typ)
+	}
+	return x, nil
+}
+
+// decodeUvarint decodes a value that must consist of exactly one uvarint.
+func decodeUvarint(data []byte, typ string) (uint64, error) {
+	x, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return 0, fmt.Errorf("null: invalid uvarint data for null.%s", typ)
+	}
+	return x, nil
+}
+
+// decodeFixed checks that a fixed size value is exactly size bytes long.
+func decodeFixed(data []byte, size int, typ string) ([]byte, error) {
+	if len(data) != size {
+		return nil, fmt.Errorf("null: expected %d bytes of data for null.%s, got %d", size, typ, len(data))
+	}
+	return data, nil
+}
+
+// appendLengthPrefixed appends b preceded by its length as a uvarint.
+func appendLengthPrefixed(dst, b []byte) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(b)))
+	return append(dst, b...)
+}
+
+// decodeLengthPrefixed decodes a value written by appendLengthPrefixed.
+// The returned slice is a copy and never aliases data.
+func decodeLengthPrefixed(data []byte, typ string) ([]byte, error) {
+	size, n := binary.Uvarint(data)
+	if n <= 0 || size != uint64(len(data)-n) {
+		return nil, fmt.Errorf("null: invalid length prefixed data for null.%s", typ)
+	}
+	b := make([]byte, size)
+	copy(b, data[n:])
+	return b, nil
+}
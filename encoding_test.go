@@ -0,0 +1,258 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type gobPayload struct {
+	Bools     []Bool
+	Bytes     []Byte
+	ByteSlice []Bytes
+	Float32s  []Float32
+	Float64s  []Float64
+	Ints      []Int
+	Int8s     []Int8
+	Int16s    []Int16
+	Int32s    []Int32
+	Int64s    []Int64
+	Uints     []Uint
+	Uint8s    []Uint8
+	Uint16s   []Uint16
+	Uint32s   []Uint32
+	Uint64s   []Uint64
+	JSONs     []JSON
+	Strings   []String
+	Times     []Time
+	UUIDs     []UUID
+	Decimals  []Decimal
+	Durations []Duration
+	IPs       []IP
+	Nulls     []Null[string]
+}
+
+func gobRoundTrip(in, out interface{}) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(in)
+	maybePanic(err)
+	err = gob.NewDecoder(&buf).Decode(out)
+	maybePanic(err)
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	in := gobPayload{
+		Bools:     []Bool{BoolFrom(true), NewBool(false, false), BoolFrom(false)},
+		Bytes:     []Byte{ByteFrom('b'), NewByte(0, false)},
+		ByteSlice: []Bytes{BytesFrom([]byte("hello")), NewBytes(nil, false), BytesFrom([]byte{})},
+		Float32s:  []Float32{Float32From(1.2345), NewFloat32(0, false)},
+		Float64s:  []Float64{Float64From(-1.2345), NewFloat64(0, false), Float64From(0)},
+		Ints:      []Int{IntFrom(-12345), NewInt(0, false)},
+		Int8s:     []Int8{Int8From(-128), NewInt8(0, false), Int8From(127)},
+		Int16s:    []Int16{Int16From(-32768), NewInt16(0, false)},
+		Int32s:    []Int32{Int32From(-2147483648), NewInt32(0, false)},
+		Int64s:    []Int64{Int64From(-9223372036854775808), NewInt64(0, false), Int64From(0)},
+		Uints:     []Uint{UintFrom(12345), NewUint(0, false)},
+		Uint8s:    []Uint8{Uint8From(255), NewUint8(0, false)},
+		Uint16s:   []Uint16{Uint16From(65535), NewUint16(0, false)},
+		Uint32s:   []Uint32{Uint32From(4294967295), NewUint32(0, false)},
+		Uint64s:   []Uint64{Uint64From(18446744073709551615), NewUint64(0, false)},
+		JSONs:     []JSON{JSONFrom([]byte(`{"a":1}`)), NewJSON(nil, false)},
+		Strings:   []String{StringFrom("test"), NewString("", false), StringFrom("")},
+		Times:     []Time{TimeFrom(timeValue.In(time.FixedZone("UTC+5", 5*60*60))), NewTime(time.Time{}, false), TimeFrom(time.Time{})},
+		UUIDs:     []UUID{UUIDFrom(uuidValue), NewUUID(uuid.Nil, false)},
+		Decimals:  []Decimal{DecimalFrom(decimalValue), NewDecimal(decimal.Zero, false)},
+		Durations: []Duration{DurationFrom(-durationValue), NewDuration(0, false)},
+		IPs:       []IP{IPFrom(ipValue), IPFrom(ipv6Value), NewIP(nil, false)},
+		Nulls:     []Null[string]{NullFrom("test"), NewNull("", false)},
+	}
+
+	var out gobPayload
+	gobRoundTrip(in, &out)
+
+	check := func(name string, n int, equal func(i int) bool) {
+		for i := 0; i < n; i++ {
+			if !equal(i) {
+				t.Errorf("bad gob round trip of %s[%d]", name, i)
+			}
+		}
+	}
+	check("Bools", len(in.Bools), func(i int) bool { return in.Bools[i].Equal(out.Bools[i]) })
+	check("Bytes", len(in.Bytes), func(i int) bool { return in.Bytes[i].Equal(out.Bytes[i]) })
+	check("ByteSlice", len(in.ByteSlice), func(i int) bool { return in.ByteSlice[i].Equal(out.ByteSlice[i]) })
+	check("Float32s", len(in.Float32s), func(i int) bool { return in.Float32s[i].Equal(out.Float32s[i]) })
+	check("Float64s", len(in.Float64s), func(i int) bool { return in.Float64s[i].Equal(out.Float64s[i]) })
+	check("Ints", len(in.Ints), func(i int) bool { return in.Ints[i].Equal(out.Ints[i]) })
+	check("Int8s", len(in.Int8s), func(i int) bool { return in.Int8s[i].Equal(out.Int8s[i]) })
+	check("Int16s", len(in.Int16s), func(i int) bool { return in.Int16s[i].Equal(out.Int16s[i]) })
+	check("Int32s", len(in.Int32s), func(i int) bool { return in.Int32s[i].Equal(out.Int32s[i]) })
+	check("Int64s", len(in.Int64s), func(i int) bool { return in.Int64s[i].Equal(out.Int64s[i]) })
+	check("Uints", len(in.Uints), func(i int) bool { return in.Uints[i].Equal(out.Uints[i]) })
+	check("Uint8s", len(in.Uint8s), func(i int) bool { return in.Uint8s[i].Equal(out.Uint8s[i]) })
+	check("Uint16s", len(in.Uint16s), func(i int) bool { return in.Uint16s[i].Equal(out.Uint16s[i]) })
+	check("Uint32s", len(in.Uint32s), func(i int) bool { return in.Uint32s[i].Equal(out.Uint32s[i]) })
+	check("Uint64s", len(in.Uint64s), func(i int) bool { return in.Uint64s[i].Equal(out.Uint64s[i]) })
+	check("JSONs", len(in.JSONs), func(i int) bool { return in.JSONs[i].Equal(out.JSONs[i]) })
+	check("Strings", len(in.Strings), func(i int) bool { return in.Strings[i].Equal(out.Strings[i]) })
+	check("Times", len(in.Times), func(i int) bool { return in.Times[i].Equal(out.Times[i]) })
+	check("UUIDs", len(in.UUIDs), func(i int) bool { return in.UUIDs[i].Equal(out.UUIDs[i]) })
+	check("Decimals", len(in.Decimals), func(i int) bool { return in.Decimals[i].Equal(out.Decimals[i]) })
+	check("Durations", len(in.Durations), func(i int) bool { return in.Durations[i].Equal(out.Durations[i]) })
+	check("IPs", len(in.IPs), func(i int) bool { return in.IPs[i].Equal(out.IPs[i]) })
+	check("Nulls", len(in.Nulls), func(i int) bool { return in.Nulls[i] == out.Nulls[i] })
+
+	// a null Time must not come back as a valid zero time, and a valid Time keeps its zone
+	if out.Times[1].Valid || !out.Times[1].Time.IsZero() {
+		t.Errorf("bad null time: %#v", out.Times[1])
+	}
+	if _, offset := out.Times[0].Time.Zone(); offset != 5*60*60 {
+		t.Errorf("bad time zone offset: %d", offset)
+	}
+	if !out.Times[2].Valid {
+		t.Error("valid zero time became null")
+	}
+}
+
+func TestGobInterface(t *testing.T) {
+	gob.Register(Time{})
+	gob.Register(Int8{})
+
+	in := []interface{}{TimeFrom(timeValue), NewTime(time.Time{}, false), Int8From(-1), NewInt8(0, false)}
+	var out []interface{}
+	gobRoundTrip(in, &out)
+
+	if len(out) != len(in) {
+		t.Fatalf("bad gob interface round trip: %#v", out)
+	}
+	if !out[0].(Time).Equal(in[0].(Time)) || !out[1].(Time).Equal(in[1].(Time)) ||
+		!out[2].(Int8).Equal(in[2].(Int8)) || !out[3].(Int8).Equal(in[3].(Int8)) {
+		t.Errorf("bad gob interface round trip: %#v", out)
+	}
+}
+
+func TestGobEncodeNull(t *testing.T) {
+	data, err := NewTime(time.Time{}, false).GobEncode()
+	maybePanic(err)
+	if !bytes.Equal(data, []byte{0}) {
+		t.Errorf("bad null gob encoding: %v", data)
+	}
+}
+
+func TestGobDecodeError(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		dec  interface{ GobDecode([]byte) error }
+	}{
+		{name: "empty", data: []byte{}, dec: &Int64{}},
+		{name: "bad validity byte", data: []byte{2}, dec: &Int64{}},
+		{name: "data after null", data: []byte{0, 1}, dec: &Int64{}},
+		{name: "missing varint", data: []byte{1}, dec: &Int64{}},
+		{name: "truncated varint", data: []byte{1, 0x80}, dec: &Int64{}},
+		{name: "overflowing int8", data: []byte{1, 0x80, 0x02}, dec: &Int8{}},
+		{name: "truncated float64", data: []byte{1, 0, 0, 0}, dec: &Float64{}},
+		{name: "truncated string", data: []byte{1, 5, 'a'}, dec: &String{}},
+		{name: "truncated uuid", data: []byte{1, 1, 2, 3}, dec: &UUID{}},
+		{name: "truncated ip", data: []byte{1, 127, 0}, dec: &IP{}},
+		{name: "truncated time", data: []byte{1, 1, 0}, dec: &Time{}},
+	}
+
+	for _, test := range tests {
+		if err := test.dec.GobDecode(test.data); err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
+type binaryValue interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// binaryValues returns a valid value of every type, with a fresh value of
+// the same type to decode into.
+func binaryValues() []struct{ in, out binaryValue } {
+	var (
+		bo  = BoolFrom(true)
+		by  = ByteFrom('b')
+		bs  = BytesFrom([]byte("hello"))
+		f32 = Float32From(1.2345)
+		f64 = Float64From(-1.2345)
+		i   = IntFrom(-12345)
+		i8  = Int8From(-128)
+		i16 = Int16From(-32768)
+		i32 = Int32From(-2147483648)
+		i64 = Int64From(-9223372036854775808)
+		u   = UintFrom(12345)
+		u8  = Uint8From(255)
+		u16 = Uint16From(65535)
+		u32 = Uint32From(4294967295)
+		u64 = Uint64From(18446744073709551615)
+		j   = JSONFrom([]byte(`{"a":1}`))
+		s   = StringFrom("test")
+		ti  = TimeFrom(timeValue)
+		id  = UUIDFrom(uuidValue)
+		d   = DecimalFrom(decimalValue)
+		du  = DurationFrom(durationValue)
+		ip  = IPFrom(ipv6Value)
+		mac = MACFrom(eui64Value)
+		net = CIDRFrom(cidrv6Value)
+		n   = NullFrom("test")
+		c64 = Complex64From(complex(1, -2))
+		c   = Complex128From(complex(-1.5, 2.5))
+	)
+	return []struct{ in, out binaryValue }{
+		{&bo, &Bool{}}, {&by, &Byte{}}, {&bs, &Bytes{}}, {&f32, &Float32{}}, {&f64, &Float64{}},
+		{&i, &Int{}}, {&i8, &Int8{}}, {&i16, &Int16{}}, {&i32, &Int32{}}, {&i64, &Int64{}},
+		{&u, &Uint{}}, {&u8, &Uint8{}}, {&u16, &Uint16{}}, {&u32, &Uint32{}}, {&u64, &Uint64{}},
+		{&j, &JSON{}}, {&s, &String{}}, {&ti, &Time{}}, {&id, &UUID{}}, {&d, &Decimal{}},
+		{&du, &Duration{}}, {&ip, &IP{}}, {&n, &Null[string]{}}, {&c64, &Complex64{}}, {&c, &Complex128{}},
+		{&mac, &MAC{}}, {&net, &CIDR{}},
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, v := range binaryValues() {
+		data, err := v.in.MarshalBinary()
+		maybePanic(err)
+		if data[0] != 1 {
+			t.Errorf("%T: bad validity byte: %v", v.in, data)
+		}
+		err = v.out.UnmarshalBinary(data)
+		maybePanic(err)
+		if !reflect.DeepEqual(reflect.ValueOf(v.out).Elem().Interface(), reflect.ValueOf(v.in).Elem().Interface()) {
+			t.Errorf("%T: bad binary round trip: %v ≠ %v", v.in, v.out, v.in)
+		}
+	}
+}
+
+func TestMarshalBinaryNull(t *testing.T) {
+	for _, v := range binaryValues() {
+		// decode a null into a valid value, then encode it again
+		err := v.in.UnmarshalBinary([]byte{0})
+		maybePanic(err)
+		data, err := v.in.MarshalBinary()
+		maybePanic(err)
+		if !bytes.Equal(data, []byte{0}) {
+			t.Errorf("%T: bad null binary encoding: %v", v.in, data)
+		}
+	}
+}
+
+func TestUnmarshalBinaryTruncated(t *testing.T) {
+	for _, v := range binaryValues() {
+		data, err := v.in.MarshalBinary()
+		maybePanic(err)
+		for n := 0; n < len(data); n++ {
+			if err := v.out.UnmarshalBinary(data[:n]); err == nil {
+				t.Errorf("%T: expected error decoding %d of %d bytes", v.in, n, len(data))
+			}
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// EnumValues lists the values allowed in an Enum. It is implemented by a
+// type, usually an empty struct, that names the enum:
+//
+//	type Color struct{}
+//
+//	func (Color) Values() []string { return []string{"red", "green", "blue"} }
+//
+// Values is called on the zero value of the type.
+type EnumValues interface {
+	Values() []string
+}
+
+// Enum is a nullable string that may only hold one of the values listed by
+// V, for string columns that are really enums. UnmarshalJSON, UnmarshalText
+// and Scan reject any other value with an error, and null is always allowed.
+// The constructors and SetValid do not check the value; use ParseEnum for
+// values that are not known to be allowed.
+type Enum[V EnumValues] struct {
+	Enum  string
+	Valid bool
+}
+
+// NewEnum creates a new Enum
+func NewEnum[V EnumValues](s string, valid bool) Enum[V] {
+	return Enum[V]{
+		Enum:  s,
+		Valid: valid,
+	}
+}
+
+// EnumFrom creates a new Enum that will always be valid.
+func EnumFrom[V EnumValues](s string) Enum[V] {
+	return NewEnum[V](s, true)
+}
+
+// EnumFromPtr creates a new Enum that will be null if s is nil.
+func EnumFromPtr[V EnumValues](s *string) Enum[V] {
+	if s == nil {
+		return NewEnum[V]("", false)
+	}
+	return NewEnum[V](*s, true)
+}
+
+// ParseEnum returns a valid Enum holding s, or an error if s is not one of
+// the values V allows.
+func ParseEnum[V EnumValues](s string) (Enum[V], error) {
+	var e Enum[V]
+	if err := e.set(s); err != nil {
+		return e, err
+	}
+	return e, nil
+}
+
+// MustEnum is like ParseEnum, but panics if s is not one of the values V
+// allows.
+func MustEnum[V EnumValues](s string) Enum[V] {
+	e, err := ParseEnum[V](s)
+	return mustParse("MustEnum", s, e, err)
+}
+
+// Allowed returns the values this Enum may hold.
+func (e Enum[V]) Allowed() []string {
+	var v V
+	return v.Values()
+}
+
+// set makes s the value of this Enum if it is allowed, and otherwise makes
+// this Enum null and returns an error.
+func (e *Enum[V]) set(s string) error {
+	allowed := e.Allowed()
+	for _, a := range allowed {
+		if s == a {
+			e.Enum, e.Valid = s, true
+			return nil
+		}
+	}
+	e.Enum, e.Valid = "", false
+	var v V
+	return fmt.Errorf("null: %q is not an allowed %T value, expected one of %s", s, v, strings.Join(allowed, ", "))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a JSON string holding an allowed value, or null.
+func (e *Enum[V]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		e.Enum = ""
+		e.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		e.Enum, e.Valid = "", false
+		return err
+	}
+	return e.set(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Blank text will be null, and any other text must be an allowed value.
+func (e *Enum[V]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		e.Enum = ""
+		e.Valid = false
+		return nil
+	}
+	return e.set(string(text))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Enum is null.
+func (e Enum[V]) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return NullBytes, nil
+	}
+	return appendJSONString(make([]byte, 0, len(e.Enum)+2), e.Enum), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Enum as chosen by opts.
+func (e Enum[V]) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(e)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Enum is null.
+func (e Enum[V]) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+	return []byte(e.Enum), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Enum is null.
+func (e Enum[V]) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, e, e.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (e *Enum[V]) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, e)
+}
+
+// SetValid changes this Enum's value and also sets it to be non-null.
+// It does not check that v is allowed.
+func (e *Enum[V]) SetValid(v string) {
+	e.Enum = v
+	e.Valid = true
+}
+
+// SetNull sets this Enum to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (e *Enum[V]) SetNull() {
+	e.Enum = ""
+	e.Valid = false
+}
+
+// Ptr returns a pointer to this Enum's value, or a nil pointer if this Enum is null.
+func (e Enum[V]) Ptr() *string {
+	if !e.Valid {
+		return nil
+	}
+	return &e.Enum
+}
+
+// ValueOrZero returns the inner value if valid, otherwise "".
+func (e Enum[V]) ValueOrZero() string {
+	if !e.Valid {
+		return ""
+	}
+	return e.Enum
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (e Enum[V]) ValueOr(def string) string {
+	if !e.Valid {
+		return def
+	}
+	return e.Enum
+}
+
+// Or returns this Enum if it is valid, otherwise other.
+func (e Enum[V]) Or(other Enum[V]) Enum[V] {
+	if !e.Valid {
+		return other
+	}
+	return e
+}
+
+// MustValue returns the inner value, and panics if this Enum is null.
+func (e Enum[V]) MustValue() string {
+	if !e.Valid {
+		panic("null.Enum: MustValue called on invalid value")
+	}
+	return e.Enum
+}
+
+// IsZero returns true if this Enum is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (e Enum[V]) IsZero() bool {
+	return !e.Valid
+}
+
+// IsNull returns true if this Enum is null, the same as !Valid.
+func (e Enum[V]) IsNull() bool {
+	return !e.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Enum, or nil if it is null, for
+// a *Enum field tagged omitempty that should leave nulls out.
+func (e Enum[V]) OmitEmpty() *Enum[V] {
+	if !e.Valid {
+		return nil
+	}
+	return &e
+}
+
+// Equal returns true if both Enums are null or both hold the same value.
+func (e Enum[V]) Equal(other Enum[V]) bool {
+	return e.Valid == other.Valid && (!e.Valid || e.Enum == other.Enum)
+}
+
+// String implements fmt.Stringer.
+// It returns the value, or NullDisplay if this Enum is null.
+func (e Enum[V]) String() string {
+	if !e.Valid {
+		return NullDisplay
+	}
+	return e.Enum
+}
+
+// Scan implements the Scanner interface.
+// It accepts an allowed value as a string or []byte.
+func (e *Enum[V]) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	switch x := value.(type) {
+	case string:
+		return scanError("Enum", value, nil, e.set(x))
+	case []byte:
+		return scanError("Enum", value, nil, e.set(string(x)))
+	case nil:
+		e.Enum, e.Valid = "", false
+		return nil
+	}
+	e.Enum, e.Valid = "", false
+	return scanError("Enum", value, nil, errUnsupportedScanType)
+}
+
+// Value implements the driver Valuer interface.
+func (e Enum[V]) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return e.Enum, nil
+}
+
+// ValueOrNil returns nil if this Enum is null, otherwise the same value as Value.
+func (e Enum[V]) ValueOrNil() interface{} {
+	if !e.Valid {
+		return nil
+	}
+	return e.Enum
+}
+
+// Randomize for sqlboiler. It picks one of the allowed values.
+func (e *Enum[V]) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	allowed := e.Allowed()
+	if shouldBeNull || len(allowed) == 0 {
+		e.Enum = ""
+		e.Valid = false
+	} else {
+		n := nextInt() % int64(len(allowed))
+		if n < 0 {
+			n = -n
+		}
+		e.Enum = allowed[n]
+		e.Valid = true
+	}
+}
@@ -0,0 +1,171 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type color struct{}
+
+func (color) Values() []string { return []string{"red", "green", "blue"} }
+
+func TestEnumFrom(t *testing.T) {
+	assertEnum(t, EnumFrom[color]("green"), "EnumFrom()")
+
+	s := "green"
+	assertEnum(t, EnumFromPtr[color](&s), "EnumFromPtr()")
+	assertNullEnum(t, EnumFromPtr[color](nil), "EnumFromPtr(nil)")
+
+	e, err := ParseEnum[color]("green")
+	maybePanic(err)
+	assertEnum(t, e, "ParseEnum()")
+
+	bad, err := ParseEnum[color]("purple")
+	if err == nil {
+		t.Error("expected error for ParseEnum() of a value not allowed")
+	}
+	assertNullEnum(t, bad, "ParseEnum() not allowed")
+}
+
+func TestUnmarshalEnum(t *testing.T) {
+	var e Enum[color]
+	err := json.Unmarshal([]byte(`"green"`), &e)
+	maybePanic(err)
+	assertEnum(t, e, "member json")
+
+	var null Enum[color]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullEnum(t, null, "null json")
+
+	invalid := EnumFrom[color]("green")
+	err = json.Unmarshal([]byte(`"purple"`), &invalid)
+	if err == nil || !strings.Contains(err.Error(), `"purple" is not an allowed null.color value, expected one of red, green, blue`) {
+		t.Errorf("bad error: %v", err)
+	}
+	assertNullEnum(t, invalid, "non-member json")
+
+	for _, data := range [][]byte{blankStringJSON, []byte(`"Green"`), intJSON, boolJSON} {
+		bad := EnumFrom[color]("green")
+		if err := json.Unmarshal(data, &bad); err == nil {
+			t.Errorf("%s: expected error", data)
+		}
+		assertNullEnum(t, bad, string(data))
+	}
+
+	// in a struct, as it is usually used
+	var rec struct {
+		Color Enum[color] `json:"color"`
+	}
+	err = json.Unmarshal([]byte(`{"color":"green"}`), &rec)
+	maybePanic(err)
+	assertEnum(t, rec.Color, "struct field json")
+	if err := json.Unmarshal([]byte(`{"color":"pink"}`), &rec); err == nil {
+		t.Error("expected error for a struct field not allowed")
+	}
+}
+
+func TestMarshalEnum(t *testing.T) {
+	data, err := json.Marshal(EnumFrom[color]("green"))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"green"`, "non-empty json marshal")
+
+	data, err = json.Marshal(NewEnum[color]("", false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestEnumText(t *testing.T) {
+	var e Enum[color]
+	err := e.UnmarshalText([]byte("green"))
+	maybePanic(err)
+	assertEnum(t, e, "UnmarshalText()")
+
+	var blank Enum[color]
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullEnum(t, blank, "UnmarshalText() empty")
+
+	if err := blank.UnmarshalText([]byte("purple")); err == nil {
+		t.Error("expected error for UnmarshalText() of a value not allowed")
+	}
+}
+
+func TestEnumScanValue(t *testing.T) {
+	var e Enum[color]
+	err := e.Scan("green")
+	maybePanic(err)
+	assertEnum(t, e, "scanned string")
+	if v, err := e.Value(); v != "green" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var b Enum[color]
+	err = b.Scan([]byte("green"))
+	maybePanic(err)
+	assertEnum(t, b, "scanned []byte")
+
+	var null Enum[color]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullEnum(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	for _, value := range []interface{}{"purple", int64(1), ""} {
+		wrong := EnumFrom[color]("green")
+		if err := wrong.Scan(value); err == nil {
+			t.Errorf("%#v: expected error", value)
+		}
+		assertNullEnum(t, wrong, fmt.Sprint("scanned ", value))
+	}
+}
+
+func TestEnumRandomize(t *testing.T) {
+	var e Enum[color]
+	for _, seed := range []int64{0, 1, 2, 3, -4} {
+		e.Randomize(func() int64 { return seed }, "", false)
+		if _, err := ParseEnum[color](e.Enum); err != nil || !e.Valid {
+			t.Errorf("Randomize() gave a value not allowed: %#v", e)
+		}
+	}
+	e.Randomize(func() int64 { return 1 }, "", true)
+	assertNullEnum(t, e, "Randomize() null")
+}
+
+func TestEnumString(t *testing.T) {
+	if s := EnumFrom[color]("green").String(); s != "green" {
+		t.Errorf("bad String(): %q", s)
+	}
+	if s := fmt.Sprint(NewEnum[color]("", false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestEnumValueOrNil(t *testing.T) {
+	assertValueOrNil(t, EnumFrom[color]("green"), "valid")
+	assertValueOrNil(t, NewEnum[color]("", false), "null")
+}
+
+func TestEnumMustValue(t *testing.T) {
+	v := EnumFrom[color]("green")
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewEnum[color]("", false).MustValue() }, "Enum")
+}
+
+func assertEnum(t *testing.T, e Enum[color], from string) {
+	if e.Enum != "green" {
+		t.Errorf("bad %s enum: %q ≠ %q\n", from, e.Enum, "green")
+	}
+	if !e.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullEnum(t *testing.T, e Enum[color], from string) {
+	if e.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,109 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// NullLiteral supplies the JSON a Field encodes a null as. It is implemented
+// by a type, usually an empty struct, that names the encoding:
+//
+//	type Dash struct{}
+//
+//	func (Dash) NullJSON() []byte { return []byte(`"-"`) }
+//
+// NullJSON is called on the zero value of the type and must return valid JSON.
+type NullLiteral interface {
+	NullJSON() []byte
+}
+
+// LiteralNull encodes a null Field as JSON null, the same as MarshalJSON.
+type LiteralNull struct{}
+
+// NullJSON implements NullLiteral.
+func (LiteralNull) NullJSON() []byte { return NullBytes }
+
+// LiteralNullString encodes a null Field as the JSON string "null".
+type LiteralNullString struct{}
+
+// NullJSON implements NullLiteral.
+func (LiteralNullString) NullJSON() []byte { return nullStringBytes }
+
+// LiteralEmptyString encodes a null Field as the empty JSON string "".
+type LiteralEmptyString struct{}
+
+// NullJSON implements NullLiteral.
+func (LiteralEmptyString) NullJSON() []byte { return []byte(`""`) }
+
+// FieldValue is a type a Field can wrap, such as any of the types in this
+// package.
+type FieldValue interface {
+	json.Marshaler
+	IsZero() bool
+}
+
+// Field wraps a nullable value so that a null encodes as the JSON chosen by L
+// rather than as the global NullBytes, such as:
+//
+//	type Response struct {
+//		Name  null.Field[null.String, null.LiteralEmptyString] `json:"name"`
+//		Email null.Field[null.String, null.LiteralNull]        `json:"email"`
+//		Phone null.String                                      `json:"phone,omitzero"`
+//	}
+//
+// Each field of a struct can use a different encoding, which MarshalOptions
+// cannot do since it applies to a whole call. A Field with the omitzero tag
+// option is left out when null, as its IsZero is that of the value.
+// UnmarshalJSON reads the literal of L back as null, so a Field with
+// LiteralEmptyString cannot hold a valid empty string.
+type Field[T FieldValue, L NullLiteral] struct {
+	Val T
+}
+
+// FieldFrom creates a new Field wrapping v.
+func FieldFrom[T FieldValue, L NullLiteral](v T) Field[T, L] {
+	return Field[T, L]{Val: v}
+}
+
+// nullJSON returns the encoding of a null under L.
+func (f Field[T, L]) nullJSON() []byte {
+	var l L
+	return l.NullJSON()
+}
+
+// MarshalJSON implements json.Marshaler.
+// It encodes the value with its own MarshalJSON, replacing JSON null with the
+// literal of L.
+func (f Field[T, L]) MarshalJSON() ([]byte, error) {
+	data, err := f.Val.MarshalJSON()
+	if err != nil || !bytes.Equal(data, NullBytes) {
+		return data, err
+	}
+	return f.nullJSON(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The literal of L is decoded as JSON null, and anything else as the value
+// would decode it.
+func (f *Field[T, L]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), f.nullJSON()) {
+		data = NullBytes
+	}
+	if u, ok := interface{}(&f.Val).(json.Unmarshaler); ok {
+		return u.UnmarshalJSON(data)
+	}
+	return json.Unmarshal(data, &f.Val)
+}
+
+// IsZero returns true if the wrapped value is null, so that the omitzero
+// struct tag option (Go 1.24 and later) leaves out nulls.
+func (f Field[T, L]) IsZero() bool {
+	return f.Val.IsZero()
+}
+
+// String implements fmt.Stringer.
+// It formats the wrapped value, so a null prints as the value's null does.
+func (f Field[T, L]) String() string {
+	return fmt.Sprint(f.Val)
+}
@@ -0,0 +1,89 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// dashLiteral is a NullLiteral defined outside the package's own literals.
+type dashLiteral struct{}
+
+func (dashLiteral) NullJSON() []byte { return []byte(`"-"`) }
+
+type fieldDocument struct {
+	Name    Field[String, LiteralEmptyString] `json:"name"`
+	Email   Field[String, LiteralNull]        `json:"email"`
+	Count   Field[Int, LiteralNullString]     `json:"count"`
+	Score   Field[Float64, dashLiteral]       `json:"score"`
+	Phone   Field[String, LiteralNull]        `json:"phone,omitzero"`
+	Comment String                            `json:"comment"`
+}
+
+func TestFieldMarshal(t *testing.T) {
+	var doc fieldDocument
+	data, err := json.Marshal(doc)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":"","email":null,"count":"null","score":"-","comment":null}`, "null fields")
+
+	doc = fieldDocument{
+		Name:    FieldFrom[String, LiteralEmptyString](StringFrom("alice")),
+		Email:   FieldFrom[String, LiteralNull](StringFrom("alice@example.com")),
+		Count:   FieldFrom[Int, LiteralNullString](IntFrom(0)),
+		Score:   FieldFrom[Float64, dashLiteral](Float64From(1.5)),
+		Phone:   FieldFrom[String, LiteralNull](StringFrom("")),
+		Comment: StringFrom("hi"),
+	}
+	data, err = json.Marshal(doc)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":"alice","email":"alice@example.com","count":0,"score":1.5,"phone":"","comment":"hi"}`, "valid fields")
+}
+
+func TestFieldUnmarshal(t *testing.T) {
+	var doc fieldDocument
+	err := json.Unmarshal([]byte(`{"name":"","email":null,"count":"null","score":"-","phone":null,"comment":null}`), &doc)
+	maybePanic(err)
+	if !doc.Name.IsZero() || !doc.Email.IsZero() || !doc.Count.IsZero() || !doc.Score.IsZero() || !doc.Phone.IsZero() {
+		t.Errorf("every literal should decode as null: %+v", doc)
+	}
+
+	// JSON null is still null whatever the literal
+	err = json.Unmarshal([]byte(`{"name":null,"count":null,"score":null}`), &doc)
+	maybePanic(err)
+	if !doc.Name.IsZero() || !doc.Count.IsZero() || !doc.Score.IsZero() {
+		t.Errorf("JSON null should decode as null: %+v", doc)
+	}
+
+	err = json.Unmarshal([]byte(`{"name":"bob","count":"7","score":2.5}`), &doc)
+	maybePanic(err)
+	if doc.Name.Val != StringFrom("bob") || doc.Count.Val != IntFrom(7) || doc.Score.Val != Float64From(2.5) {
+		t.Errorf("bad valid fields: %+v", doc)
+	}
+
+	if err := json.Unmarshal([]byte(`{"count":"seven"}`), &doc); err == nil {
+		t.Error("expected error from the wrapped value")
+	}
+}
+
+func TestFieldRoundTrip(t *testing.T) {
+	in := fieldDocument{
+		Name:  FieldFrom[String, LiteralEmptyString](NewString("", false)),
+		Email: FieldFrom[String, LiteralNull](StringFrom("x")),
+		Count: FieldFrom[Int, LiteralNullString](NewInt(0, false)),
+		Score: FieldFrom[Float64, dashLiteral](Float64From(-1)),
+	}
+	data, err := json.Marshal(in)
+	maybePanic(err)
+	var out fieldDocument
+	maybePanic(json.Unmarshal(data, &out))
+	if !out.Name.Val.Equal(in.Name.Val) || !out.Email.Val.Equal(in.Email.Val) ||
+		!out.Count.Val.Equal(in.Count.Val) || !out.Score.Val.Equal(in.Score.Val) || !out.Phone.Val.Equal(in.Phone.Val) {
+		t.Errorf("bad round trip through %s: %+v ≠ %+v", data, out, in)
+	}
+
+	if s := FieldFrom[Int, LiteralNullString](IntFrom(3)).String(); s != "3" {
+		t.Errorf("bad String(): %q", s)
+	}
+	if s := FieldFrom[Int, LiteralNullString](NewInt(0, false)).String(); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
@@ -3,10 +3,15 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Float32 is a nullable float32.
@@ -36,22 +41,37 @@ func Float32FromPtr(f *float32) Float32 {
 	return NewFloat32(*f, true)
 }
 
+// Float32FromWrapperpb creates a new Float32 from a protobuf FloatValue.
+// It will be null if w is nil.
+func Float32FromWrapperpb(w *wrapperspb.FloatValue) Float32 {
+	if w == nil {
+		return NewFloat32(0, false)
+	}
+	return NewFloat32(w.Value, true)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (f *Float32) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		f.Valid = false
 		f.Float32 = 0
+		f.Valid = false
 		return nil
 	}
 
-	var x float64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONFloat(data, 32, "Float32")
+	f.Float32, f.Valid = float32(x), valid
+	return err
+}
 
-	f.Float32 = float32(x)
-	f.Valid = true
-	return nil
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Float32
+// to def and makes it valid, rather than null.
+func (f *Float32) UnmarshalJSONDefault(data []byte, def float32) error {
+	if bytes.Equal(data, NullBytes) {
+		f.SetValid(def)
+		return nil
+	}
+	return f.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -66,18 +86,44 @@ func (f *Float32) UnmarshalText(text []byte) error {
 	if f.Valid {
 		f.Float32 = float32(res)
 	}
-	return err
+	return textOverflow("Float32", &f.Float32, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
+// NaN and infinite values cannot be represented in JSON and return an error.
+// Use MarshalJSONWith and MarshalOptions.NonFiniteAsNull to encode them as null.
 func (f Float32) MarshalJSON() ([]byte, error) {
 	if !f.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)), nil
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Float32 to dst and returns
+// the extended buffer. Like MarshalJSON, it returns an error for NaN and
+// infinite values.
+func (f Float32) AppendJSON(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return append(dst, NullBytes...), nil
+	}
+	if x := float64(f.Float32); math.IsNaN(x) || math.IsInf(x, 0) {
+		return dst, fmt.Errorf("json: cannot marshal non-finite value %v in null.Float32", x)
+	}
+	return appendJSONFloat(dst, float64(f.Float32), 32), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Float32 as chosen by opts.
+// With opts.NonFiniteAsNull, NaN and infinite values are encoded as null too.
+func (f Float32) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	if x := float64(f.Float32); opts.NonFiniteAsNull && f.Valid && (math.IsNaN(x) || math.IsInf(x, 0)) {
+		return opts.nullJSON(), nil
+	}
+	return opts.marshalJSON(f)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Float32 is null, which UnmarshalText
+// reads back as null.
 func (f Float32) MarshalText() ([]byte, error) {
 	if !f.Valid {
 		return []byte{}, nil
@@ -85,12 +131,127 @@ func (f Float32) MarshalText() ([]byte, error) {
 	return []byte(strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)), nil
 }
 
+// MarshalTextWith is like MarshalText, but encodes a null Float32 as opts.NullText.
+func (f Float32) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(f, f.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Float32 is null.
+func (f Float32) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, f, f.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (f *Float32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, f)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Float32 is null.
+func (f Float32) MarshalYAML() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return f.Float32, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (f *Float32) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *float32
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		f.Float32 = 0
+		f.Valid = false
+		return nil
+	}
+	f.Float32 = *v
+	f.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Float32 is null.
+func (f Float32) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !f.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeFloat32(f.Float32)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (f *Float32) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *float32
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		f.Float32 = 0
+		f.Valid = false
+		return nil
+	}
+	f.Float32 = *v
+	f.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Float32 is encoded as a single byte.
+func (f Float32) MarshalBinary() ([]byte, error) {
+	if !f.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.BigEndian.AppendUint32([]byte{encodedValid}, math.Float32bits(f.Float32)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Float32) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Float32")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		f.Float32 = 0
+		f.Valid = false
+		return nil
+	}
+	value, err = decodeFixed(value, 4, "Float32")
+	if err != nil {
+		return err
+	}
+	f.Float32 = math.Float32frombits(binary.BigEndian.Uint32(value))
+	f.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (f Float32) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (f *Float32) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
 // SetValid changes this Float32's value and also sets it to be non-null.
 func (f *Float32) SetValid(n float32) {
 	f.Float32 = n
 	f.Valid = true
 }
 
+// SetNull sets this Float32 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (f *Float32) SetNull() {
+	f.Float32 = 0
+	f.Valid = false
+}
+
 // Ptr returns a pointer to this Float32's value, or a nil pointer if this Float32 is null.
 func (f Float32) Ptr() *float32 {
 	if !f.Valid {
@@ -99,19 +260,138 @@ func (f Float32) Ptr() *float32 {
 	return &f.Float32
 }
 
-// IsZero returns true for invalid Float32s, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Float32) ValueOrZero() float32 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float32
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (f Float32) ValueOr(def float32) float32 {
+	if !f.Valid {
+		return def
+	}
+	return f.Float32
+}
+
+// Or returns this Float32 if it is valid, otherwise other.
+func (f Float32) Or(other Float32) Float32 {
+	if !f.Valid {
+		return other
+	}
+	return f
+}
+
+// MustValue returns the inner value, and panics if this Float32 is null.
+func (f Float32) MustValue() float32 {
+	if !f.Valid {
+		panic("null.Float32: MustValue called on invalid value")
+	}
+	return f.Float32
+}
+
+// Map returns a new Float32 holding fn applied to this Float32's value. A null Float32 is
+// returned unchanged and fn is not called.
+func (f Float32) Map(fn func(float32) float32) Float32 {
+	if !f.Valid {
+		return f
+	}
+	return NewFloat32(fn(f.Float32), true)
+}
+
+// Round returns this Float32 rounded to places decimal places, as for
+// Float64.Round but working from the exact float32 value. A null Float32, NaN
+// and infinities are returned unchanged.
+func (f Float32) Round(places int) Float32 {
+	if x := float64(f.Float32); !f.Valid || math.IsNaN(x) || math.IsInf(x, 0) {
+		return f
+	}
+	if places < 0 {
+		scale := math.Pow10(-places)
+		return NewFloat32(float32(math.RoundToEven(float64(f.Float32)/scale)*scale), true)
+	}
+	x, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f.Float32), 'f', places, 32), 32)
+	return NewFloat32(float32(x), true)
+}
+
+// Abs returns a new Float32 holding the absolute value of this Float32's
+// value. A null Float32 is returned unchanged, and NaN stays NaN.
+func (f Float32) Abs() Float32 {
+	if !f.Valid {
+		return f
+	}
+	return NewFloat32(float32(math.Abs(float64(f.Float32))), true)
+}
+
+// Clamp returns a new Float32 holding this Float32's value bounded to the
+// range [min, max]. A null Float32 and NaN are returned unchanged. It panics
+// if min is greater than max.
+func (f Float32) Clamp(min, max float32) Float32 {
+	if min > max {
+		panic("null.Float32: Clamp called with min greater than max")
+	}
+	if !f.Valid || math.IsNaN(float64(f.Float32)) {
+		return f
+	}
+	if f.Float32 < min {
+		return NewFloat32(min, true)
+	}
+	if f.Float32 > max {
+		return NewFloat32(max, true)
+	}
+	return f
+}
+
+// IsZero returns true if this Float32 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (f Float32) IsZero() bool {
 	return !f.Valid
 }
 
+// IsNull returns true if this Float32 is null, the same as !Valid.
+func (f Float32) IsNull() bool {
+	return !f.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Float32, or nil if it is null, for
+// a *Float32 field tagged omitempty that should leave nulls out.
+func (f Float32) OmitEmpty() *Float32 {
+	if !f.Valid {
+		return nil
+	}
+	return &f
+}
+
+// Equal returns true if both Float32s are null or both hold the same value.
+func (f Float32) Equal(other Float32) bool {
+	return f.Valid == other.Valid && (!f.Valid || f.Float32 == other.Float32)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Float32 is null.
+func (f Float32) String() string {
+	if !f.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatFloat(float64(f.Float32), 'g', -1, 32)
+}
+
 // Scan implements the Scanner interface.
+// Values beyond the float32 range return an error.
 func (f *Float32) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		f.Float32, f.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&f.Float32, value); err != nil {
+		f.Float32, f.Valid = 0, false
+		return scanError("Float32", value, &f.Float32, err)
+	}
 	f.Valid = true
-	return convert.ConvertAssign(&f.Float32, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -122,6 +402,22 @@ func (f Float32) Value() (driver.Value, error) {
 	return float64(f.Float32), nil
 }
 
+// ValueOrNil returns nil if this Float32 is null, otherwise the same value as Value.
+func (f Float32) ValueOrNil() interface{} {
+	if !f.Valid {
+		return nil
+	}
+	return float64(f.Float32)
+}
+
+// ToWrapperpb returns this Float32 as a protobuf FloatValue, or nil if this Float32 is null.
+func (f Float32) ToWrapperpb() *wrapperspb.FloatValue {
+	if !f.Valid {
+		return nil
+	}
+	return wrapperspb.Float(f.Float32)
+}
+
 // Randomize for sqlboiler
 func (f *Float32) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
@@ -2,6 +2,9 @@ package null
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -130,6 +133,15 @@ func TestFloat32SetValid(t *testing.T) {
 	assertFloat32(t, change, "SetValid()")
 }
 
+func TestFloat32SetNull(t *testing.T) {
+	change := Float32From(1.2345)
+	change.SetNull()
+	assertNullFloat32(t, change, "SetNull()")
+	if change.Float32 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Float32)
+	}
+}
+
 func TestFloat32Scan(t *testing.T) {
 	var f Float32
 	err := f.Scan(1.2345)
@@ -142,6 +154,246 @@ func TestFloat32Scan(t *testing.T) {
 	assertNullFloat32(t, null, "scanned null")
 }
 
+func TestFloat32Equal(t *testing.T) {
+	null := NewFloat32(0, false)
+	other := NewFloat32(1.2345, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Float32From(1.2345)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Float32From(1.2345)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Float32From(5.4321)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestFloat32ValueOr(t *testing.T) {
+	valid := Float32From(1.2345)
+	if valid.ValueOrZero() != 1.2345 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(5.4321) != 1.2345 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(5.4321))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewFloat32(1.2345, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(5.4321) != 5.4321 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(5.4321))
+	}
+}
+
+func TestFloat32String(t *testing.T) {
+	v := Float32From(1.2345)
+	if s := v.String(); s != "1.2345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewFloat32(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestFloat32ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Float32From(1.2345), "valid")
+	assertValueOrNil(t, NewFloat32(0, false), "null")
+}
+
+func TestFloat32MustValue(t *testing.T) {
+	v := Float32From(1.2345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewFloat32(0, false).MustValue() }, "Float32")
+}
+
+func TestFloat32Map(t *testing.T) {
+	mapped := Float32From(1.5).Map(func(v float32) float32 { return v * 2 })
+	if !mapped.Valid || mapped.Float32 != 3 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewFloat32(0, false).Map(func(v float32) float32 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullFloat32(t, null, "Map() null")
+}
+
+func TestFloat32RoundAbsClamp(t *testing.T) {
+	rounds := []struct {
+		in     float32
+		places int
+		want   float32
+	}{
+		{2.5, 0, 2},
+		{1.2345, 2, 1.23},
+		{0.125, 2, 0.12},
+		{-1.23456, 4, -1.2346},
+		{1250, -2, 1200},
+	}
+	for _, test := range rounds {
+		got := Float32From(test.in).Round(test.places)
+		if !got.Valid || got.Float32 != test.want {
+			t.Errorf("Round(%v, %d): %v ≠ %v", test.in, test.places, got, test.want)
+		}
+	}
+
+	if abs := Float32From(-1.5).Abs(); !abs.Valid || abs.Float32 != 1.5 {
+		t.Errorf("bad Abs(): %#v", abs)
+	}
+	clamps := []struct{ in, want float32 }{{-5, 0}, {5, 5}, {15, 10}}
+	for _, test := range clamps {
+		if got := Float32From(test.in).Clamp(0, 10); !got.Valid || got.Float32 != test.want {
+			t.Errorf("Clamp(%v): %v ≠ %v", test.in, got, test.want)
+		}
+	}
+
+	for name, f := range map[string]Float32{
+		"Round": NewFloat32(-1.5, false).Round(0),
+		"Abs":   NewFloat32(-1.5, false).Abs(),
+		"Clamp": NewFloat32(-1.5, false).Clamp(0, 10),
+	} {
+		if f.Valid || f.Float32 != -1.5 {
+			t.Errorf("%s() should pass a null through unchanged: %#v", name, f)
+		}
+	}
+	nan := float32(math.NaN())
+	for name, f := range map[string]Float32{
+		"Round": Float32From(nan).Round(2),
+		"Abs":   Float32From(nan).Abs(),
+		"Clamp": Float32From(nan).Clamp(0, 10),
+	} {
+		if !f.Valid || !math.IsNaN(float64(f.Float32)) {
+			t.Errorf("%s() should keep NaN: %#v", name, f)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Clamp() should panic when min is greater than max")
+		}
+	}()
+	Float32From(1).Clamp(10, 0)
+}
+
+func TestMarshalFloat32NonFinite(t *testing.T) {
+	for _, x := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		v := Float32From(float32(x))
+		if _, err := json.Marshal(v); err == nil {
+			t.Errorf("%v: expected error", x)
+		}
+		if _, err := v.MarshalJSONWith(MarshalOptions{}); err == nil {
+			t.Errorf("%v: expected error from MarshalJSONWith", x)
+		}
+
+		data, err := v.MarshalJSONWith(MarshalOptions{NonFiniteAsNull: true})
+		maybePanic(err)
+		assertJSONEquals(t, data, "null", "non-finite as null")
+
+		data, err = v.MarshalJSONWith(MarshalOptions{Null: NullAsString, NonFiniteAsNull: true})
+		maybePanic(err)
+		assertJSONEquals(t, data, `"null"`, "non-finite as null string")
+	}
+
+	// finite values are unaffected
+	data, err := Float32From(1.2345).MarshalJSONWith(MarshalOptions{NonFiniteAsNull: true})
+	maybePanic(err)
+	assertJSONEquals(t, data, "1.2345", "finite with NonFiniteAsNull")
+}
+
+func TestUnmarshalFloat32NonFinite(t *testing.T) {
+	for _, in := range []string{"NaN", "Infinity", "-Infinity"} {
+		var f Float32
+		err := f.UnmarshalJSON([]byte(in))
+		if err == nil || !strings.Contains(err.Error(), "NaN and Infinity are not valid JSON numbers") {
+			t.Errorf("%s: bad error: %v", in, err)
+		}
+		assertNullFloat32(t, f, in)
+	}
+}
+
+func TestUnmarshalFloat32Range(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{in: "3.4028234663852886e38", valid: true},
+		{in: "-3.4028234663852886e38", valid: true},
+		{in: "3.5e38", valid: false},
+		{in: "1e40", valid: false},
+		{in: "-1e40", valid: false},
+		// smallest normal and subnormal float32 values
+		{in: "1.1754943508222875e-38", valid: true},
+		{in: "1.401298464324817e-45", valid: true},
+		// too small for a subnormal, rounds to zero
+		{in: "1e-50", valid: true},
+	}
+
+	for _, test := range tests {
+		var f Float32
+		err := json.Unmarshal([]byte(test.in), &f)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.in, err)
+			}
+			if !f.Valid || math.IsInf(float64(f.Float32), 0) {
+				t.Errorf("%s: bad float32: %#v", test.in, f)
+			}
+			continue
+		}
+		want := "json: " + test.in + " overflows max float32 value"
+		if strings.HasPrefix(test.in, "-") {
+			want = "json: " + test.in + " underflows min float32 value"
+		}
+		if err == nil || err.Error() != want {
+			t.Errorf("%s: bad error: %v", test.in, err)
+		}
+		assertNullFloat32(t, f, test.in)
+	}
+
+	var sub Float32
+	err := json.Unmarshal([]byte("1.401298464324817e-45"), &sub)
+	maybePanic(err)
+	if sub.Float32 != math.SmallestNonzeroFloat32 {
+		t.Errorf("bad subnormal float32: %v", sub.Float32)
+	}
+}
+
+func TestFloat32ScanRange(t *testing.T) {
+	var f Float32
+	err := f.Scan(float64(math.MaxFloat32))
+	maybePanic(err)
+	if !f.Valid || f.Float32 != math.MaxFloat32 {
+		t.Errorf("bad max float32: %#v", f)
+	}
+
+	var overflow Float32
+	err = overflow.Scan(1e40)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullFloat32(t, overflow, "scanned overflow")
+
+	var str Float32
+	err = str.Scan([]byte("1e40"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullFloat32(t, str, "scanned overflow string")
+}
+
 func assertFloat32(t *testing.T, f Float32, from string) {
 	if f.Float32 != 1.2345 {
 		t.Errorf("bad %s float32: %f ≠ %f\n", from, f.Float32, 1.2345)
@@ -156,3 +408,18 @@ func assertNullFloat32(t *testing.T, f Float32, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestMarshalFloat32MatchesEncodingJSON(t *testing.T) {
+	values := []float32{
+		0, 1, -1, 0.1, 1.5, 1e6, 1e15, 1e20, 1e21, -1e21, math.MaxFloat32, 1e-6, 1e-7, 1.5e-10, math.SmallestNonzeroFloat32,
+	}
+	for _, x := range values {
+		want, err := json.Marshal(x)
+		maybePanic(err)
+		data, err := json.Marshal(Float32From(x))
+		maybePanic(err)
+		if string(data) != string(want) {
+			t.Errorf("bad json marshal of %v: %s ≠ %s", x, data, want)
+		}
+	}
+}
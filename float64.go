@@ -2,11 +2,17 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Float64 is a nullable float64.
@@ -36,7 +42,22 @@ func Float64FromPtr(f *float64) Float64 {
 	return NewFloat64(*f, true)
 }
 
+// Float64FromSQL creates a new Float64 from the database/sql NullFloat64 n.
+func Float64FromSQL(n sql.NullFloat64) Float64 {
+	return NewFloat64(n.Float64, n.Valid)
+}
+
+// Float64FromWrapperpb creates a new Float64 from a protobuf DoubleValue.
+// It will be null if w is nil.
+func Float64FromWrapperpb(w *wrapperspb.DoubleValue) Float64 {
+	if w == nil {
+		return NewFloat64(0, false)
+	}
+	return NewFloat64(w.Value, true)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (f *Float64) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
 		f.Float64 = 0
@@ -44,12 +65,29 @@ func (f *Float64) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &f.Float64); err != nil {
-		return err
+	x, valid, err := parseJSONFloat(data, 64, "Float64")
+	f.Float64, f.Valid = x, valid
+	return err
+}
+
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Float64
+// to def and makes it valid, rather than null.
+func (f *Float64) UnmarshalJSONDefault(data []byte, def float64) error {
+	if bytes.Equal(data, NullBytes) {
+		f.SetValid(def)
+		return nil
 	}
+	return f.UnmarshalJSON(data)
+}
 
-	f.Valid = true
-	return nil
+// isNonFiniteJSON reports whether data is one of the NaN or Infinity tokens
+// that some encoders emit for non-finite floats, which are not valid JSON.
+func isNonFiniteJSON(data []byte) bool {
+	switch string(bytes.TrimSpace(data)) {
+	case "NaN", "Infinity", "+Infinity", "-Infinity":
+		return true
+	}
+	return false
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -61,18 +99,48 @@ func (f *Float64) UnmarshalText(text []byte) error {
 	var err error
 	f.Float64, err = strconv.ParseFloat(string(text), 64)
 	f.Valid = err == nil
-	return err
+	return textOverflow("Float64", &f.Float64, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
+// NaN and infinite values cannot be represented in JSON and return an error.
+// Use MarshalJSONWith and MarshalOptions.NonFiniteAsNull to encode them as null.
 func (f Float64) MarshalJSON() ([]byte, error) {
 	if !f.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Float64 to dst and returns
+// the extended buffer. Like MarshalJSON, it returns an error for NaN and
+// infinite values.
+func (f Float64) AppendJSON(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return append(dst, NullBytes...), nil
+	}
+	if x := f.Float64; math.IsNaN(x) || math.IsInf(x, 0) {
+		return dst, fmt.Errorf("json: cannot marshal non-finite value %v in null.Float64", x)
+	}
+	return appendJSONFloat(dst, f.Float64, 64), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Float64 as chosen by opts.
+// With opts.NonFiniteAsNull, NaN and infinite values are encoded as null too, and
+// opts.FloatPlaces fixes the number of decimal places.
+func (f Float64) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	if x := f.Float64; opts.NonFiniteAsNull && f.Valid && (math.IsNaN(x) || math.IsInf(x, 0)) {
+		return opts.nullJSON(), nil
+	}
+	if x := f.Float64; opts.FloatPlaces > 0 && f.Valid && !math.IsNaN(x) && !math.IsInf(x, 0) {
+		return strconv.AppendFloat(nil, x, 'f', opts.FloatPlaces, 64), nil
+	}
+	return opts.marshalJSON(f)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Float64 is null, which UnmarshalText
+// reads back as null.
 func (f Float64) MarshalText() ([]byte, error) {
 	if !f.Valid {
 		return []byte{}, nil
@@ -80,12 +148,127 @@ func (f Float64) MarshalText() ([]byte, error) {
 	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
 }
 
+// MarshalTextWith is like MarshalText, but encodes a null Float64 as opts.NullText.
+func (f Float64) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(f, f.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Float64 is null.
+func (f Float64) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, f, f.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (f *Float64) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, f)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Float64 is null.
+func (f Float64) MarshalYAML() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return f.Float64, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (f *Float64) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *float64
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		f.Float64 = 0
+		f.Valid = false
+		return nil
+	}
+	f.Float64 = *v
+	f.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Float64 is null.
+func (f Float64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !f.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeFloat64(f.Float64)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (f *Float64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *float64
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		f.Float64 = 0
+		f.Valid = false
+		return nil
+	}
+	f.Float64 = *v
+	f.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Float64 is encoded as a single byte.
+func (f Float64) MarshalBinary() ([]byte, error) {
+	if !f.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.BigEndian.AppendUint64([]byte{encodedValid}, math.Float64bits(f.Float64)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Float64) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Float64")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		f.Float64 = 0
+		f.Valid = false
+		return nil
+	}
+	value, err = decodeFixed(value, 8, "Float64")
+	if err != nil {
+		return err
+	}
+	f.Float64 = math.Float64frombits(binary.BigEndian.Uint64(value))
+	f.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (f Float64) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (f *Float64) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
 // SetValid changes this Float64's value and also sets it to be non-null.
 func (f *Float64) SetValid(n float64) {
 	f.Float64 = n
 	f.Valid = true
 }
 
+// SetNull sets this Float64 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (f *Float64) SetNull() {
+	f.Float64 = 0
+	f.Valid = false
+}
+
 // Ptr returns a pointer to this Float64's value, or a nil pointer if this Float64 is null.
 func (f Float64) Ptr() *float64 {
 	if !f.Valid {
@@ -94,19 +277,158 @@ func (f Float64) Ptr() *float64 {
 	return &f.Float64
 }
 
-// IsZero returns true for invalid Float64s, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Float64) ValueOrZero() float64 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float64
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (f Float64) ValueOr(def float64) float64 {
+	if !f.Valid {
+		return def
+	}
+	return f.Float64
+}
+
+// Or returns this Float64 if it is valid, otherwise other.
+func (f Float64) Or(other Float64) Float64 {
+	if !f.Valid {
+		return other
+	}
+	return f
+}
+
+// MustValue returns the inner value, and panics if this Float64 is null.
+func (f Float64) MustValue() float64 {
+	if !f.Valid {
+		panic("null.Float64: MustValue called on invalid value")
+	}
+	return f.Float64
+}
+
+// Map returns a new Float64 holding fn applied to this Float64's value. A null Float64 is
+// returned unchanged and fn is not called.
+func (f Float64) Map(fn func(float64) float64) Float64 {
+	if !f.Valid {
+		return f
+	}
+	return NewFloat64(fn(f.Float64), true)
+}
+
+// Round returns this Float64 rounded to places decimal places, with ties
+// rounded to even, so 0.125 rounds to 0.12 for places 2. The exact binary
+// value is rounded, so 2.675, which is stored as 2.67499..., rounds to 2.67.
+// A negative places rounds to tens, hundreds and so on. A null Float64, NaN
+// and infinities are returned unchanged.
+func (f Float64) Round(places int) Float64 {
+	if x := f.Float64; !f.Valid || math.IsNaN(x) || math.IsInf(x, 0) {
+		return f
+	}
+	if places < 0 {
+		scale := math.Pow10(-places)
+		return NewFloat64(math.RoundToEven(f.Float64/scale)*scale, true)
+	}
+	x, _ := strconv.ParseFloat(strconv.FormatFloat(f.Float64, 'f', places, 64), 64)
+	return NewFloat64(x, true)
+}
+
+// Abs returns a new Float64 holding the absolute value of this Float64's
+// value. A null Float64 is returned unchanged, and NaN stays NaN.
+func (f Float64) Abs() Float64 {
+	if !f.Valid {
+		return f
+	}
+	return NewFloat64(math.Abs(f.Float64), true)
+}
+
+// Clamp returns a new Float64 holding this Float64's value bounded to the
+// range [min, max]. A null Float64 and NaN are returned unchanged. It panics
+// if min is greater than max.
+func (f Float64) Clamp(min, max float64) Float64 {
+	if min > max {
+		panic("null.Float64: Clamp called with min greater than max")
+	}
+	if !f.Valid || math.IsNaN(f.Float64) {
+		return f
+	}
+	return NewFloat64(math.Min(math.Max(f.Float64, min), max), true)
+}
+
+// IsZero returns true if this Float64 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (f Float64) IsZero() bool {
 	return !f.Valid
 }
 
+// IsNull returns true if this Float64 is null, the same as !Valid.
+func (f Float64) IsNull() bool {
+	return !f.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Float64, or nil if it is null, for
+// a *Float64 field tagged omitempty that should leave nulls out.
+func (f Float64) OmitEmpty() *Float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f
+}
+
+// Equal returns true if both Float64s are null or both hold the same value.
+func (f Float64) Equal(other Float64) bool {
+	return f.Valid == other.Valid && (!f.Valid || f.Float64 == other.Float64)
+}
+
+// Compare returns -1 if this Float64 sorts before other, 0 if they are equal
+// and +1 if it sorts after. A null Float64 sorts before every valid one, like
+// SQL's NULLS FIRST, and NaN sorts before every other number. It can be used
+// with sort.Slice and slices.SortFunc.
+func (f Float64) Compare(other Float64) int {
+	switch {
+	case !f.Valid && !other.Valid:
+		return 0
+	case !f.Valid:
+		return -1
+	case !other.Valid:
+		return 1
+	}
+	aNaN, bNaN := math.IsNaN(f.Float64), math.IsNaN(other.Float64)
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN, f.Float64 < other.Float64:
+		return -1
+	case bNaN, f.Float64 > other.Float64:
+		return 1
+	}
+	return 0
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Float64 is null.
+func (f Float64) String() string {
+	if !f.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatFloat(f.Float64, 'g', -1, 64)
+}
+
 // Scan implements the Scanner interface.
 func (f *Float64) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		f.Float64, f.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&f.Float64, value); err != nil {
+		f.Float64, f.Valid = 0, false
+		return scanError("Float64", value, &f.Float64, err)
+	}
 	f.Valid = true
-	return convert.ConvertAssign(&f.Float64, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -117,6 +439,27 @@ func (f Float64) Value() (driver.Value, error) {
 	return f.Float64, nil
 }
 
+// ValueOrNil returns nil if this Float64 is null, otherwise the same value as Value.
+func (f Float64) ValueOrNil() interface{} {
+	if !f.Valid {
+		return nil
+	}
+	return f.Float64
+}
+
+// ToSQL returns this Float64 as a database/sql NullFloat64.
+func (f Float64) ToSQL() sql.NullFloat64 {
+	return sql.NullFloat64{Float64: f.Float64, Valid: f.Valid}
+}
+
+// ToWrapperpb returns this Float64 as a protobuf DoubleValue, or nil if this Float64 is null.
+func (f Float64) ToWrapperpb() *wrapperspb.DoubleValue {
+	if !f.Valid {
+		return nil
+	}
+	return wrapperspb.Double(f.Float64)
+}
+
 // Randomize for sqlboiler
 func (f *Float64) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
@@ -2,6 +2,10 @@ package null
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -130,6 +134,15 @@ func TestFloat64SetValid(t *testing.T) {
 	assertFloat64(t, change, "SetValid()")
 }
 
+func TestFloat64SetNull(t *testing.T) {
+	change := Float64From(1.2345)
+	change.SetNull()
+	assertNullFloat64(t, change, "SetNull()")
+	if change.Float64 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Float64)
+	}
+}
+
 func TestFloat64Scan(t *testing.T) {
 	var f Float64
 	err := f.Scan(1.2345)
@@ -142,6 +155,258 @@ func TestFloat64Scan(t *testing.T) {
 	assertNullFloat64(t, null, "scanned null")
 }
 
+func TestFloat64Equal(t *testing.T) {
+	null := NewFloat64(0, false)
+	other := NewFloat64(1.2345, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Float64From(1.2345)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Float64From(1.2345)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Float64From(5.4321)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestFloat64Compare(t *testing.T) {
+	null := NewFloat64(0, false)
+	nan := Float64From(math.NaN())
+	vals := []Float64{Float64From(1.5), nan, null, Float64From(math.Inf(-1)), Float64From(-2)}
+	sort.Slice(vals, func(i, j int) bool { return vals[i].Compare(vals[j]) < 0 })
+	if vals[0].Valid || !math.IsNaN(vals[1].Float64) {
+		t.Errorf("null then NaN should sort first: %v", vals)
+	}
+	want := []float64{math.Inf(-1), -2, 1.5}
+	for i, f := range want {
+		if vals[i+2].Float64 != f {
+			t.Errorf("bad sorted value %d: %v ≠ %v", i+2, vals[i+2], f)
+		}
+	}
+
+	if c := nan.Compare(nan); c != 0 {
+		t.Errorf("NaN should compare equal to NaN, got %d", c)
+	}
+	if c := null.Compare(nan); c != -1 {
+		t.Errorf("null should sort before NaN, got %d", c)
+	}
+}
+
+func TestFloat64ValueOr(t *testing.T) {
+	valid := Float64From(1.2345)
+	if valid.ValueOrZero() != 1.2345 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(5.4321) != 1.2345 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(5.4321))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewFloat64(1.2345, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(5.4321) != 5.4321 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(5.4321))
+	}
+}
+
+func TestFloat64String(t *testing.T) {
+	v := Float64From(1.2345)
+	if s := v.String(); s != "1.2345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewFloat64(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestFloat64ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Float64From(1.2345), "valid")
+	assertValueOrNil(t, NewFloat64(0, false), "null")
+}
+
+func TestFloat64MustValue(t *testing.T) {
+	v := Float64From(1.2345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewFloat64(0, false).MustValue() }, "Float64")
+}
+
+func TestFloat64Map(t *testing.T) {
+	mapped := Float64From(1.5).Map(func(v float64) float64 { return v * 2 })
+	if !mapped.Valid || mapped.Float64 != 3 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewFloat64(0, false).Map(func(v float64) float64 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullFloat64(t, null, "Map() null")
+}
+
+func TestMarshalFloat64NonFinite(t *testing.T) {
+	for _, x := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		v := Float64From(x)
+		if _, err := json.Marshal(v); err == nil {
+			t.Errorf("%v: expected error", x)
+		}
+		if _, err := v.MarshalJSONWith(MarshalOptions{}); err == nil {
+			t.Errorf("%v: expected error from MarshalJSONWith", x)
+		}
+
+		data, err := v.MarshalJSONWith(MarshalOptions{NonFiniteAsNull: true})
+		maybePanic(err)
+		assertJSONEquals(t, data, "null", "non-finite as null")
+
+		data, err = v.MarshalJSONWith(MarshalOptions{Null: NullAsString, NonFiniteAsNull: true})
+		maybePanic(err)
+		assertJSONEquals(t, data, `"null"`, "non-finite as null string")
+	}
+
+	// finite values are unaffected
+	data, err := Float64From(1.2345).MarshalJSONWith(MarshalOptions{NonFiniteAsNull: true})
+	maybePanic(err)
+	assertJSONEquals(t, data, "1.2345", "finite with NonFiniteAsNull")
+}
+
+func TestFloat64Round(t *testing.T) {
+	tests := []struct {
+		in     float64
+		places int
+		want   float64
+	}{
+		{0.5, 0, 0},
+		{1.5, 0, 2},
+		{2.5, 0, 2},
+		{-2.5, 0, -2},
+		{0.125, 2, 0.12},
+		{0.375, 2, 0.38},
+		{2.675, 2, 2.67},
+		{1.005, 2, 1},
+		{1.2345, 3, 1.234},
+		{1.23456, 4, 1.2346},
+		{-1.23456, 4, -1.2346},
+		{1234.5, -1, 1230},
+		{1250, -2, 1200},
+		{1350, -2, 1400},
+		{1e300, 2, 1e300},
+	}
+	for _, test := range tests {
+		got := Float64From(test.in).Round(test.places)
+		if !got.Valid || got.Float64 != test.want {
+			t.Errorf("Round(%v, %d): %v ≠ %v", test.in, test.places, got, test.want)
+		}
+	}
+
+	null := NewFloat64(1.5, false).Round(0)
+	if null.Valid || null.Float64 != 1.5 {
+		t.Errorf("Round() should pass a null through unchanged: %#v", null)
+	}
+	if nan := Float64From(math.NaN()).Round(2); !nan.Valid || !math.IsNaN(nan.Float64) {
+		t.Errorf("Round() should pass NaN through unchanged: %#v", nan)
+	}
+}
+
+func TestFloat64AbsClamp(t *testing.T) {
+	if abs := Float64From(-1.5).Abs(); !abs.Valid || abs.Float64 != 1.5 {
+		t.Errorf("bad Abs(): %#v", abs)
+	}
+	if abs := Float64From(math.Inf(-1)).Abs(); !abs.Valid || !math.IsInf(abs.Float64, 1) {
+		t.Errorf("bad Abs() of -Inf: %#v", abs)
+	}
+
+	tests := []struct{ in, want float64 }{
+		{-5, 0},
+		{0, 0},
+		{5, 5},
+		{10, 10},
+		{15, 10},
+		{math.Inf(1), 10},
+		{math.Inf(-1), 0},
+	}
+	for _, test := range tests {
+		if got := Float64From(test.in).Clamp(0, 10); !got.Valid || got.Float64 != test.want {
+			t.Errorf("Clamp(%v): %v ≠ %v", test.in, got, test.want)
+		}
+	}
+
+	for name, f := range map[string]Float64{
+		"Abs":   NewFloat64(-1.5, false).Abs(),
+		"Clamp": NewFloat64(-1.5, false).Clamp(0, 10),
+	} {
+		if f.Valid || f.Float64 != -1.5 {
+			t.Errorf("%s() should pass a null through unchanged: %#v", name, f)
+		}
+	}
+	if nan := Float64From(math.NaN()).Abs(); !nan.Valid || !math.IsNaN(nan.Float64) {
+		t.Errorf("Abs() should keep NaN: %#v", nan)
+	}
+	if nan := Float64From(math.NaN()).Clamp(0, 10); !nan.Valid || !math.IsNaN(nan.Float64) {
+		t.Errorf("Clamp() should pass NaN through unchanged: %#v", nan)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Clamp() should panic when min is greater than max")
+		}
+	}()
+	Float64From(1).Clamp(10, 0)
+}
+
+func TestMarshalFloat64Places(t *testing.T) {
+	opts := MarshalOptions{FloatPlaces: 2}
+	tests := map[float64]string{
+		1.5:    "1.50",
+		0:      "0.00",
+		0.125:  "0.12",
+		0.375:  "0.38",
+		-1.005: "-1.00",
+		12345:  "12345.00",
+	}
+	for in, want := range tests {
+		data, err := Float64From(in).MarshalJSONWith(opts)
+		maybePanic(err)
+		assertJSONEquals(t, data, want, "FloatPlaces json marshal")
+	}
+
+	data, err := NewFloat64(1.5, false).MarshalJSONWith(opts)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null with FloatPlaces")
+
+	data, err = NewFloat64(0, false).MarshalJSONWith(MarshalOptions{Null: NullAsString, FloatPlaces: 2})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"null"`, "null string with FloatPlaces")
+
+	if _, err := Float64From(math.Inf(1)).MarshalJSONWith(opts); err == nil {
+		t.Error("expected error for an infinite value with FloatPlaces")
+	}
+	data, err = Float64From(math.NaN()).MarshalJSONWith(MarshalOptions{FloatPlaces: 2, NonFiniteAsNull: true})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "NaN with FloatPlaces and NonFiniteAsNull")
+}
+
+func TestUnmarshalFloat64NonFinite(t *testing.T) {
+	for _, in := range []string{"NaN", "Infinity", "-Infinity"} {
+		var f Float64
+		err := f.UnmarshalJSON([]byte(in))
+		if err == nil || !strings.Contains(err.Error(), "NaN and Infinity are not valid JSON numbers") {
+			t.Errorf("%s: bad error: %v", in, err)
+		}
+		assertNullFloat64(t, f, in)
+	}
+}
+
 func assertFloat64(t *testing.T, f Float64, from string) {
 	if f.Float64 != 1.2345 {
 		t.Errorf("bad %s float64: %f ≠ %f\n", from, f.Float64, 1.2345)
@@ -156,3 +421,19 @@ func assertNullFloat64(t *testing.T, f Float64, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestMarshalFloat64MatchesEncodingJSON(t *testing.T) {
+	values := []float64{
+		0, math.Copysign(0, -1), 1, -1, 0.1, 1.5, 1e6, 1e15, 1000000000000000, 1e20, 123456789012345678,
+		1e21, -1e21, 1.5e300, math.MaxFloat64, 1e-6, 0.000001234, 1e-7, -1e-7, 1.5e-10, 5e-324, math.SmallestNonzeroFloat64,
+	}
+	for _, x := range values {
+		want, err := json.Marshal(x)
+		maybePanic(err)
+		data, err := json.Marshal(Float64From(x))
+		maybePanic(err)
+		if string(data) != string(want) {
+			t.Errorf("bad json marshal of %v: %s ≠ %s", x, data, want)
+		}
+	}
+}
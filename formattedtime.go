@@ -0,0 +1,237 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// FormattedTime is a nullable time.Time that marshals to and from text and
+// JSON using its own Layout, such as "2006-01-02 15:04:05" or time.DateOnly.
+// An empty Layout means time.RFC3339Nano, the layout used by Time.
+//
+// To unmarshal a layout other than the default, set Layout before decoding:
+//
+//	v := struct{ At FormattedTime }{At: FormattedTime{Layout: time.DateOnly}}
+//	err := json.Unmarshal(data, &v)
+type FormattedTime struct {
+	Time   time.Time
+	Valid  bool
+	Layout string
+}
+
+// NewFormattedTime creates a new FormattedTime.
+func NewFormattedTime(t time.Time, layout string, valid bool) FormattedTime {
+	return FormattedTime{
+		Time:   t,
+		Valid:  valid,
+		Layout: layout,
+	}
+}
+
+// FormattedTimeFrom creates a new FormattedTime that will always be valid.
+func FormattedTimeFrom(t time.Time, layout string) FormattedTime {
+	return NewFormattedTime(t, layout, true)
+}
+
+// FormattedTimeFromPtr creates a new FormattedTime that will be null if t is nil.
+func FormattedTimeFromPtr(t *time.Time, layout string) FormattedTime {
+	if t == nil {
+		return NewFormattedTime(time.Time{}, layout, false)
+	}
+	return NewFormattedTime(*t, layout, true)
+}
+
+// layout returns the layout used for text and JSON.
+func (t FormattedTime) layout() string {
+	if t.Layout == "" {
+		return time.RFC3339Nano
+	}
+	return t.Layout
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a JSON string in this FormattedTime's layout. A blank string will be null.
+func (t *FormattedTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		t.Time = time.Time{}
+		t.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Time, t.Valid = time.Time{}, false
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.FormattedTime", data)
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses text in this FormattedTime's layout. Blank text will be null.
+func (t *FormattedTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.Time = time.Time{}
+		t.Valid = false
+		return nil
+	}
+
+	var err error
+	t.Time, err = time.Parse(t.layout(), string(text))
+	t.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It encodes a JSON string in this FormattedTime's layout.
+func (t FormattedTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return NullBytes, nil
+	}
+	return json.Marshal(t.Time.Format(t.layout()))
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null FormattedTime as chosen by opts.
+func (t FormattedTime) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	if opts.TimeInUTC && t.Valid {
+		t.Time = t.Time.UTC()
+	}
+	return opts.marshalJSON(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes this FormattedTime's layout, or an empty string if null.
+func (t FormattedTime) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(t.Time.Format(t.layout())), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this FormattedTime is null.
+func (t FormattedTime) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, t, t.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (t *FormattedTime) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, t)
+}
+
+// SetValid changes this FormattedTime's value and sets it to be non-null.
+// The layout is unchanged.
+func (t *FormattedTime) SetValid(v time.Time) {
+	t.Time = v
+	t.Valid = true
+}
+
+// SetNull sets this FormattedTime to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (t *FormattedTime) SetNull() {
+	t.Time = time.Time{}
+	t.Valid = false
+}
+
+// Ptr returns a pointer to this FormattedTime's value, or a nil pointer if this FormattedTime is null.
+func (t FormattedTime) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t FormattedTime) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (t FormattedTime) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
+// Or returns this FormattedTime if it is valid, otherwise other.
+func (t FormattedTime) Or(other FormattedTime) FormattedTime {
+	if !t.Valid {
+		return other
+	}
+	return t
+}
+
+// MustValue returns the inner value, and panics if this FormattedTime is null.
+func (t FormattedTime) MustValue() time.Time {
+	if !t.Valid {
+		panic("null.FormattedTime: MustValue called on invalid value")
+	}
+	return t.Time
+}
+
+// IsZero returns true if this FormattedTime is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (t FormattedTime) IsZero() bool {
+	return !t.Valid
+}
+
+// IsNull returns true if this FormattedTime is null, the same as !Valid.
+func (t FormattedTime) IsNull() bool {
+	return !t.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this FormattedTime, or nil if it is null, for
+// a *FormattedTime field tagged omitempty that should leave nulls out.
+func (t FormattedTime) OmitEmpty() *FormattedTime {
+	if !t.Valid {
+		return nil
+	}
+	return &t
+}
+
+// Equal returns true if both FormattedTimes are null or both hold the same
+// instant. Layouts are not compared.
+func (t FormattedTime) Equal(other FormattedTime) bool {
+	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
+}
+
+// String implements fmt.Stringer.
+// It returns the time in this FormattedTime's layout, or NullDisplay if this FormattedTime is null.
+func (t FormattedTime) String() string {
+	if !t.Valid {
+		return NullDisplay
+	}
+	return t.Time.Format(t.layout())
+}
+
+// Scan implements the Scanner interface. The layout is unchanged.
+func (t *FormattedTime) Scan(value interface{}) error {
+	var nt Time
+	err := nt.Scan(value)
+	t.Time, t.Valid = nt.Time, nt.Valid
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (t FormattedTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// ValueOrNil returns nil if this FormattedTime is null, otherwise the same value as Value.
+func (t FormattedTime) ValueOrNil() interface{} {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time
+}
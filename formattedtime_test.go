@@ -0,0 +1,193 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	formattedLayout = "2006-01-02 15:04:05"
+	formattedString = "2012-12-21 21:21:21"
+	formattedJSON   = []byte(`"` + formattedString + `"`)
+)
+
+func TestFormattedTimeFrom(t *testing.T) {
+	ti := FormattedTimeFrom(timeValue, formattedLayout)
+	assertFormattedTime(t, ti, "FormattedTimeFrom()")
+
+	ptr := FormattedTimeFromPtr(&timeValue, formattedLayout)
+	assertFormattedTime(t, ptr, "FormattedTimeFromPtr()")
+
+	null := FormattedTimeFromPtr(nil, formattedLayout)
+	assertNullFormattedTime(t, null, "FormattedTimeFromPtr(nil)")
+	if null.Layout != formattedLayout {
+		t.Errorf("bad layout: %q", null.Layout)
+	}
+}
+
+func TestUnmarshalFormattedTime(t *testing.T) {
+	ti := FormattedTime{Layout: formattedLayout}
+	err := json.Unmarshal(formattedJSON, &ti)
+	maybePanic(err)
+	assertFormattedTime(t, ti, "formatted json")
+
+	date := FormattedTime{Layout: time.DateOnly}
+	err = json.Unmarshal([]byte(`"2012-12-21"`), &date)
+	maybePanic(err)
+	if !date.Valid || !date.Time.Equal(time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad date-only time: %#v", date)
+	}
+
+	var def FormattedTime
+	err = json.Unmarshal(timeJSON, &def)
+	maybePanic(err)
+	assertFormattedTime(t, def, "default layout json")
+
+	null := FormattedTime{Layout: formattedLayout}
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullFormattedTime(t, null, "null json")
+
+	blank := FormattedTime{Layout: formattedLayout}
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullFormattedTime(t, blank, "blank json string")
+
+	mismatched := FormattedTime{Layout: formattedLayout}
+	err = json.Unmarshal(timeJSON, &mismatched)
+	if _, ok := err.(*time.ParseError); !ok {
+		t.Errorf("expected *time.ParseError, not %T: %v", err, err)
+	}
+	assertNullFormattedTime(t, mismatched, "mismatched layout json")
+
+	badType := FormattedTime{Layout: formattedLayout}
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullFormattedTime(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalFormattedTime(t *testing.T) {
+	ti := FormattedTime{Layout: formattedLayout}
+	err := ti.UnmarshalText([]byte(formattedString))
+	maybePanic(err)
+	assertFormattedTime(t, ti, "UnmarshalText() formatted")
+
+	blank := FormattedTime{Layout: formattedLayout}
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullFormattedTime(t, blank, "UnmarshalText() empty")
+
+	mismatched := FormattedTime{Layout: formattedLayout}
+	err = mismatched.UnmarshalText([]byte("21/12/2012"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullFormattedTime(t, mismatched, "UnmarshalText() mismatched")
+}
+
+func TestMarshalFormattedTime(t *testing.T) {
+	ti := FormattedTimeFrom(timeValue, formattedLayout)
+	data, err := json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(formattedJSON), "formatted json marshal")
+
+	data, err = ti.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, formattedString, "formatted text marshal")
+
+	def := FormattedTimeFrom(timeValue, "")
+	data, err = json.Marshal(def)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeJSON), "default layout json marshal")
+
+	null := NewFormattedTime(time.Time{}, formattedLayout, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestFormattedTimeRoundTrip(t *testing.T) {
+	type payload struct {
+		At FormattedTime `json:"at"`
+	}
+	in := payload{At: FormattedTimeFrom(timeValue, formattedLayout)}
+	data, err := json.Marshal(in)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"at":"`+formattedString+`"}`, "round trip marshal")
+
+	out := payload{At: FormattedTime{Layout: formattedLayout}}
+	err = json.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.At.Equal(in.At) {
+		t.Errorf("bad round trip: %v ≠ %v", out.At, in.At)
+	}
+}
+
+func TestFormattedTimeScanValue(t *testing.T) {
+	ti := FormattedTime{Layout: formattedLayout}
+	err := ti.Scan(timeValue)
+	maybePanic(err)
+	assertFormattedTime(t, ti, "scanned time")
+	if ti.Layout != formattedLayout {
+		t.Errorf("bad layout after scan: %q", ti.Layout)
+	}
+	if v, err := ti.Value(); v != timeValue || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null FormattedTime
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullFormattedTime(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+}
+
+func TestFormattedTimeString(t *testing.T) {
+	ti := FormattedTimeFrom(timeValue, formattedLayout)
+	if s := ti.String(); s != formattedString {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewFormattedTime(time.Time{}, formattedLayout, false)
+	if s := null.String(); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestFormattedTimeMustValue(t *testing.T) {
+	v := FormattedTimeFrom(timeValue, "")
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewFormattedTime(time.Time{}, "", false).MustValue() }, "FormattedTime")
+}
+
+func TestFormattedTimeSetNull(t *testing.T) {
+	change := FormattedTimeFrom(timeValue, "")
+	change.SetNull()
+	assertNullFormattedTime(t, change, "SetNull()")
+	if !change.Time.IsZero() {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Time)
+	}
+}
+
+func assertFormattedTime(t *testing.T, ti FormattedTime, from string) {
+	if !ti.Time.Equal(timeValue) {
+		t.Errorf("bad %v time: %v ≠ %v\n", from, ti.Time, timeValue)
+	}
+	if !ti.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullFormattedTime(t *testing.T, ti FormattedTime, from string) {
+	if ti.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
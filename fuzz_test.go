@@ -0,0 +1,265 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"testing"
+)
+
+// fuzzSeeds are inputs, valid and not, that every UnmarshalJSON fuzz target
+// starts from.
+var fuzzSeeds = []string{
+	`null`, `""`, `0`, `-0`, `1`, `-1`, `1.5`, `1e3`, `-1e-400`, `1e400`,
+	`"0"`, `"-1"`, `"1.5"`, `127`, `128`, `-129`, `255`, `256`, `65536`,
+	`2147483648`, `9223372036854775808`, `18446744073709551616`,
+	`true`, `false`, `"true"`, `"t"`, `[]`, `[1]`, `{}`, `{"a":1}`, `"a"`,
+	`"\u0000"`, `"\ud800"`, `"2012-12-21T21:21:21Z"`, `"2012-12-21"`,
+	`"1h30m"`, `"192.0.2.1"`, `"192.0.2.0/24"`, `"00:00:5e:00:53:01"`,
+	`"https://example.com/"`, `"#"`, `"?"`, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+	`"1/3"`, `"1+2i"`, `"a=>b"`, `"{a,b}"`, `"YWJj"`, `"`, `nul`, ``, ` `,
+	` null `, `"\"`, `01`, `-`, `1.`, `.5`, `+1`, `0x1F`, `1_000`,
+}
+
+// fuzzJSON checks that UnmarshalJSON into T never panics, whatever the
+// input, and that any value it accepts marshals to JSON that decodes back to
+// a value with the same encoding. Comparing encodings rather than values
+// allows for types that normalize, such as Bytes encoding empty as null.
+func fuzzJSON[T any, P interface {
+	*T
+	json.Marshaler
+	json.Unmarshaler
+}](f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v T
+		if err := P(&v).UnmarshalJSON(data); err != nil {
+			return
+		}
+		out, err := P(&v).MarshalJSON()
+		if err != nil {
+			t.Fatalf("%T from %q does not marshal: %v", v, data, err)
+		}
+		var back T
+		if err := P(&back).UnmarshalJSON(out); err != nil {
+			t.Fatalf("%T from %q does not unmarshal its own %s: %v", v, data, out, err)
+		}
+		again, err := P(&back).MarshalJSON()
+		if err != nil || !bytes.Equal(again, out) {
+			t.Fatalf("%T from %q changed in a round trip through %s: %s, %v", v, data, out, again, err)
+		}
+	})
+}
+
+// jsonZeroer is a null type that can be decoded from JSON.
+type jsonZeroer interface {
+	json.Unmarshaler
+	IsZero() bool
+}
+
+func TestUnmarshalJSONBogusValue(t *testing.T) {
+	newValues := func() []jsonZeroer {
+		// every value starts valid, so a failed UnmarshalJSON must set it to null
+		return []jsonZeroer{
+			&BigFloat{BigFloat: bigFloatValue, Valid: true},
+			&BigInt{BigInt: bigIntValue, Valid: true},
+			&BigRat{BigRat: bigRatValue, Valid: true},
+			&Bool{Bool: true, Valid: true},
+			&Byte{Byte: 'a', Valid: true},
+			&Bytes{Bytes: []byte("x"), Valid: true},
+			&CIDR{CIDR: cidrValue, Valid: true},
+			&Complex64{Complex64: 1i, Valid: true},
+			&Complex128{Complex128: 1i, Valid: true},
+			&Date{Date: dateValue, Valid: true},
+			&Decimal{Decimal: decimalValue, Valid: true},
+			&Duration{Duration: durationValue, Valid: true},
+			&Enum[color]{Enum: "red", Valid: true},
+			&Float32{Float32: 1, Valid: true},
+			&Float64{Float64: 1, Valid: true},
+			&FormattedTime{Time: timeValue, Valid: true},
+			&Hstore{Hstore: hstoreValue, Valid: true},
+			&Int{Int: 1, Valid: true},
+			&IntBool{Bool: true, Valid: true},
+			&Int8{Int8: 1, Valid: true},
+			&Int16{Int16: 1, Valid: true},
+			&Int32{Int32: 1, Valid: true},
+			&Int64{Int64: 1, Valid: true},
+			&IP{IP: ipValue, Valid: true},
+			&JSON{JSON: []byte("1"), Valid: true},
+			&LooseString{String: "x", Valid: true},
+			&MAC{MAC: macValue, Valid: true},
+			&Map{Map: mapValue, Valid: true},
+			&Rune{Rune: runeValue, Valid: true},
+			&String{String: "x", Valid: true},
+			&StringSlice{StringSlice: []string{"a"}, Valid: true},
+			&TextBytes{Bytes: []byte("x"), Valid: true},
+			&Time{Time: timeValue, Valid: true},
+			&TimeString{Time: timeValue, Valid: true},
+			&TrimmedString{String: "x", Valid: true},
+			&Uint{Uint: 1, Valid: true},
+			&Uint8{Uint8: 1, Valid: true},
+			&Uint16{Uint16: 1, Valid: true},
+			&Uint32{Uint32: 1, Valid: true},
+			&Uint64{Uint64: 1, Valid: true},
+			&URL{URL: urlValue, Valid: true},
+			&UUID{UUID: uuidValue, Valid: true},
+		}
+	}
+	for _, data := range []string{`{`, `[1,`, `"\x`, `nope`, ``} {
+		for _, v := range newValues() {
+			if err := v.UnmarshalJSON([]byte(data)); err == nil {
+				continue
+			}
+			if !v.IsZero() {
+				t.Errorf("%T should be null after failing to unmarshal %q: %#v", v, data, v)
+			}
+		}
+	}
+}
+
+func FuzzBigFloatUnmarshalJSON(f *testing.F) { fuzzJSON[BigFloat](f) }
+
+func FuzzBigIntUnmarshalJSON(f *testing.F) { fuzzJSON[BigInt](f) }
+
+func FuzzBigRatUnmarshalJSON(f *testing.F) { fuzzJSON[BigRat](f) }
+
+func FuzzBoolUnmarshalJSON(f *testing.F) { fuzzJSON[Bool](f) }
+
+func FuzzByteUnmarshalJSON(f *testing.F) { fuzzJSON[Byte](f) }
+
+func FuzzBytesUnmarshalJSON(f *testing.F) { fuzzJSON[Bytes](f) }
+
+func FuzzCIDRUnmarshalJSON(f *testing.F) { fuzzJSON[CIDR](f) }
+
+func FuzzComplex128UnmarshalJSON(f *testing.F) { fuzzJSON[Complex128](f) }
+
+func FuzzComplex64UnmarshalJSON(f *testing.F) { fuzzJSON[Complex64](f) }
+
+func FuzzDateUnmarshalJSON(f *testing.F) { fuzzJSON[Date](f) }
+
+func FuzzDecimalUnmarshalJSON(f *testing.F) { fuzzJSON[Decimal](f) }
+
+func FuzzDurationUnmarshalJSON(f *testing.F) { fuzzJSON[Duration](f) }
+
+func FuzzEnumUnmarshalJSON(f *testing.F) { fuzzJSON[Enum[color]](f) }
+
+func FuzzFloat32UnmarshalJSON(f *testing.F) { fuzzJSON[Float32](f) }
+
+func FuzzFloat64UnmarshalJSON(f *testing.F) { fuzzJSON[Float64](f) }
+
+func FuzzFormattedTimeUnmarshalJSON(f *testing.F) { fuzzJSON[FormattedTime](f) }
+
+func FuzzHstoreUnmarshalJSON(f *testing.F) { fuzzJSON[Hstore](f) }
+
+func FuzzIntUnmarshalJSON(f *testing.F) { fuzzJSON[Int](f) }
+
+func FuzzIntBoolUnmarshalJSON(f *testing.F) { fuzzJSON[IntBool](f) }
+
+func FuzzInt16UnmarshalJSON(f *testing.F) { fuzzJSON[Int16](f) }
+
+func FuzzInt32UnmarshalJSON(f *testing.F) { fuzzJSON[Int32](f) }
+
+func FuzzInt64UnmarshalJSON(f *testing.F) { fuzzJSON[Int64](f) }
+
+func FuzzInt8UnmarshalJSON(f *testing.F) { fuzzJSON[Int8](f) }
+
+func FuzzIPUnmarshalJSON(f *testing.F) { fuzzJSON[IP](f) }
+
+func FuzzJSONUnmarshalJSON(f *testing.F) { fuzzJSON[JSON](f) }
+
+func FuzzLooseStringUnmarshalJSON(f *testing.F) { fuzzJSON[LooseString](f) }
+
+func FuzzMACUnmarshalJSON(f *testing.F) { fuzzJSON[MAC](f) }
+
+func FuzzMapUnmarshalJSON(f *testing.F) { fuzzJSON[Map](f) }
+
+func FuzzRuneUnmarshalJSON(f *testing.F) { fuzzJSON[Rune](f) }
+
+func FuzzStringUnmarshalJSON(f *testing.F) { fuzzJSON[String](f) }
+
+func FuzzStringSliceUnmarshalJSON(f *testing.F) { fuzzJSON[StringSlice](f) }
+
+func FuzzTextBytesUnmarshalJSON(f *testing.F) { fuzzJSON[TextBytes](f) }
+
+func FuzzTimeUnmarshalJSON(f *testing.F) { fuzzJSON[Time](f) }
+
+func FuzzTimeStringUnmarshalJSON(f *testing.F) { fuzzJSON[TimeString](f) }
+
+func FuzzTrimmedStringUnmarshalJSON(f *testing.F) { fuzzJSON[TrimmedString](f) }
+
+func FuzzUintUnmarshalJSON(f *testing.F) { fuzzJSON[Uint](f) }
+
+func FuzzUint16UnmarshalJSON(f *testing.F) { fuzzJSON[Uint16](f) }
+
+func FuzzUint32UnmarshalJSON(f *testing.F) { fuzzJSON[Uint32](f) }
+
+func FuzzUint64UnmarshalJSON(f *testing.F) { fuzzJSON[Uint64](f) }
+
+func FuzzUint8UnmarshalJSON(f *testing.F) { fuzzJSON[Uint8](f) }
+
+func FuzzURLUnmarshalJSON(f *testing.F) { fuzzJSON[URL](f) }
+
+func FuzzUUIDUnmarshalJSON(f *testing.F) { fuzzJSON[UUID](f) }
+
+// fuzzText is like fuzzJSON, for UnmarshalText and MarshalText.
+func fuzzText[T any, P interface {
+	*T
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}](f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, text []byte) {
+		var v T
+		if err := P(&v).UnmarshalText(text); err != nil {
+			return
+		}
+		out, err := P(&v).MarshalText()
+		if err != nil {
+			t.Fatalf("%T from %q does not marshal: %v", v, text, err)
+		}
+		var back T
+		if err := P(&back).UnmarshalText(out); err != nil {
+			t.Fatalf("%T from %q does not unmarshal its own %q: %v", v, text, out, err)
+		}
+		again, err := P(&back).MarshalText()
+		if err != nil || !bytes.Equal(again, out) {
+			t.Fatalf("%T from %q changed in a round trip through %q: %q, %v", v, text, out, again, err)
+		}
+	})
+}
+
+func FuzzBigFloatUnmarshalText(f *testing.F) { fuzzText[BigFloat](f) }
+
+func FuzzBigIntUnmarshalText(f *testing.F) { fuzzText[BigInt](f) }
+
+func FuzzBigRatUnmarshalText(f *testing.F) { fuzzText[BigRat](f) }
+
+func FuzzDecimalUnmarshalText(f *testing.F) { fuzzText[Decimal](f) }
+
+func FuzzFloat32UnmarshalText(f *testing.F) { fuzzText[Float32](f) }
+
+func FuzzFloat64UnmarshalText(f *testing.F) { fuzzText[Float64](f) }
+
+func FuzzIntUnmarshalText(f *testing.F) { fuzzText[Int](f) }
+
+func FuzzInt8UnmarshalText(f *testing.F) { fuzzText[Int8](f) }
+
+func FuzzInt16UnmarshalText(f *testing.F) { fuzzText[Int16](f) }
+
+func FuzzInt32UnmarshalText(f *testing.F) { fuzzText[Int32](f) }
+
+func FuzzInt64UnmarshalText(f *testing.F) { fuzzText[Int64](f) }
+
+func FuzzUintUnmarshalText(f *testing.F) { fuzzText[Uint](f) }
+
+func FuzzUint8UnmarshalText(f *testing.F) { fuzzText[Uint8](f) }
+
+func FuzzUint16UnmarshalText(f *testing.F) { fuzzText[Uint16](f) }
+
+func FuzzUint32UnmarshalText(f *testing.F) { fuzzText[Uint32](f) }
+
+func FuzzUint64UnmarshalText(f *testing.F) { fuzzText[Uint64](f) }
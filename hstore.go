@@ -0,0 +1,372 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/randomize"
+)
+
+// Hstore is a nullable Postgres hstore. Each value is itself a String, so
+// that a key holding NULL is kept apart from one holding an empty string. It
+// marshals to and from a JSON object whose values are strings or null, and
+// scans and values the hstore text form, such as "a"=>"1", "b"=>NULL.
+type Hstore struct {
+	Hstore map[string]String
+	Valid  bool
+}
+
+// NewHstore creates a new Hstore
+func NewHstore(h map[string]String, valid bool) Hstore {
+	return Hstore{
+		Hstore: h,
+		Valid:  valid,
+	}
+}
+
+// HstoreFrom creates a new Hstore that will be invalid if nil.
+func HstoreFrom(h map[string]String) Hstore {
+	return NewHstore(h, h != nil)
+}
+
+// HstoreFromPtr creates a new Hstore that will be invalid if nil.
+func HstoreFromPtr(h *map[string]String) Hstore {
+	if h == nil {
+		return NewHstore(nil, false)
+	}
+	return NewHstore(*h, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a JSON object whose values are strings or null. An empty object
+// is valid.
+func (h *Hstore) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		h.Hstore = nil
+		h.Valid = false
+		return nil
+	}
+
+	var v map[string]String
+	if err := json.Unmarshal(data, &v); err != nil {
+		h.Hstore, h.Valid = nil, false
+		return err
+	}
+	if v == nil {
+		h.Hstore, h.Valid = nil, false
+		return fmt.Errorf("null: expected a JSON object for null.Hstore, got %s", data)
+	}
+	h.Hstore = v
+	h.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Hstore is null, and otherwise a JSON object
+// with null for each null value.
+func (h Hstore) MarshalJSON() ([]byte, error) {
+	if !h.Valid {
+		return NullBytes, nil
+	}
+	if h.Hstore == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(h.Hstore)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Hstore as chosen by opts.
+func (h Hstore) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(h)
+}
+
+// SetValid changes this Hstore's value and also sets it to be non-null.
+func (h *Hstore) SetValid(v map[string]String) {
+	h.Hstore = v
+	h.Valid = true
+}
+
+// SetNull sets this Hstore to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (h *Hstore) SetNull() {
+	h.Hstore = nil
+	h.Valid = false
+}
+
+// Ptr returns a pointer to this Hstore's value, or a nil pointer if this Hstore is null.
+func (h Hstore) Ptr() *map[string]String {
+	if !h.Valid {
+		return nil
+	}
+	return &h.Hstore
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (h Hstore) ValueOrZero() map[string]String {
+	if !h.Valid {
+		return nil
+	}
+	return h.Hstore
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (h Hstore) ValueOr(def map[string]String) map[string]String {
+	if !h.Valid {
+		return def
+	}
+	return h.Hstore
+}
+
+// Or returns this Hstore if it is valid, otherwise other.
+func (h Hstore) Or(other Hstore) Hstore {
+	if !h.Valid {
+		return other
+	}
+	return h
+}
+
+// MustValue returns the inner value, and panics if this Hstore is null.
+func (h Hstore) MustValue() map[string]String {
+	if !h.Valid {
+		panic("null.Hstore: MustValue called on invalid value")
+	}
+	return h.Hstore
+}
+
+// IsZero returns true if this Hstore is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (h Hstore) IsZero() bool {
+	return !h.Valid
+}
+
+// IsNull returns true if this Hstore is null, the same as !Valid.
+func (h Hstore) IsNull() bool {
+	return !h.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Hstore, or nil if it is null, for
+// a *Hstore field tagged omitempty that should leave nulls out.
+func (h Hstore) OmitEmpty() *Hstore {
+	if !h.Valid {
+		return nil
+	}
+	return &h
+}
+
+// Equal returns true if both Hstores are null or both hold the same keys with
+// equal values. A nil and an empty valid map are considered equal.
+func (h Hstore) Equal(other Hstore) bool {
+	if h.Valid != other.Valid {
+		return false
+	}
+	if !h.Valid {
+		return true
+	}
+	if len(h.Hstore) != len(other.Hstore) {
+		return false
+	}
+	for k, v := range h.Hstore {
+		o, ok := other.Hstore[k]
+		if !ok || !v.Equal(o) {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements fmt.Stringer.
+// It returns the hstore text form, or NullDisplay if this Hstore is null.
+func (h Hstore) String() string {
+	if !h.Valid {
+		return NullDisplay
+	}
+	return formatHstore(h.Hstore)
+}
+
+// Scan implements the Scanner interface.
+// It accepts the hstore text form as a string or []byte.
+func (h *Hstore) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case string:
+		h.Hstore, err = parseHstore(x)
+	case []byte:
+		h.Hstore, err = parseHstore(string(x))
+	case nil:
+		h.Hstore, h.Valid = nil, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	h.Valid = err == nil
+	return scanError("Hstore", value, nil, err)
+}
+
+// parseHstore parses the hstore text form. Keys and values may be double
+// quoted, and backslash escapes the next character. An unquoted NULL value is
+// a null String; as a key it is an error, since hstore keys cannot be null.
+func parseHstore(literal string) (map[string]String, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("null: invalid hstore literal for null.Hstore: %s: %q", reason, literal)
+	}
+
+	isSpace := func(c byte) bool {
+		return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+	}
+	i := 0
+	skipSpace := func() {
+		for i < len(literal) && isSpace(literal[i]) {
+			i++
+		}
+	}
+	// token reads a quoted or unquoted token, reporting whether it was quoted
+	token := func() (string, bool, error) {
+		var b strings.Builder
+		if i < len(literal) && literal[i] == '"' {
+			i++
+			for {
+				if i >= len(literal) {
+					return "", false, invalid("unterminated quoted string")
+				}
+				c := literal[i]
+				i++
+				if c == '"' {
+					return b.String(), true, nil
+				}
+				if c == '\\' {
+					if i >= len(literal) {
+						return "", false, invalid("unterminated escape")
+					}
+					c = literal[i]
+					i++
+				}
+				b.WriteByte(c)
+			}
+		}
+		for i < len(literal) {
+			c := literal[i]
+			if isSpace(c) || c == ',' || c == '"' || strings.HasPrefix(literal[i:], "=>") {
+				break
+			}
+			i++
+			if c == '\\' {
+				if i >= len(literal) {
+					return "", false, invalid("unterminated escape")
+				}
+				c = literal[i]
+				i++
+			}
+			b.WriteByte(c)
+		}
+		if b.Len() == 0 {
+			return "", false, invalid("expected a key or value")
+		}
+		return b.String(), false, nil
+	}
+
+	pairs := map[string]String{}
+	skipSpace()
+	if i == len(literal) {
+		return pairs, nil
+	}
+	for {
+		skipSpace()
+		key, quoted, err := token()
+		if err != nil {
+			return nil, err
+		}
+		if !quoted && strings.EqualFold(key, "NULL") {
+			return nil, invalid("NULL keys are not supported")
+		}
+		skipSpace()
+		if !strings.HasPrefix(literal[i:], "=>") {
+			return nil, invalid("expected =>")
+		}
+		i += 2
+		skipSpace()
+		value, quoted, err := token()
+		if err != nil {
+			return nil, err
+		}
+		if !quoted && strings.EqualFold(value, "NULL") {
+			pairs[key] = NewString("", false)
+		} else {
+			pairs[key] = StringFrom(value)
+		}
+		skipSpace()
+		if i == len(literal) {
+			return pairs, nil
+		}
+		if literal[i] != ',' {
+			return nil, invalid("expected a comma")
+		}
+		i++
+	}
+}
+
+// formatHstore returns pairs in the hstore text form, sorted by key, quoting
+// every key and value and escaping quotes and backslashes.
+func formatHstore(pairs map[string]String) string {
+	keys := make([]string, 0, len(pairs))
+	for k := range pairs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	quote := func(s string) {
+		b.WriteByte('"')
+		for j := 0; j < len(s); j++ {
+			if s[j] == '"' || s[j] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(s[j])
+		}
+		b.WriteByte('"')
+	}
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		quote(k)
+		b.WriteString("=>")
+		if v := pairs[k]; v.Valid {
+			quote(v.String)
+		} else {
+			b.WriteString("NULL")
+		}
+	}
+	return b.String()
+}
+
+// Value implements the driver Valuer interface.
+// It returns the hstore text form, with the keys sorted.
+func (h Hstore) Value() (driver.Value, error) {
+	if !h.Valid {
+		return nil, nil
+	}
+	return formatHstore(h.Hstore), nil
+}
+
+// ValueOrNil returns nil if this Hstore is null, otherwise the same value as Value.
+func (h Hstore) ValueOrNil() interface{} {
+	if !h.Valid {
+		return nil
+	}
+	return formatHstore(h.Hstore)
+}
+
+// Randomize for sqlboiler
+func (h *Hstore) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		h.Hstore = nil
+		h.Valid = false
+	} else {
+		h.Hstore = map[string]String{randomize.Str(nextInt, 1): StringFrom(randomize.Str(nextInt, 1))}
+		h.Valid = true
+	}
+}
@@ -0,0 +1,179 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+var (
+	hstoreJSON  = []byte(`{"a":"1","b":null,"c":""}`)
+	hstoreText  = `"a"=>"1", "b"=>NULL, "c"=>""`
+	hstoreValue = map[string]String{
+		"a": StringFrom("1"),
+		"b": NewString("", false),
+		"c": StringFrom(""),
+	}
+)
+
+func TestHstoreFrom(t *testing.T) {
+	assertHstore(t, HstoreFrom(hstoreValue), "HstoreFrom()")
+	assertNullHstore(t, HstoreFrom(nil), "HstoreFrom(nil)")
+
+	empty := HstoreFrom(map[string]String{})
+	if !empty.Valid {
+		t.Error("HstoreFrom(map[string]String{})", "is invalid, but should be valid")
+	}
+
+	v := hstoreValue
+	assertHstore(t, HstoreFromPtr(&v), "HstoreFromPtr()")
+	assertNullHstore(t, HstoreFromPtr(nil), "HstoreFromPtr(nil)")
+}
+
+func TestUnmarshalHstore(t *testing.T) {
+	var h Hstore
+	err := json.Unmarshal(hstoreJSON, &h)
+	maybePanic(err)
+	assertHstore(t, h, "object json")
+
+	var empty Hstore
+	err = json.Unmarshal([]byte(`{}`), &empty)
+	maybePanic(err)
+	if !empty.Valid || empty.Hstore == nil || len(empty.Hstore) != 0 {
+		t.Errorf("bad empty object json: %#v", empty)
+	}
+
+	var null Hstore
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullHstore(t, null, "null json")
+
+	for _, data := range [][]byte{stringJSON, intJSON, []byte(`[1]`), []byte(`{"a":1}`)} {
+		bad := HstoreFrom(hstoreValue)
+		if err := json.Unmarshal(data, &bad); err == nil {
+			t.Errorf("%s: expected error", data)
+		}
+		assertNullHstore(t, bad, string(data))
+	}
+}
+
+func TestMarshalHstore(t *testing.T) {
+	data, err := json.Marshal(HstoreFrom(hstoreValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(hstoreJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(NewHstore(nil, true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "{}", "empty json marshal")
+
+	data, err = json.Marshal(NewHstore(nil, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestHstoreScanValue(t *testing.T) {
+	var h Hstore
+	err := h.Scan(hstoreText)
+	maybePanic(err)
+	assertHstore(t, h, "scanned string")
+	if v, err := h.Value(); v != hstoreText || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var empty Hstore
+	err = empty.Scan([]byte(""))
+	maybePanic(err)
+	if !empty.Valid || len(empty.Hstore) != 0 {
+		t.Errorf("bad scanned empty hstore: %#v", empty)
+	}
+	if v, err := empty.Value(); v != "" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null Hstore
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullHstore(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	// quotes, backslashes, commas and => inside keys and values, and unquoted tokens
+	var tricky Hstore
+	err = tricky.Scan(`"say \"hi\""=>"a, b", "back\\slash"=>"x=>y" ,plain => word,"null"=>"NULL"`)
+	maybePanic(err)
+	want := HstoreFrom(map[string]String{
+		`say "hi"`:   StringFrom("a, b"),
+		`back\slash`: StringFrom("x=>y"),
+		"plain":      StringFrom("word"),
+		"null":       StringFrom("NULL"),
+	})
+	if !tricky.Equal(want) {
+		t.Errorf("bad scanned tricky hstore: %v", tricky)
+	}
+	v, err := tricky.Value()
+	maybePanic(err)
+	var roundTrip Hstore
+	err = roundTrip.Scan(v)
+	maybePanic(err)
+	if !roundTrip.Equal(want) {
+		t.Errorf("bad value round trip through %s: %v", v, roundTrip)
+	}
+
+	for _, value := range []interface{}{int64(1), `"a"`, `"a"=>`, `"a"=>"1" "b"=>"2"`, `"a=>"1"`, `NULL=>"1"`, `"a"=>"1",`} {
+		wrong := HstoreFrom(hstoreValue)
+		if err := wrong.Scan(value); err == nil {
+			t.Errorf("%v: expected error", value)
+		}
+		assertNullHstore(t, wrong, fmt.Sprint("scanned ", value))
+	}
+}
+
+func TestHstoreEqual(t *testing.T) {
+	if !NewHstore(nil, false).Equal(NewHstore(hstoreValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if !HstoreFrom(map[string]String{}).Equal(NewHstore(nil, true)) {
+		t.Error("Equal() should be true for an empty and a nil valid map")
+	}
+	if HstoreFrom(map[string]String{"a": StringFrom("")}).Equal(HstoreFrom(map[string]String{"a": NewString("", false)})) {
+		t.Error("Equal() should be false for an empty and a null value")
+	}
+	if HstoreFrom(map[string]String{"a": StringFrom("")}).Equal(HstoreFrom(map[string]String{"b": StringFrom("")})) {
+		t.Error("Equal() should be false for different keys")
+	}
+}
+
+func TestHstoreString(t *testing.T) {
+	if s := HstoreFrom(hstoreValue).String(); s != hstoreText {
+		t.Errorf("bad String(): %s", s)
+	}
+	if s := fmt.Sprint(NewHstore(nil, false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestHstoreValueOrNil(t *testing.T) {
+	assertValueOrNil(t, HstoreFrom(hstoreValue), "valid")
+	assertValueOrNil(t, NewHstore(nil, false), "null")
+}
+
+func TestHstoreMustValue(t *testing.T) {
+	v := HstoreFrom(hstoreValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewHstore(nil, false).MustValue() }, "Hstore")
+}
+
+func assertHstore(t *testing.T, h Hstore, from string) {
+	if !h.Equal(HstoreFrom(hstoreValue)) {
+		t.Errorf("bad %s hstore: %v ≠ %v\n", from, h.Hstore, hstoreValue)
+	}
+	if !h.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullHstore(t *testing.T, h Hstore, from string) {
+	if h.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -3,10 +3,12 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/binary"
+	"encoding/xml"
 	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
 )
 
@@ -38,21 +40,27 @@ func IntFromPtr(i *int) Int {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (i *Int) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		i.Valid = false
 		i.Int = 0
+		i.Valid = false
 		return nil
 	}
 
-	var x int64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONInt(data, strconv.IntSize, "Int")
+	i.Int, i.Valid = int(x), valid
+	return err
+}
 
-	i.Int = int(x)
-	i.Valid = true
-	return nil
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Int
+// to def and makes it valid, rather than null.
+func (i *Int) UnmarshalJSONDefault(data []byte, def int) error {
+	if bytes.Equal(data, NullBytes) {
+		i.SetValid(def)
+		return nil
+	}
+	return i.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -67,7 +75,7 @@ func (i *Int) UnmarshalText(text []byte) error {
 	if i.Valid {
 		i.Int = int(res)
 	}
-	return err
+	return textOverflow("Int", &i.Int, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -75,15 +83,142 @@ func (i Int) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int), 10)), nil
+	return i.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Int to dst and returns
+// the extended buffer.
+func (i Int) AppendJSON(dst []byte) []byte {
+	if !i.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendInt(dst, int64(i.Int), 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Int as chosen by opts.
+func (i Int) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(i)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Int is null, which UnmarshalText
+// reads back as null.
 func (i Int) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int), 10)), nil
+	return strconv.AppendInt(make([]byte, 0, maxIntegerLen), int64(i.Int), 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Int as opts.NullText.
+func (i Int) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(i, i.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Int is null.
+func (i Int) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (i *Int) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Int is null.
+func (i Int) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.Int, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (i *Int) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *int
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int = *v
+	i.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Int is null.
+func (i Int) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !i.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeInt(int64(i.Int))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (i *Int) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *int
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int = *v
+	i.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Int is encoded as a single byte.
+func (i Int) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendVarint([]byte{encodedValid}, int64(i.Int)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Int")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		i.Int = 0
+		i.Valid = false
+		return nil
+	}
+	x, err := decodeVarint(value, "Int")
+	if err != nil {
+		return err
+	}
+	if x < math.MinInt || x > math.MaxInt {
+		return intOverflow("Int", strconv.FormatInt(x, 10), strconv.IntSize)
+	}
+	i.Int = int(x)
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (i Int) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (i *Int) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
 }
 
 // SetValid changes this Int's value and also sets it to be non-null.
@@ -92,6 +227,24 @@ func (i *Int) SetValid(n int) {
 	i.Valid = true
 }
 
+// SetChecked sets this Int to n and makes it non-null, or returns an error and
+// leaves it unchanged if n does not fit in an int.
+func (i *Int) SetChecked(n int64) error {
+	if n < math.MinInt || n > math.MaxInt {
+		return intOverflow("Int", strconv.FormatInt(n, 10), strconv.IntSize)
+	}
+	i.Int = int(n)
+	i.Valid = true
+	return nil
+}
+
+// SetNull sets this Int to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (i *Int) SetNull() {
+	i.Int = 0
+	i.Valid = false
+}
+
 // Ptr returns a pointer to this Int's value, or a nil pointer if this Int is null.
 func (i Int) Ptr() *int {
 	if !i.Valid {
@@ -100,19 +253,94 @@ func (i Int) Ptr() *int {
 	return &i.Int
 }
 
-// IsZero returns true for invalid Ints, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int) ValueOrZero() int {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int) ValueOr(def int) int {
+	if !i.Valid {
+		return def
+	}
+	return i.Int
+}
+
+// Or returns this Int if it is valid, otherwise other.
+func (i Int) Or(other Int) Int {
+	if !i.Valid {
+		return other
+	}
+	return i
+}
+
+// MustValue returns the inner value, and panics if this Int is null.
+func (i Int) MustValue() int {
+	if !i.Valid {
+		panic("null.Int: MustValue called on invalid value")
+	}
+	return i.Int
+}
+
+// Map returns a new Int holding fn applied to this Int's value. A null Int is
+// returned unchanged and fn is not called.
+func (i Int) Map(fn func(int) int) Int {
+	if !i.Valid {
+		return i
+	}
+	return NewInt(fn(i.Int), true)
+}
+
+// IsZero returns true if this Int is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (i Int) IsZero() bool {
 	return !i.Valid
 }
 
+// IsNull returns true if this Int is null, the same as !Valid.
+func (i Int) IsNull() bool {
+	return !i.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Int, or nil if it is null, for
+// a *Int field tagged omitempty that should leave nulls out.
+func (i Int) OmitEmpty() *Int {
+	if !i.Valid {
+		return nil
+	}
+	return &i
+}
+
+// Equal returns true if both Ints are null or both hold the same value.
+func (i Int) Equal(other Int) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int == other.Int)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Int is null.
+func (i Int) String() string {
+	if !i.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatInt(int64(i.Int), 10)
+}
+
 // Scan implements the Scanner interface.
 func (i *Int) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		i.Int, i.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&i.Int, value); err != nil {
+		i.Int, i.Valid = 0, false
+		return scanError("Int", value, &i.Int, err)
+	}
 	i.Valid = true
-	return convert.ConvertAssign(&i.Int, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -123,13 +351,21 @@ func (i Int) Value() (driver.Value, error) {
 	return int64(i.Int), nil
 }
 
+// ValueOrNil returns nil if this Int is null, otherwise the same value as Value.
+func (i Int) ValueOrNil() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return int64(i.Int)
+}
+
 // Randomize for sqlboiler
 func (i *Int) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		i.Int = 0
 		i.Valid = false
 	} else {
-		i.Int = int(int32(nextInt() % math.MaxInt32))
+		i.Int = int(randomInt(nextInt, fieldType, strconv.IntSize))
 		i.Valid = true
 	}
 }
@@ -2,12 +2,14 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+	"encoding/binary"
+	"encoding/xml"
 	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
 )
 
@@ -38,26 +40,33 @@ func Int16FromPtr(i *int16) Int16 {
 	return NewInt16(*i, true)
 }
 
+// Int16FromSQL creates a new Int16 from the database/sql NullInt16 n.
+func Int16FromSQL(n sql.NullInt16) Int16 {
+	return NewInt16(n.Int16, n.Valid)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (i *Int16) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		i.Valid = false
 		i.Int16 = 0
+		i.Valid = false
 		return nil
 	}
 
-	var x int64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONInt(data, 16, "Int16")
+	i.Int16, i.Valid = int16(x), valid
+	return err
+}
 
-	if x > math.MaxInt16 {
-		return fmt.Errorf("json: %d overflows max int16 value", x)
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Int16
+// to def and makes it valid, rather than null.
+func (i *Int16) UnmarshalJSONDefault(data []byte, def int16) error {
+	if bytes.Equal(data, NullBytes) {
+		i.SetValid(def)
+		return nil
 	}
-
-	i.Int16 = int16(x)
-	i.Valid = true
-	return nil
+	return i.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -72,7 +81,7 @@ func (i *Int16) UnmarshalText(text []byte) error {
 	if i.Valid {
 		i.Int16 = int16(res)
 	}
-	return err
+	return textOverflow("Int16", &i.Int16, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -80,15 +89,142 @@ func (i Int16) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+	return i.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Int16 to dst and returns
+// the extended buffer.
+func (i Int16) AppendJSON(dst []byte) []byte {
+	if !i.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendInt(dst, int64(i.Int16), 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Int16 as chosen by opts.
+func (i Int16) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(i)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Int16 is null, which UnmarshalText
+// reads back as null.
 func (i Int16) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+	return strconv.AppendInt(make([]byte, 0, maxIntegerLen), int64(i.Int16), 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Int16 as opts.NullText.
+func (i Int16) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(i, i.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Int16 is null.
+func (i Int16) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (i *Int16) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Int16 is null.
+func (i Int16) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.Int16, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (i *Int16) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *int16
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int16 = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int16 = *v
+	i.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Int16 is null.
+func (i Int16) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !i.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeInt(int64(i.Int16))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (i *Int16) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *int16
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int16 = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int16 = *v
+	i.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Int16 is encoded as a single byte.
+func (i Int16) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendVarint([]byte{encodedValid}, int64(i.Int16)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int16) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Int16")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		i.Int16 = 0
+		i.Valid = false
+		return nil
+	}
+	x, err := decodeVarint(value, "Int16")
+	if err != nil {
+		return err
+	}
+	if x < math.MinInt16 || x > math.MaxInt16 {
+		return intOverflow("Int16", strconv.FormatInt(x, 10), 16)
+	}
+	i.Int16 = int16(x)
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (i Int16) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (i *Int16) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
 }
 
 // SetValid changes this Int16's value and also sets it to be non-null.
@@ -97,6 +233,24 @@ func (i *Int16) SetValid(n int16) {
 	i.Valid = true
 }
 
+// SetChecked sets this Int16 to n and makes it non-null, or returns an error and
+// leaves it unchanged if n does not fit in an int16.
+func (i *Int16) SetChecked(n int64) error {
+	if n < math.MinInt16 || n > math.MaxInt16 {
+		return intOverflow("Int16", strconv.FormatInt(n, 10), 16)
+	}
+	i.Int16 = int16(n)
+	i.Valid = true
+	return nil
+}
+
+// SetNull sets this Int16 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (i *Int16) SetNull() {
+	i.Int16 = 0
+	i.Valid = false
+}
+
 // Ptr returns a pointer to this Int16's value, or a nil pointer if this Int16 is null.
 func (i Int16) Ptr() *int16 {
 	if !i.Valid {
@@ -105,19 +259,94 @@ func (i Int16) Ptr() *int16 {
 	return &i.Int16
 }
 
-// IsZero returns true for invalid Int16's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int16) ValueOrZero() int16 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int16
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int16) ValueOr(def int16) int16 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int16
+}
+
+// Or returns this Int16 if it is valid, otherwise other.
+func (i Int16) Or(other Int16) Int16 {
+	if !i.Valid {
+		return other
+	}
+	return i
+}
+
+// MustValue returns the inner value, and panics if this Int16 is null.
+func (i Int16) MustValue() int16 {
+	if !i.Valid {
+		panic("null.Int16: MustValue called on invalid value")
+	}
+	return i.Int16
+}
+
+// Map returns a new Int16 holding fn applied to this Int16's value. A null Int16 is
+// returned unchanged and fn is not called.
+func (i Int16) Map(fn func(int16) int16) Int16 {
+	if !i.Valid {
+		return i
+	}
+	return NewInt16(fn(i.Int16), true)
+}
+
+// IsZero returns true if this Int16 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (i Int16) IsZero() bool {
 	return !i.Valid
 }
 
+// IsNull returns true if this Int16 is null, the same as !Valid.
+func (i Int16) IsNull() bool {
+	return !i.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Int16, or nil if it is null, for
+// a *Int16 field tagged omitempty that should leave nulls out.
+func (i Int16) OmitEmpty() *Int16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i
+}
+
+// Equal returns true if both Int16s are null or both hold the same value.
+func (i Int16) Equal(other Int16) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int16 == other.Int16)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Int16 is null.
+func (i Int16) String() string {
+	if !i.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatInt(int64(i.Int16), 10)
+}
+
 // Scan implements the Scanner interface.
 func (i *Int16) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		i.Int16, i.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&i.Int16, value); err != nil {
+		i.Int16, i.Valid = 0, false
+		return scanError("Int16", value, &i.Int16, err)
+	}
 	i.Valid = true
-	return convert.ConvertAssign(&i.Int16, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -128,13 +357,26 @@ func (i Int16) Value() (driver.Value, error) {
 	return int64(i.Int16), nil
 }
 
+// ValueOrNil returns nil if this Int16 is null, otherwise the same value as Value.
+func (i Int16) ValueOrNil() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return int64(i.Int16)
+}
+
+// ToSQL returns this Int16 as a database/sql NullInt16.
+func (i Int16) ToSQL() sql.NullInt16 {
+	return sql.NullInt16{Int16: i.Int16, Valid: i.Valid}
+}
+
 // Randomize for sqlboiler
 func (i *Int16) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		i.Int16 = 0
 		i.Valid = false
 	} else {
-		i.Int16 = int16(nextInt() % math.MaxInt16)
+		i.Int16 = int16(randomInt(nextInt, fieldType, 16))
 		i.Valid = true
 	}
 }
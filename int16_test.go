@@ -2,6 +2,7 @@ package null
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	"strconv"
 	"testing"
@@ -80,6 +81,49 @@ func TestUnmarshalInt16Overflow(t *testing.T) {
 	}
 }
 
+func TestUnmarshalInt16Bounds(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{in: strconv.FormatInt(math.MaxInt16, 10), valid: true},
+		{in: strconv.FormatInt(math.MaxInt16+1, 10), valid: false},
+		{in: strconv.FormatInt(math.MinInt16, 10), valid: true},
+		{in: strconv.FormatInt(math.MinInt16-1, 10), valid: false},
+	}
+
+	for _, test := range tests {
+		var i Int16
+		err := json.Unmarshal([]byte(test.in), &i)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.in, err)
+			}
+			if !i.Valid || strconv.FormatInt(int64(i.Int16), 10) != test.in {
+				t.Errorf("%s: bad int16: %#v", test.in, i)
+			}
+		} else {
+			if err == nil {
+				t.Errorf("%s: expected error", test.in)
+			}
+			assertNullInt16(t, i, test.in)
+		}
+	}
+}
+
+func TestUnmarshalInt16Zero(t *testing.T) {
+	var i Int16
+	err := json.Unmarshal([]byte(`0`), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int16 != 0 {
+		t.Errorf("bad zero int16: %#v", i)
+	}
+
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+}
+
 func TestTextUnmarshalInt16(t *testing.T) {
 	var i Int16
 	err := i.UnmarshalText([]byte("32766"))
@@ -156,6 +200,15 @@ func TestInt16SetValid(t *testing.T) {
 	assertInt16(t, change, "SetValid()")
 }
 
+func TestInt16SetNull(t *testing.T) {
+	change := Int16From(-12345)
+	change.SetNull()
+	assertNullInt16(t, change, "SetNull()")
+	if change.Int16 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Int16)
+	}
+}
+
 func TestInt16Scan(t *testing.T) {
 	var i Int16
 	err := i.Scan(32766)
@@ -168,6 +221,83 @@ func TestInt16Scan(t *testing.T) {
 	assertNullInt16(t, null, "scanned null")
 }
 
+func TestInt16Equal(t *testing.T) {
+	null := NewInt16(0, false)
+	other := NewInt16(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Int16From(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Int16From(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Int16From(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestInt16ValueOr(t *testing.T) {
+	valid := Int16From(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewInt16(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestInt16String(t *testing.T) {
+	v := Int16From(-12345)
+	if s := v.String(); s != "-12345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewInt16(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestInt16ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Int16From(-12345), "valid")
+	assertValueOrNil(t, NewInt16(0, false), "null")
+}
+
+func TestInt16MustValue(t *testing.T) {
+	v := Int16From(-12345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewInt16(0, false).MustValue() }, "Int16")
+}
+
+func TestInt16Map(t *testing.T) {
+	mapped := Int16From(12).Map(func(v int16) int16 { return v * 2 })
+	if !mapped.Valid || mapped.Int16 != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewInt16(0, false).Map(func(v int16) int16 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullInt16(t, null, "Map() null")
+}
+
 func assertInt16(t *testing.T, i Int16, from string) {
 	if i.Int16 != 32766 {
 		t.Errorf("bad %s int16: %d ≠ %d\n", from, i.Int16, 32766)
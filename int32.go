@@ -2,14 +2,16 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+	"encoding/binary"
+	"encoding/xml"
 	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
-	"github.com/volatiletech/sqlboiler/randomize"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Int32 is an nullable int32.
@@ -39,26 +41,42 @@ func Int32FromPtr(i *int32) Int32 {
 	return NewInt32(*i, true)
 }
 
+// Int32FromSQL creates a new Int32 from the database/sql NullInt32 n.
+func Int32FromSQL(n sql.NullInt32) Int32 {
+	return NewInt32(n.Int32, n.Valid)
+}
+
+// Int32FromWrapperpb creates a new Int32 from a protobuf Int32Value.
+// It will be null if w is nil.
+func Int32FromWrapperpb(w *wrapperspb.Int32Value) Int32 {
+	if w == nil {
+		return NewInt32(0, false)
+	}
+	return NewInt32(w.Value, true)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (i *Int32) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		i.Valid = false
 		i.Int32 = 0
+		i.Valid = false
 		return nil
 	}
 
-	var x int64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONInt(data, 32, "Int32")
+	i.Int32, i.Valid = int32(x), valid
+	return err
+}
 
-	if x > math.MaxInt32 {
-		return fmt.Errorf("json: %d overflows max int32 value", x)
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Int32
+// to def and makes it valid, rather than null.
+func (i *Int32) UnmarshalJSONDefault(data []byte, def int32) error {
+	if bytes.Equal(data, NullBytes) {
+		i.SetValid(def)
+		return nil
 	}
-
-	i.Int32 = int32(x)
-	i.Valid = true
-	return nil
+	return i.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -73,7 +91,7 @@ func (i *Int32) UnmarshalText(text []byte) error {
 	if i.Valid {
 		i.Int32 = int32(res)
 	}
-	return err
+	return textOverflow("Int32", &i.Int32, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -81,15 +99,142 @@ func (i Int32) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+	return i.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Int32 to dst and returns
+// the extended buffer.
+func (i Int32) AppendJSON(dst []byte) []byte {
+	if !i.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendInt(dst, int64(i.Int32), 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Int32 as chosen by opts.
+func (i Int32) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(i)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Int32 is null, which UnmarshalText
+// reads back as null.
 func (i Int32) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+	return strconv.AppendInt(make([]byte, 0, maxIntegerLen), int64(i.Int32), 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Int32 as opts.NullText.
+func (i Int32) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(i, i.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Int32 is null.
+func (i Int32) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (i *Int32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Int32 is null.
+func (i Int32) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.Int32, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (i *Int32) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *int32
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int32 = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int32 = *v
+	i.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Int32 is null.
+func (i Int32) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !i.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeInt(int64(i.Int32))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (i *Int32) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *int32
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int32 = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int32 = *v
+	i.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Int32 is encoded as a single byte.
+func (i Int32) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendVarint([]byte{encodedValid}, int64(i.Int32)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int32) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Int32")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		i.Int32 = 0
+		i.Valid = false
+		return nil
+	}
+	x, err := decodeVarint(value, "Int32")
+	if err != nil {
+		return err
+	}
+	if x < math.MinInt32 || x > math.MaxInt32 {
+		return intOverflow("Int32", strconv.FormatInt(x, 10), 32)
+	}
+	i.Int32 = int32(x)
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (i Int32) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (i *Int32) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
 }
 
 // SetValid changes this Int32's value and also sets it to be non-null.
@@ -98,6 +243,24 @@ func (i *Int32) SetValid(n int32) {
 	i.Valid = true
 }
 
+// SetChecked sets this Int32 to n and makes it non-null, or returns an error and
+// leaves it unchanged if n does not fit in an int32.
+func (i *Int32) SetChecked(n int64) error {
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return intOverflow("Int32", strconv.FormatInt(n, 10), 32)
+	}
+	i.Int32 = int32(n)
+	i.Valid = true
+	return nil
+}
+
+// SetNull sets this Int32 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (i *Int32) SetNull() {
+	i.Int32 = 0
+	i.Valid = false
+}
+
 // Ptr returns a pointer to this Int32's value, or a nil pointer if this Int32 is null.
 func (i Int32) Ptr() *int32 {
 	if !i.Valid {
@@ -106,19 +269,94 @@ func (i Int32) Ptr() *int32 {
 	return &i.Int32
 }
 
-// IsZero returns true for invalid Int32's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int32) ValueOrZero() int32 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int32
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int32) ValueOr(def int32) int32 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int32
+}
+
+// Or returns this Int32 if it is valid, otherwise other.
+func (i Int32) Or(other Int32) Int32 {
+	if !i.Valid {
+		return other
+	}
+	return i
+}
+
+// MustValue returns the inner value, and panics if this Int32 is null.
+func (i Int32) MustValue() int32 {
+	if !i.Valid {
+		panic("null.Int32: MustValue called on invalid value")
+	}
+	return i.Int32
+}
+
+// Map returns a new Int32 holding fn applied to this Int32's value. A null Int32 is
+// returned unchanged and fn is not called.
+func (i Int32) Map(fn func(int32) int32) Int32 {
+	if !i.Valid {
+		return i
+	}
+	return NewInt32(fn(i.Int32), true)
+}
+
+// IsZero returns true if this Int32 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (i Int32) IsZero() bool {
 	return !i.Valid
 }
 
+// IsNull returns true if this Int32 is null, the same as !Valid.
+func (i Int32) IsNull() bool {
+	return !i.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Int32, or nil if it is null, for
+// a *Int32 field tagged omitempty that should leave nulls out.
+func (i Int32) OmitEmpty() *Int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i
+}
+
+// Equal returns true if both Int32s are null or both hold the same value.
+func (i Int32) Equal(other Int32) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int32 == other.Int32)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Int32 is null.
+func (i Int32) String() string {
+	if !i.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatInt(int64(i.Int32), 10)
+}
+
 // Scan implements the Scanner interface.
 func (i *Int32) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		i.Int32, i.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&i.Int32, value); err != nil {
+		i.Int32, i.Valid = 0, false
+		return scanError("Int32", value, &i.Int32, err)
+	}
 	i.Valid = true
-	return convert.ConvertAssign(&i.Int32, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -129,19 +367,34 @@ func (i Int32) Value() (driver.Value, error) {
 	return int64(i.Int32), nil
 }
 
+// ValueOrNil returns nil if this Int32 is null, otherwise the same value as Value.
+func (i Int32) ValueOrNil() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return int64(i.Int32)
+}
+
+// ToSQL returns this Int32 as a database/sql NullInt32.
+func (i Int32) ToSQL() sql.NullInt32 {
+	return sql.NullInt32{Int32: i.Int32, Valid: i.Valid}
+}
+
+// ToWrapperpb returns this Int32 as a protobuf Int32Value, or nil if this Int32 is null.
+func (i Int32) ToWrapperpb() *wrapperspb.Int32Value {
+	if !i.Valid {
+		return nil
+	}
+	return wrapperspb.Int32(i.Int32)
+}
+
 // Randomize for sqlboiler
 func (i *Int32) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		i.Int32 = 0
 		i.Valid = false
 	} else {
-		val, ok := randomize.MediumInt(nextInt, fieldType)
-		if ok {
-			i.Int32 = val
-		} else {
-			i.Int32 = int32(nextInt() % math.MaxInt32)
-		}
-
+		i.Int32 = int32(randomInt(nextInt, fieldType, 32))
 		i.Valid = true
 	}
 }
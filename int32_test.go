@@ -2,6 +2,7 @@ package null
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	"strconv"
 	"testing"
@@ -81,6 +82,49 @@ func TestUnmarshalInt32Overflow(t *testing.T) {
 	}
 }
 
+func TestUnmarshalInt32Bounds(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{in: strconv.FormatInt(math.MaxInt32, 10), valid: true},
+		{in: strconv.FormatInt(math.MaxInt32+1, 10), valid: false},
+		{in: strconv.FormatInt(math.MinInt32, 10), valid: true},
+		{in: strconv.FormatInt(math.MinInt32-1, 10), valid: false},
+	}
+
+	for _, test := range tests {
+		var i Int32
+		err := json.Unmarshal([]byte(test.in), &i)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.in, err)
+			}
+			if !i.Valid || strconv.FormatInt(int64(i.Int32), 10) != test.in {
+				t.Errorf("%s: bad int32: %#v", test.in, i)
+			}
+		} else {
+			if err == nil {
+				t.Errorf("%s: expected error", test.in)
+			}
+			assertNullInt32(t, i, test.in)
+		}
+	}
+}
+
+func TestUnmarshalInt32Zero(t *testing.T) {
+	var i Int32
+	err := json.Unmarshal([]byte(`0`), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int32 != 0 {
+		t.Errorf("bad zero int32: %#v", i)
+	}
+
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+}
+
 func TestTextUnmarshalInt32(t *testing.T) {
 	var i Int32
 	err := i.UnmarshalText([]byte("2147483646"))
@@ -157,6 +201,15 @@ func TestInt32SetValid(t *testing.T) {
 	assertInt32(t, change, "SetValid()")
 }
 
+func TestInt32SetNull(t *testing.T) {
+	change := Int32From(-12345)
+	change.SetNull()
+	assertNullInt32(t, change, "SetNull()")
+	if change.Int32 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Int32)
+	}
+}
+
 func TestInt32Scan(t *testing.T) {
 	var i Int32
 	err := i.Scan(2147483646)
@@ -169,6 +222,83 @@ func TestInt32Scan(t *testing.T) {
 	assertNullInt32(t, null, "scanned null")
 }
 
+func TestInt32Equal(t *testing.T) {
+	null := NewInt32(0, false)
+	other := NewInt32(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Int32From(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Int32From(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Int32From(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestInt32ValueOr(t *testing.T) {
+	valid := Int32From(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewInt32(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestInt32String(t *testing.T) {
+	v := Int32From(-12345)
+	if s := v.String(); s != "-12345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewInt32(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestInt32ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Int32From(-12345), "valid")
+	assertValueOrNil(t, NewInt32(0, false), "null")
+}
+
+func TestInt32MustValue(t *testing.T) {
+	v := Int32From(-12345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewInt32(0, false).MustValue() }, "Int32")
+}
+
+func TestInt32Map(t *testing.T) {
+	mapped := Int32From(12).Map(func(v int32) int32 { return v * 2 })
+	if !mapped.Valid || mapped.Int32 != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewInt32(0, false).Map(func(v int32) int32 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullInt32(t, null, "Map() null")
+}
+
 func assertInt32(t *testing.T, i Int32, from string) {
 	if i.Int32 != 2147483646 {
 		t.Errorf("bad %s int32: %d ≠ %d\n", from, i.Int32, 2147483646)
@@ -2,13 +2,15 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
-	"reflect"
+	"encoding/binary"
+	"encoding/xml"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Int64 is an nullable int64.
@@ -38,38 +40,42 @@ func Int64FromPtr(i *int64) Int64 {
 	return NewInt64(*i, true)
 }
 
+// Int64FromSQL creates a new Int64 from the database/sql NullInt64 n.
+func Int64FromSQL(n sql.NullInt64) Int64 {
+	return NewInt64(n.Int64, n.Valid)
+}
+
+// Int64FromWrapperpb creates a new Int64 from a protobuf Int64Value.
+// It will be null if w is nil.
+func Int64FromWrapperpb(w *wrapperspb.Int64Value) Int64 {
+	if w == nil {
+		return NewInt64(0, false)
+	}
+	return NewInt64(w.Value, true)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (i *Int64) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		i.Valid = false
 		i.Int64 = 0
+		i.Valid = false
 		return nil
 	}
 
-	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		// Unmarshal again, directly to int64, to avoid intermediate float64
-		err = json.Unmarshal(data, &i.Int64)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		i.Int64, err = strconv.ParseInt(str, 10, 64)
-	case nil:
-		i.Valid = false
+	x, valid, err := parseJSONInt(data, 64, "Int64")
+	i.Int64, i.Valid = x, valid
+	return err
+}
+
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Int64
+// to def and makes it valid, rather than null.
+func (i *Int64) UnmarshalJSONDefault(data []byte, def int64) error {
+	if bytes.Equal(data, NullBytes) {
+		i.SetValid(def)
 		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int64", reflect.TypeOf(v).Name())
 	}
-	i.Valid = (err == nil) && (i.Int64 != 0)
-	return err
+	return i.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -81,7 +87,7 @@ func (i *Int64) UnmarshalText(text []byte) error {
 	var err error
 	i.Int64, err = strconv.ParseInt(string(text), 10, 64)
 	i.Valid = err == nil
-	return err
+	return textOverflow("Int64", &i.Int64, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -89,15 +95,139 @@ func (i Int64) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return i.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Int64 to dst and returns
+// the extended buffer.
+func (i Int64) AppendJSON(dst []byte) []byte {
+	if !i.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendInt(dst, i.Int64, 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Int64 as chosen by opts.
+func (i Int64) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(i)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Int64 is null, which UnmarshalText
+// reads back as null.
 func (i Int64) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return strconv.AppendInt(make([]byte, 0, maxIntegerLen), i.Int64, 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Int64 as opts.NullText.
+func (i Int64) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(i, i.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Int64 is null.
+func (i Int64) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (i *Int64) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Int64 is null.
+func (i Int64) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.Int64, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (i *Int64) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *int64
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int64 = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int64 = *v
+	i.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Int64 is null.
+func (i Int64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !i.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeInt(i.Int64)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (i *Int64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *int64
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int64 = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int64 = *v
+	i.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Int64 is encoded as a single byte.
+func (i Int64) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendVarint([]byte{encodedValid}, i.Int64), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int64) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Int64")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		i.Int64 = 0
+		i.Valid = false
+		return nil
+	}
+	x, err := decodeVarint(value, "Int64")
+	if err != nil {
+		return err
+	}
+	i.Int64 = x
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (i Int64) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (i *Int64) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
 }
 
 // SetValid changes this Int64's value and also sets it to be non-null.
@@ -106,6 +236,13 @@ func (i *Int64) SetValid(n int64) {
 	i.Valid = true
 }
 
+// SetNull sets this Int64 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (i *Int64) SetNull() {
+	i.Int64 = 0
+	i.Valid = false
+}
+
 // Ptr returns a pointer to this Int64's value, or a nil pointer if this Int64 is null.
 func (i Int64) Ptr() *int64 {
 	if !i.Valid {
@@ -114,19 +251,115 @@ func (i Int64) Ptr() *int64 {
 	return &i.Int64
 }
 
-// IsZero returns true for invalid Int64's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int64) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int64
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int64) ValueOr(def int64) int64 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int64
+}
+
+// Or returns this Int64 if it is valid, otherwise other.
+func (i Int64) Or(other Int64) Int64 {
+	if !i.Valid {
+		return other
+	}
+	return i
+}
+
+// MustValue returns the inner value, and panics if this Int64 is null.
+func (i Int64) MustValue() int64 {
+	if !i.Valid {
+		panic("null.Int64: MustValue called on invalid value")
+	}
+	return i.Int64
+}
+
+// Map returns a new Int64 holding fn applied to this Int64's value. A null Int64 is
+// returned unchanged and fn is not called.
+func (i Int64) Map(fn func(int64) int64) Int64 {
+	if !i.Valid {
+		return i
+	}
+	return NewInt64(fn(i.Int64), true)
+}
+
+// IsZero returns true if this Int64 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (i Int64) IsZero() bool {
 	return !i.Valid
 }
 
+// IsNull returns true if this Int64 is null, the same as !Valid.
+func (i Int64) IsNull() bool {
+	return !i.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Int64, or nil if it is null, for
+// a *Int64 field tagged omitempty that should leave nulls out.
+func (i Int64) OmitEmpty() *Int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i
+}
+
+// Equal returns true if both Int64s are null or both hold the same value.
+func (i Int64) Equal(other Int64) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int64 == other.Int64)
+}
+
+// Compare returns -1 if this Int64 sorts before other, 0 if they are equal
+// and +1 if it sorts after. A null Int64 sorts before every valid one, like
+// SQL's NULLS FIRST, so it can be used with sort.Slice and slices.SortFunc.
+func (i Int64) Compare(other Int64) int {
+	switch {
+	case !i.Valid && !other.Valid:
+		return 0
+	case !i.Valid:
+		return -1
+	case !other.Valid:
+		return 1
+	}
+	switch {
+	case i.Int64 < other.Int64:
+		return -1
+	case i.Int64 > other.Int64:
+		return 1
+	}
+	return 0
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Int64 is null.
+func (i Int64) String() string {
+	if !i.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatInt(i.Int64, 10)
+}
+
 // Scan implements the Scanner interface.
 func (i *Int64) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		i.Int64, i.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&i.Int64, value); err != nil {
+		i.Int64, i.Valid = 0, false
+		return scanError("Int64", value, &i.Int64, err)
+	}
 	i.Valid = true
-	return convert.ConvertAssign(&i.Int64, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -137,13 +370,34 @@ func (i Int64) Value() (driver.Value, error) {
 	return i.Int64, nil
 }
 
+// ValueOrNil returns nil if this Int64 is null, otherwise the same value as Value.
+func (i Int64) ValueOrNil() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return i.Int64
+}
+
+// ToSQL returns this Int64 as a database/sql NullInt64.
+func (i Int64) ToSQL() sql.NullInt64 {
+	return sql.NullInt64{Int64: i.Int64, Valid: i.Valid}
+}
+
+// ToWrapperpb returns this Int64 as a protobuf Int64Value, or nil if this Int64 is null.
+func (i Int64) ToWrapperpb() *wrapperspb.Int64Value {
+	if !i.Valid {
+		return nil
+	}
+	return wrapperspb.Int64(i.Int64)
+}
+
 // Randomize for sqlboiler
 func (i *Int64) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		i.Int64 = 0
 		i.Valid = false
 	} else {
-		i.Int64 = int64(nextInt())
+		i.Int64 = int64(randomInt(nextInt, fieldType, 64))
 		i.Valid = true
 	}
 }
@@ -1,10 +1,16 @@
 package null
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
 
 var (
@@ -92,6 +98,26 @@ func TestUnmarshalInt64Overflow(t *testing.T) {
 	}
 }
 
+func TestUnmarshalInt64Zero(t *testing.T) {
+	var i Int64
+	err := json.Unmarshal([]byte(`0`), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int64 != 0 {
+		t.Errorf("bad zero int64: %#v", i)
+	}
+
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+
+	var str Int64
+	err = json.Unmarshal([]byte(`"0"`), &str)
+	maybePanic(err)
+	if !str.Valid || str.Int64 != 0 {
+		t.Errorf("bad zero string int64: %#v", str)
+	}
+}
+
 func TestTextUnmarshalInt64(t *testing.T) {
 	var i Int64
 	err := i.UnmarshalText([]byte("9223372036854775806"))
@@ -134,7 +160,7 @@ func TestInt64Pointer(t *testing.T) {
 	i := Int64From(9223372036854775806)
 	ptr := i.Ptr()
 	if *ptr != 9223372036854775806 {
-		t.Errorf("bad %s int64: %#v ≠ %d\n", "pointer", ptr, 9223372036854775806)
+		t.Errorf("bad %s int64: %#v ≠ %d\n", "pointer", ptr, int64(9223372036854775806))
 	}
 
 	null := NewInt64(0, false)
@@ -168,9 +194,18 @@ func TestInt64SetValid(t *testing.T) {
 	assertInt64(t, change, "SetValid()")
 }
 
+func TestInt64SetNull(t *testing.T) {
+	change := Int64From(-12345)
+	change.SetNull()
+	assertNullInt64(t, change, "SetNull()")
+	if change.Int64 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Int64)
+	}
+}
+
 func TestInt64Scan(t *testing.T) {
 	var i Int64
-	err := i.Scan(9223372036854775806)
+	err := i.Scan(int64(9223372036854775806))
 	maybePanic(err)
 	assertInt64(t, i, "scanned int64")
 
@@ -180,9 +215,190 @@ func TestInt64Scan(t *testing.T) {
 	assertNullInt64(t, null, "scanned null")
 }
 
+func TestInt64Equal(t *testing.T) {
+	null := NewInt64(0, false)
+	other := NewInt64(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Int64From(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Int64From(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Int64From(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestInt64Compare(t *testing.T) {
+	null := NewInt64(0, false)
+	tests := []struct {
+		a, b Int64
+		want int
+	}{
+		{null, NewInt64(5, false), 0},
+		{null, Int64From(math.MinInt64), -1},
+		{Int64From(math.MinInt64), null, 1},
+		{Int64From(-1), Int64From(1), -1},
+		{Int64From(1), Int64From(-1), 1},
+		{Int64From(7), Int64From(7), 0},
+	}
+	for _, test := range tests {
+		if got := test.a.Compare(test.b); got != test.want {
+			t.Errorf("bad Compare(%v, %v): %d ≠ %d", test.a, test.b, got, test.want)
+		}
+	}
+
+	vals := []Int64{Int64From(3), null, Int64From(-2), Int64From(0), null}
+	sort.Slice(vals, func(i, j int) bool { return vals[i].Compare(vals[j]) < 0 })
+	want := []Int64{null, null, Int64From(-2), Int64From(0), Int64From(3)}
+	for i := range want {
+		if !vals[i].Equal(want[i]) {
+			t.Errorf("bad sorted value %d: %v ≠ %v", i, vals[i], want[i])
+		}
+	}
+}
+
+func TestInt64ValueOr(t *testing.T) {
+	valid := Int64From(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewInt64(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestInt64String(t *testing.T) {
+	v := Int64From(-9223372036854775808)
+	if s := v.String(); s != "-9223372036854775808" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewInt64(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestInt64Msgpack(t *testing.T) {
+	i := Int64From(9223372036854775806)
+	data, err := msgpack.Marshal(i)
+	maybePanic(err)
+
+	// valid values must encode as a native msgpack int
+	var n int64
+	err = msgpack.Unmarshal(data, &n)
+	maybePanic(err)
+	if n != 9223372036854775806 {
+		t.Errorf("bad msgpack int: %d", n)
+	}
+
+	var out Int64
+	err = msgpack.Unmarshal(data, &out)
+	maybePanic(err)
+	assertInt64(t, out, "msgpack")
+
+	data, err = msgpack.Marshal(NewInt64(0, false))
+	maybePanic(err)
+	if !bytes.Equal(data, []byte{msgpcode.Nil}) {
+		t.Errorf("bad null msgpack: %x", data)
+	}
+
+	null := Int64From(1)
+	err = msgpack.Unmarshal(data, &null)
+	maybePanic(err)
+	assertNullInt64(t, null, "msgpack nil")
+}
+
+func TestInt64ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Int64From(-12345), "valid")
+	assertValueOrNil(t, NewInt64(0, false), "null")
+}
+
+func TestInt64MustValue(t *testing.T) {
+	v := Int64From(-12345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewInt64(0, false).MustValue() }, "Int64")
+}
+
+func TestInt64Map(t *testing.T) {
+	mapped := Int64From(12).Map(func(v int64) int64 { return v * 2 })
+	if !mapped.Valid || mapped.Int64 != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewInt64(0, false).Map(func(v int64) int64 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullInt64(t, null, "Map() null")
+}
+
+func TestUnmarshalInt64Inputs(t *testing.T) {
+	tests := []struct {
+		in    string
+		value int64
+		valid bool
+		err   bool
+	}{
+		{in: `0`, valid: true},
+		{in: `-0`, valid: true},
+		{in: `42`, value: 42, valid: true},
+		{in: `-42`, value: -42, valid: true},
+		{in: `9223372036854775807`, value: math.MaxInt64, valid: true},
+		{in: `-9223372036854775808`, value: math.MinInt64, valid: true},
+		{in: `9223372036854775808`, err: true},
+		{in: `1e3`, err: true},
+		{in: `1.0`, err: true},
+		{in: `01`, err: true},
+		{in: `-`, err: true},
+		{in: `"42"`, value: 42, valid: true},
+		{in: `"-42"`, value: -42, valid: true},
+		{in: `"\u0034\u0032"`, value: 42, valid: true},
+		{in: `""`},
+		{in: `" 42"`, err: true},
+		{in: `"4\n2"`, err: true},
+		{in: `"9223372036854775808"`, err: true},
+		{in: `true`, err: true},
+		{in: `[]`, err: true},
+		{in: `{}`, err: true},
+	}
+	for _, test := range tests {
+		var i Int64
+		err := json.Unmarshal([]byte(test.in), &i)
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error: %v", test.in, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if i.Valid != test.valid || i.Int64 != test.value {
+			t.Errorf("%s: bad result: %#v", test.in, i)
+		}
+	}
+}
+
 func assertInt64(t *testing.T, i Int64, from string) {
 	if i.Int64 != 9223372036854775806 {
-		t.Errorf("bad %s int64: %d ≠ %d\n", from, i.Int64, 9223372036854775806)
+		t.Errorf("bad %s int64: %d ≠ %d\n", from, i.Int64, int64(9223372036854775806))
 	}
 	if !i.Valid {
 		t.Error(from, "is invalid, but should be valid")
@@ -194,3 +410,39 @@ func assertNullInt64(t *testing.T, i Int64, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func BenchmarkUnmarshalInt64(b *testing.B) {
+	inputs := [][]byte{int64JSON, int64StringJSON, nullJSON}
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		var i Int64
+		for _, data := range inputs {
+			if err := json.Unmarshal(data, &i); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkMarshalInt64(b *testing.B) {
+	values := []Int64{Int64From(-1234567890), Int64From(42), NewInt64(0, false)}
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, i := range values {
+			if _, err := i.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkInt64AppendJSON(b *testing.B) {
+	values := []Int64{Int64From(-1234567890), Int64From(42), NewInt64(0, false)}
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, i := range values {
+			buf = i.AppendJSON(buf[:0])
+		}
+	}
+}
@@ -3,11 +3,12 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+	"encoding/binary"
+	"encoding/xml"
 	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
 )
 
@@ -39,25 +40,27 @@ func Int8FromPtr(i *int8) Int8 {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (i *Int8) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		i.Valid = false
 		i.Int8 = 0
+		i.Valid = false
 		return nil
 	}
 
-	var x int64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONInt(data, 8, "Int8")
+	i.Int8, i.Valid = int8(x), valid
+	return err
+}
 
-	if x > math.MaxInt8 {
-		return fmt.Errorf("json: %d overflows max int8 value", x)
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Int8
+// to def and makes it valid, rather than null.
+func (i *Int8) UnmarshalJSONDefault(data []byte, def int8) error {
+	if bytes.Equal(data, NullBytes) {
+		i.SetValid(def)
+		return nil
 	}
-
-	i.Int8 = int8(x)
-	i.Valid = true
-	return nil
+	return i.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -72,7 +75,7 @@ func (i *Int8) UnmarshalText(text []byte) error {
 	if i.Valid {
 		i.Int8 = int8(res)
 	}
-	return err
+	return textOverflow("Int8", &i.Int8, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -80,15 +83,142 @@ func (i Int8) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int8), 10)), nil
+	return i.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Int8 to dst and returns
+// the extended buffer.
+func (i Int8) AppendJSON(dst []byte) []byte {
+	if !i.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendInt(dst, int64(i.Int8), 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Int8 as chosen by opts.
+func (i Int8) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(i)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Int8 is null, which UnmarshalText
+// reads back as null.
 func (i Int8) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int8), 10)), nil
+	return strconv.AppendInt(make([]byte, 0, maxIntegerLen), int64(i.Int8), 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Int8 as opts.NullText.
+func (i Int8) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(i, i.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Int8 is null.
+func (i Int8) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (i *Int8) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Int8 is null.
+func (i Int8) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.Int8, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (i *Int8) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *int8
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int8 = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int8 = *v
+	i.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Int8 is null.
+func (i Int8) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !i.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeInt(int64(i.Int8))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (i *Int8) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *int8
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		i.Int8 = 0
+		i.Valid = false
+		return nil
+	}
+	i.Int8 = *v
+	i.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Int8 is encoded as a single byte.
+func (i Int8) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendVarint([]byte{encodedValid}, int64(i.Int8)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int8) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Int8")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		i.Int8 = 0
+		i.Valid = false
+		return nil
+	}
+	x, err := decodeVarint(value, "Int8")
+	if err != nil {
+		return err
+	}
+	if x < math.MinInt8 || x > math.MaxInt8 {
+		return intOverflow("Int8", strconv.FormatInt(x, 10), 8)
+	}
+	i.Int8 = int8(x)
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (i Int8) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (i *Int8) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
 }
 
 // SetValid changes this Int8's value and also sets it to be non-null.
@@ -97,6 +227,24 @@ func (i *Int8) SetValid(n int8) {
 	i.Valid = true
 }
 
+// SetChecked sets this Int8 to n and makes it non-null, or returns an error and
+// leaves it unchanged if n does not fit in an int8.
+func (i *Int8) SetChecked(n int64) error {
+	if n < math.MinInt8 || n > math.MaxInt8 {
+		return intOverflow("Int8", strconv.FormatInt(n, 10), 8)
+	}
+	i.Int8 = int8(n)
+	i.Valid = true
+	return nil
+}
+
+// SetNull sets this Int8 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (i *Int8) SetNull() {
+	i.Int8 = 0
+	i.Valid = false
+}
+
 // Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
 func (i Int8) Ptr() *int8 {
 	if !i.Valid {
@@ -105,19 +253,94 @@ func (i Int8) Ptr() *int8 {
 	return &i.Int8
 }
 
-// IsZero returns true for invalid Int8's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int8) ValueOrZero() int8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int8
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int8) ValueOr(def int8) int8 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int8
+}
+
+// Or returns this Int8 if it is valid, otherwise other.
+func (i Int8) Or(other Int8) Int8 {
+	if !i.Valid {
+		return other
+	}
+	return i
+}
+
+// MustValue returns the inner value, and panics if this Int8 is null.
+func (i Int8) MustValue() int8 {
+	if !i.Valid {
+		panic("null.Int8: MustValue called on invalid value")
+	}
+	return i.Int8
+}
+
+// Map returns a new Int8 holding fn applied to this Int8's value. A null Int8 is
+// returned unchanged and fn is not called.
+func (i Int8) Map(fn func(int8) int8) Int8 {
+	if !i.Valid {
+		return i
+	}
+	return NewInt8(fn(i.Int8), true)
+}
+
+// IsZero returns true if this Int8 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (i Int8) IsZero() bool {
 	return !i.Valid
 }
 
+// IsNull returns true if this Int8 is null, the same as !Valid.
+func (i Int8) IsNull() bool {
+	return !i.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Int8, or nil if it is null, for
+// a *Int8 field tagged omitempty that should leave nulls out.
+func (i Int8) OmitEmpty() *Int8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i
+}
+
+// Equal returns true if both Int8s are null or both hold the same value.
+func (i Int8) Equal(other Int8) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int8 == other.Int8)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Int8 is null.
+func (i Int8) String() string {
+	if !i.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatInt(int64(i.Int8), 10)
+}
+
 // Scan implements the Scanner interface.
 func (i *Int8) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		i.Int8, i.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&i.Int8, value); err != nil {
+		i.Int8, i.Valid = 0, false
+		return scanError("Int8", value, &i.Int8, err)
+	}
 	i.Valid = true
-	return convert.ConvertAssign(&i.Int8, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -128,13 +351,21 @@ func (i Int8) Value() (driver.Value, error) {
 	return int64(i.Int8), nil
 }
 
+// ValueOrNil returns nil if this Int8 is null, otherwise the same value as Value.
+func (i Int8) ValueOrNil() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return int64(i.Int8)
+}
+
 // Randomize for sqlboiler
 func (i *Int8) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		i.Int8 = 0
 		i.Valid = false
 	} else {
-		i.Int8 = int8(nextInt() % math.MaxInt8)
+		i.Int8 = int8(randomInt(nextInt, fieldType, 8))
 		i.Valid = true
 	}
 }
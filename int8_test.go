@@ -2,6 +2,7 @@ package null
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	"strconv"
 	"testing"
@@ -81,6 +82,49 @@ func TestUnmarshalInt8Overflow(t *testing.T) {
 	}
 }
 
+func TestUnmarshalInt8Bounds(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{in: strconv.FormatInt(math.MaxInt8, 10), valid: true},
+		{in: strconv.FormatInt(math.MaxInt8+1, 10), valid: false},
+		{in: strconv.FormatInt(math.MinInt8, 10), valid: true},
+		{in: strconv.FormatInt(math.MinInt8-1, 10), valid: false},
+	}
+
+	for _, test := range tests {
+		var i Int8
+		err := json.Unmarshal([]byte(test.in), &i)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.in, err)
+			}
+			if !i.Valid || strconv.FormatInt(int64(i.Int8), 10) != test.in {
+				t.Errorf("%s: bad int8: %#v", test.in, i)
+			}
+		} else {
+			if err == nil {
+				t.Errorf("%s: expected error", test.in)
+			}
+			assertNullInt8(t, i, test.in)
+		}
+	}
+}
+
+func TestUnmarshalInt8Zero(t *testing.T) {
+	var i Int8
+	err := json.Unmarshal([]byte(`0`), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int8 != 0 {
+		t.Errorf("bad zero int8: %#v", i)
+	}
+
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+}
+
 func TestTextUnmarshalInt8(t *testing.T) {
 	var i Int8
 	err := i.UnmarshalText([]byte("126"))
@@ -157,6 +201,15 @@ func TestInt8SetValid(t *testing.T) {
 	assertInt8(t, change, "SetValid()")
 }
 
+func TestInt8SetNull(t *testing.T) {
+	change := Int8From(-123)
+	change.SetNull()
+	assertNullInt8(t, change, "SetNull()")
+	if change.Int8 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Int8)
+	}
+}
+
 func TestInt8Scan(t *testing.T) {
 	var i Int8
 	err := i.Scan(126)
@@ -169,6 +222,83 @@ func TestInt8Scan(t *testing.T) {
 	assertNullInt8(t, null, "scanned null")
 }
 
+func TestInt8Equal(t *testing.T) {
+	null := NewInt8(0, false)
+	other := NewInt8(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Int8From(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Int8From(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Int8From(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestInt8ValueOr(t *testing.T) {
+	valid := Int8From(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewInt8(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestInt8String(t *testing.T) {
+	v := Int8From(-123)
+	if s := v.String(); s != "-123" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewInt8(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestInt8ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Int8From(-123), "valid")
+	assertValueOrNil(t, NewInt8(0, false), "null")
+}
+
+func TestInt8MustValue(t *testing.T) {
+	v := Int8From(-123)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewInt8(0, false).MustValue() }, "Int8")
+}
+
+func TestInt8Map(t *testing.T) {
+	mapped := Int8From(12).Map(func(v int8) int8 { return v * 2 })
+	if !mapped.Valid || mapped.Int8 != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewInt8(0, false).Map(func(v int8) int8 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullInt8(t, null, "Map() null")
+}
+
 func assertInt8(t *testing.T, i Int8, from string) {
 	if i.Int8 != 126 {
 		t.Errorf("bad %s int8: %d ≠ %d\n", from, i.Int8, 126)
@@ -2,6 +2,8 @@ package null
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"testing"
 )
 
@@ -63,6 +65,59 @@ func TestUnmarshalNonIntegerNumber(t *testing.T) {
 	}
 }
 
+func TestUnmarshalIntZero(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte(`0`), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int != 0 {
+		t.Errorf("bad zero int: %#v", i)
+	}
+
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+}
+
+func TestUnmarshalIntWidth(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte(`5000000000`), &i)
+	if strconv.IntSize == 32 {
+		if err == nil || err.Error() != "json: 5000000000 overflows max int value" {
+			t.Errorf("bad 32-bit overflow error: %v", err)
+		}
+		assertNullInt(t, i, "32-bit overflow json")
+	} else {
+		maybePanic(err)
+		if !i.Valid || int64(i.Int) != 5000000000 {
+			t.Errorf("bad 64-bit json: %#v", i)
+		}
+	}
+
+	var neg Int
+	err = json.Unmarshal([]byte(`-5000000000`), &neg)
+	if strconv.IntSize == 32 {
+		if err == nil || err.Error() != "json: -5000000000 underflows min int value" {
+			t.Errorf("bad 32-bit underflow error: %v", err)
+		}
+	} else {
+		maybePanic(err)
+	}
+
+	var scanned Int
+	err = scanned.Scan(int64(5000000000))
+	if strconv.IntSize == 32 {
+		if err == nil {
+			t.Error("expected 32-bit scan overflow error")
+		}
+		assertNullInt(t, scanned, "32-bit overflow scan")
+	} else {
+		maybePanic(err)
+		if !scanned.Valid || int64(scanned.Int) != 5000000000 {
+			t.Errorf("bad 64-bit scan: %#v", scanned)
+		}
+	}
+}
+
 func TestTextUnmarshalInt(t *testing.T) {
 	var i Int
 	err := i.UnmarshalText([]byte("12345"))
@@ -139,6 +194,15 @@ func TestIntSetValid(t *testing.T) {
 	assertInt(t, change, "SetValid()")
 }
 
+func TestIntSetNull(t *testing.T) {
+	change := IntFrom(-12345)
+	change.SetNull()
+	assertNullInt(t, change, "SetNull()")
+	if change.Int != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Int)
+	}
+}
+
 func TestIntScan(t *testing.T) {
 	var i Int
 	err := i.Scan(12345)
@@ -151,6 +215,83 @@ func TestIntScan(t *testing.T) {
 	assertNullInt(t, null, "scanned null")
 }
 
+func TestIntEqual(t *testing.T) {
+	null := NewInt(0, false)
+	other := NewInt(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := IntFrom(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := IntFrom(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := IntFrom(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestIntValueOr(t *testing.T) {
+	valid := IntFrom(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewInt(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestIntString(t *testing.T) {
+	v := IntFrom(-12345)
+	if s := v.String(); s != "-12345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewInt(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestIntValueOrNil(t *testing.T) {
+	assertValueOrNil(t, IntFrom(-12345), "valid")
+	assertValueOrNil(t, NewInt(0, false), "null")
+}
+
+func TestIntMustValue(t *testing.T) {
+	v := IntFrom(-12345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewInt(0, false).MustValue() }, "Int")
+}
+
+func TestIntMap(t *testing.T) {
+	mapped := IntFrom(12).Map(func(v int) int { return v * 2 })
+	if !mapped.Valid || mapped.Int != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewInt(0, false).Map(func(v int) int {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullInt(t, null, "Map() null")
+}
+
 func assertInt(t *testing.T, i Int, from string) {
 	if i.Int != 12345 {
 		t.Errorf("bad %s int: %d ≠ %d\n", from, i.Int, 12345)
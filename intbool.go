@@ -0,0 +1,186 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// IntBool is a nullable bool stored in the database as the integer 1 or 0,
+// for SQLite and MySQL tinyint(1) columns and other schemas without a boolean
+// type. Value returns an int64, while Scan, like Bool's, accepts a bool, the
+// integers 1 and 0, and strings such as "1", "0", "true" and "false". It
+// otherwise behaves like Bool, which keeps returning a bool from Value.
+type IntBool Bool
+
+// NewIntBool creates a new IntBool
+func NewIntBool(b bool, valid bool) IntBool {
+	return IntBool{
+		Bool:  b,
+		Valid: valid,
+	}
+}
+
+// IntBoolFrom creates a new IntBool that will always be valid.
+func IntBoolFrom(b bool) IntBool {
+	return NewIntBool(b, true)
+}
+
+// IntBoolFromPtr creates a new IntBool that will be null if b is nil.
+func IntBoolFromPtr(b *bool) IntBool {
+	if b == nil {
+		return NewIntBool(false, false)
+	}
+	return NewIntBool(*b, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same input as Bool.UnmarshalJSON.
+func (b *IntBool) UnmarshalJSON(data []byte) error {
+	return (*Bool)(b).UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the same input as Bool.UnmarshalText.
+func (b *IntBool) UnmarshalText(text []byte) error {
+	return (*Bool)(b).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It encodes a JSON boolean, like Bool, not 1 or 0.
+func (b IntBool) MarshalJSON() ([]byte, error) {
+	return Bool(b).MarshalJSON()
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null IntBool as chosen by opts.
+func (b IntBool) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes "true" or "false", or empty text if this IntBool is null.
+func (b IntBool) MarshalText() ([]byte, error) {
+	return Bool(b).MarshalText()
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this IntBool is null.
+func (b IntBool) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, b, b.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (b *IntBool) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// SetValid changes this IntBool's value and also sets it to be non-null.
+func (b *IntBool) SetValid(v bool) {
+	b.Bool = v
+	b.Valid = true
+}
+
+// SetNull sets this IntBool to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (b *IntBool) SetNull() {
+	b.Bool = false
+	b.Valid = false
+}
+
+// Ptr returns a pointer to this IntBool's value, or a nil pointer if this IntBool is null.
+func (b IntBool) Ptr() *bool {
+	return Bool(b).Ptr()
+}
+
+// ValueOrZero returns the inner value if valid, otherwise false.
+func (b IntBool) ValueOrZero() bool {
+	return Bool(b).ValueOrZero()
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (b IntBool) ValueOr(def bool) bool {
+	return Bool(b).ValueOr(def)
+}
+
+// Or returns this IntBool if it is valid, otherwise other.
+func (b IntBool) Or(other IntBool) IntBool {
+	if !b.Valid {
+		return other
+	}
+	return b
+}
+
+// MustValue returns the inner value, and panics if this IntBool is null.
+func (b IntBool) MustValue() bool {
+	if !b.Valid {
+		panic("null.IntBool: MustValue called on invalid value")
+	}
+	return b.Bool
+}
+
+// IsZero returns true if this IntBool is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (b IntBool) IsZero() bool {
+	return !b.Valid
+}
+
+// IsNull returns true if this IntBool is null, the same as !Valid.
+func (b IntBool) IsNull() bool {
+	return !b.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this IntBool, or nil if it is null, for
+// a *IntBool field tagged omitempty that should leave nulls out.
+func (b IntBool) OmitEmpty() *IntBool {
+	if !b.Valid {
+		return nil
+	}
+	return &b
+}
+
+// Equal returns true if both IntBools are null or both hold the same value.
+func (b IntBool) Equal(other IntBool) bool {
+	return Bool(b).Equal(Bool(other))
+}
+
+// String implements fmt.Stringer.
+// It returns "true" or "false", or NullDisplay if this IntBool is null.
+func (b IntBool) String() string {
+	return Bool(b).String()
+}
+
+// Scan implements the Scanner interface.
+// It accepts the same values as Bool.Scan.
+func (b *IntBool) Scan(value interface{}) error {
+	return (*Bool)(b).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+// It returns int64(1) for true and int64(0) for false.
+func (b IntBool) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.asInt64(), nil
+}
+
+// ValueOrNil returns nil if this IntBool is null, otherwise the same value as Value.
+func (b IntBool) ValueOrNil() interface{} {
+	if !b.Valid {
+		return nil
+	}
+	return b.asInt64()
+}
+
+// asInt64 returns 1 for true and 0 for false.
+func (b IntBool) asInt64() int64 {
+	if b.Bool {
+		return 1
+	}
+	return 0
+}
+
+// Randomize for sqlboiler
+func (b *IntBool) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	(*Bool)(b).Randomize(nextInt, fieldType, shouldBeNull)
+}
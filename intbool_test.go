@@ -0,0 +1,81 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIntBoolValue(t *testing.T) {
+	if v, err := IntBoolFrom(true).Value(); v != int64(1) || err != nil {
+		t.Error("bad true value or err:", v, err)
+	}
+	if v, err := IntBoolFrom(false).Value(); v != int64(0) || err != nil {
+		t.Error("bad false value or err:", v, err)
+	}
+	if v, err := NewIntBool(true, false).Value(); v != nil || err != nil {
+		t.Error("bad null value or err:", v, err)
+	}
+	assertValueOrNil(t, IntBoolFrom(true), "valid")
+	assertValueOrNil(t, NewIntBool(true, false), "null")
+
+	// Bool itself still hands the driver a bool
+	if v, err := BoolFrom(true).Value(); v != true || err != nil {
+		t.Error("bad Bool value or err:", v, err)
+	}
+}
+
+func TestIntBoolScanRoundTrip(t *testing.T) {
+	for _, in := range []interface{}{int64(1), int64(0), "1", "0", "true", "false", []byte("1"), []byte("0"), true, false} {
+		var b IntBool
+		maybePanic(b.Scan(in))
+		if !b.Valid {
+			t.Errorf("%#v: should be valid", in)
+		}
+		v, err := b.Value()
+		maybePanic(err)
+		var back IntBool
+		maybePanic(back.Scan(v))
+		if !back.Equal(b) {
+			t.Errorf("%#v: bad round trip through %#v: %v ≠ %v", in, v, back, b)
+		}
+	}
+
+	null := IntBoolFrom(true)
+	maybePanic(null.Scan(nil))
+	if null.Valid {
+		t.Error("scanned nil should be null")
+	}
+
+	wrong := IntBoolFrom(true)
+	if err := wrong.Scan(int64(2)); err == nil {
+		t.Error("expected error")
+	}
+	if wrong.Valid {
+		t.Error("a failed Scan should leave the IntBool null")
+	}
+}
+
+func TestIntBoolJSON(t *testing.T) {
+	data, err := json.Marshal(IntBoolFrom(true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "true", "non-empty json marshal")
+
+	var b IntBool
+	err = json.Unmarshal([]byte(`1`), &b)
+	maybePanic(err)
+	if !b.Valid || !b.Bool {
+		t.Errorf("bad unmarshaled 1: %#v", b)
+	}
+
+	var null IntBool
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid || null.String() != NullDisplay {
+		t.Errorf("bad null json: %#v", null)
+	}
+}
+
+func TestIntBoolMustValue(t *testing.T) {
+	v := IntBoolFrom(true)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewIntBool(false, false).MustValue() }, "IntBool")
+}
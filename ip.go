@@ -0,0 +1,366 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// IP is a nullable net.IP. Both IPv4 and IPv6 addresses are supported.
+type IP struct {
+	IP    net.IP
+	Valid bool
+}
+
+// NewIP creates a new IP
+func NewIP(ip net.IP, valid bool) IP {
+	return IP{
+		IP:    ip,
+		Valid: valid,
+	}
+}
+
+// IPFrom creates a new IP that will be invalid if ip is empty.
+func IPFrom(ip net.IP) IP {
+	return NewIP(ip, len(ip) != 0)
+}
+
+// IPFromPtr creates a new IP that will be invalid if ip is nil or empty.
+func IPFromPtr(ip *net.IP) IP {
+	if ip == nil {
+		return NewIP(nil, false)
+	}
+	return IPFrom(*ip)
+}
+
+// ParseIP parses an IPv4 or IPv6 address such as "192.0.2.1" into a valid IP, as UnmarshalText
+// does, but returns an error rather than a null IP for empty text.
+func ParseIP(s string) (IP, error) {
+	return parseText[IP]("IP", s)
+}
+
+// MustIP is like ParseIP, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustIP(s string) IP {
+	v, err := ParseIP(s)
+	return mustParse("MustIP", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// An empty string will be null.
+func (i *IP) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		i.IP = nil
+		i.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		i.IP, i.Valid = nil, false
+		return err
+	}
+
+	return i.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *IP) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		i.IP = nil
+		i.Valid = false
+		return nil
+	}
+
+	ip, err := parseIP(string(text))
+	if err != nil {
+		return err
+	}
+
+	i.IP = ip
+	i.Valid = true
+	return nil
+}
+
+// parseIP parses a textual address. The Postgres inet form with a prefix
+// length, such as "192.168.0.1/24", is accepted and the prefix is dropped.
+func parseIP(s string) (net.IP, error) {
+	if strings.IndexByte(s, '/') >= 0 {
+		ip, _, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("null: invalid IP address %q", s)
+		}
+		return ip, nil
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("null: invalid IP address %q", s)
+	}
+	return ip, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i IP) MarshalJSON() ([]byte, error) {
+	if !i.Valid || len(i.IP) == 0 {
+		return NullBytes, nil
+	}
+	return []byte(`"` + i.IP.String() + `"`), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null IP as chosen by opts.
+func (i IP) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(i)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i IP) MarshalText() ([]byte, error) {
+	if !i.Valid || len(i.IP) == 0 {
+		return []byte{}, nil
+	}
+	return []byte(i.IP.String()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this IP is null.
+func (i IP) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (i *IP) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this IP is null.
+func (i IP) MarshalYAML() (interface{}, error) {
+	if !i.Valid || len(i.IP) == 0 {
+		return nil, nil
+	}
+	return i.IP.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (i *IP) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return i.UnmarshalText(nil)
+	}
+	return i.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this IP is null.
+func (i IP) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !i.Valid || len(i.IP) == 0 {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(i.IP.String())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (i *IP) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return i.UnmarshalText(nil)
+	}
+	return i.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null or empty IP is encoded as a single byte. IPv4 addresses are encoded
+// in their length prefixed 4 byte form.
+func (i IP) MarshalBinary() ([]byte, error) {
+	if !i.Valid || len(i.IP) == 0 {
+		return []byte{encodedNull}, nil
+	}
+	ip := i.IP
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, ip), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *IP) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "IP")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		i.IP = nil
+		i.Valid = false
+		return nil
+	}
+	b, err := decodeLengthPrefixed(value, "IP")
+	if err != nil {
+		return err
+	}
+	if len(b) != net.IPv4len && len(b) != net.IPv6len {
+		return fmt.Errorf("null: expected %d or %d bytes of data for null.IP, got %d", net.IPv4len, net.IPv6len, len(b))
+	}
+	i.IP = b
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (i IP) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (i *IP) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// SetValid changes this IP's value and also sets it to be non-null.
+func (i *IP) SetValid(n net.IP) {
+	i.IP = n
+	i.Valid = true
+}
+
+// SetNull sets this IP to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (i *IP) SetNull() {
+	i.IP = nil
+	i.Valid = false
+}
+
+// Ptr returns a pointer to this IP's value, or a nil pointer if this IP is null.
+func (i IP) Ptr() *net.IP {
+	if !i.Valid {
+		return nil
+	}
+	return &i.IP
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (i IP) ValueOrZero() net.IP {
+	if !i.Valid {
+		return nil
+	}
+	return i.IP
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i IP) ValueOr(def net.IP) net.IP {
+	if !i.Valid {
+		return def
+	}
+	return i.IP
+}
+
+// Or returns this IP if it is valid, otherwise other.
+func (i IP) Or(other IP) IP {
+	if !i.Valid {
+		return other
+	}
+	return i
+}
+
+// MustValue returns the inner value, and panics if this IP is null.
+func (i IP) MustValue() net.IP {
+	if !i.Valid {
+		panic("null.IP: MustValue called on invalid value")
+	}
+	return i.IP
+}
+
+// IsZero returns true if this IP is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (i IP) IsZero() bool {
+	return !i.Valid
+}
+
+// IsNull returns true if this IP is null, the same as !Valid.
+func (i IP) IsNull() bool {
+	return !i.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this IP, or nil if it is null, for
+// a *IP field tagged omitempty that should leave nulls out.
+func (i IP) OmitEmpty() *IP {
+	if !i.Valid {
+		return nil
+	}
+	return &i
+}
+
+// Equal returns true if both IPs are null or both hold the same address.
+// An IPv4 address and its IPv4-in-IPv6 form are considered equal.
+func (i IP) Equal(other IP) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.IP.Equal(other.IP))
+}
+
+// String implements fmt.Stringer.
+// It returns the address in its usual string form, or NullDisplay if this IP is null or empty.
+func (i IP) String() string {
+	if !i.Valid || len(i.IP) == 0 {
+		return NullDisplay
+	}
+	return i.IP.String()
+}
+
+// Scan implements the Scanner interface.
+// It accepts the textual form, including the Postgres inet form, as a string or []byte.
+func (i *IP) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case string:
+		i.IP, err = parseIP(x)
+	case []byte:
+		i.IP, err = parseIP(string(x))
+	case nil:
+		i.IP, i.Valid = nil, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	i.Valid = err == nil
+	return scanError("IP", value, nil, err)
+}
+
+// Value implements the driver Valuer interface.
+func (i IP) Value() (driver.Value, error) {
+	if !i.Valid || len(i.IP) == 0 {
+		return nil, nil
+	}
+	return i.IP.String(), nil
+}
+
+// ValueOrNil returns nil if this IP is null, otherwise the same value as Value.
+func (i IP) ValueOrNil() interface{} {
+	if !i.Valid || len(i.IP) == 0 {
+		return nil
+	}
+	return i.IP.String()
+}
+
+// Randomize for sqlboiler
+func (i *IP) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		i.IP = nil
+		i.Valid = false
+	} else {
+		i.IP = net.IPv4(byte(nextInt()%256), byte(nextInt()%256), byte(nextInt()%256), byte(nextInt()%256))
+		i.Valid = true
+	}
+}
@@ -0,0 +1,263 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+)
+
+var (
+	ipString   = "192.168.0.1"
+	ipJSON     = []byte(`"` + ipString + `"`)
+	ipValue    = net.ParseIP(ipString)
+	ipv6String = "2001:db8::68"
+	ipv6JSON   = []byte(`"` + ipv6String + `"`)
+	ipv6Value  = net.ParseIP(ipv6String)
+)
+
+func TestIPFrom(t *testing.T) {
+	i := IPFrom(ipValue)
+	assertIP(t, i, ipValue, "IPFrom()")
+
+	empty := IPFrom(net.IP{})
+	assertNullIP(t, empty, "IPFrom(net.IP{})")
+}
+
+func TestIPFromPtr(t *testing.T) {
+	v := ipValue
+	i := IPFromPtr(&v)
+	assertIP(t, i, ipValue, "IPFromPtr()")
+
+	null := IPFromPtr(nil)
+	assertNullIP(t, null, "IPFromPtr(nil)")
+}
+
+func TestUnmarshalIP(t *testing.T) {
+	var i IP
+	err := json.Unmarshal(ipJSON, &i)
+	maybePanic(err)
+	assertIP(t, i, ipValue, "ipv4 json")
+
+	var v6 IP
+	err = json.Unmarshal(ipv6JSON, &v6)
+	maybePanic(err)
+	assertIP(t, v6, ipv6Value, "ipv6 json")
+
+	var mapped IP
+	err = json.Unmarshal([]byte(`"::ffff:192.168.0.1"`), &mapped)
+	maybePanic(err)
+	assertIP(t, mapped, ipValue, "ipv4-mapped ipv6 json")
+
+	var null IP
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullIP(t, null, "null json")
+
+	var blank IP
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullIP(t, blank, "blank json string")
+
+	var badType IP
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullIP(t, badType, "wrong type json")
+
+	var invalid IP
+	err = json.Unmarshal(stringJSON, &invalid)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	if err.Error() != `null: invalid IP address "test"` {
+		t.Errorf("bad error: %v", err)
+	}
+	assertNullIP(t, invalid, "invalid ip json")
+}
+
+func TestTextUnmarshalIP(t *testing.T) {
+	var i IP
+	err := i.UnmarshalText([]byte(ipString))
+	maybePanic(err)
+	assertIP(t, i, ipValue, "UnmarshalText() ip")
+
+	var blank IP
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullIP(t, blank, "UnmarshalText() empty ip")
+}
+
+func TestMarshalIP(t *testing.T) {
+	i := IPFrom(ipValue)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(ipJSON), "ipv4 json marshal")
+
+	v6 := IPFrom(ipv6Value)
+	data, err = json.Marshal(v6)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(ipv6JSON), "ipv6 json marshal")
+
+	// invalid and empty values should be encoded as null
+	null := NewIP(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	empty := NewIP(net.IP{}, true)
+	data, err = json.Marshal(empty)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "empty json marshal")
+
+	var roundTrip IP
+	err = json.Unmarshal(data, &roundTrip)
+	maybePanic(err)
+	assertNullIP(t, roundTrip, "empty json round trip")
+}
+
+func TestMarshalIPText(t *testing.T) {
+	i := IPFrom(ipValue)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, ipString, "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewIP(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestIPPointer(t *testing.T) {
+	i := IPFrom(ipValue)
+	ptr := i.Ptr()
+	if !ptr.Equal(ipValue) {
+		t.Errorf("bad %s ip: %#v ≠ %s\n", "pointer", ptr, ipValue)
+	}
+
+	null := NewIP(nil, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s ip: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestIPIsZero(t *testing.T) {
+	i := IPFrom(ipValue)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewIP(nil, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestIPSetValid(t *testing.T) {
+	change := NewIP(nil, false)
+	assertNullIP(t, change, "SetValid()")
+	change.SetValid(ipValue)
+	assertIP(t, change, ipValue, "SetValid()")
+}
+
+func TestIPSetNull(t *testing.T) {
+	change := IPFrom(ipValue)
+	change.SetNull()
+	assertNullIP(t, change, "SetNull()")
+	if change.IP != nil {
+		t.Errorf("SetNull() should zero the value, got %#v", change.IP)
+	}
+}
+
+func TestIPEqual(t *testing.T) {
+	if !NewIP(nil, false).Equal(NewIP(ipValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if IPFrom(ipValue).Equal(NewIP(nil, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	if !IPFrom(ipValue).Equal(IPFrom(ipValue.To4())) {
+		t.Error("Equal() should be true for equal addresses")
+	}
+	if IPFrom(ipValue).Equal(IPFrom(ipv6Value)) {
+		t.Error("Equal() should be false for different addresses")
+	}
+}
+
+func TestIPScanValue(t *testing.T) {
+	var i IP
+	err := i.Scan(ipString)
+	maybePanic(err)
+	assertIP(t, i, ipValue, "scanned string")
+	if v, err := i.Value(); v != ipString || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var v6 IP
+	err = v6.Scan([]byte(ipv6String))
+	maybePanic(err)
+	assertIP(t, v6, ipv6Value, "scanned []byte")
+	if v, err := v6.Value(); v != ipv6String || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var inet IP
+	err = inet.Scan([]byte(ipString + "/24"))
+	maybePanic(err)
+	assertIP(t, inet, ipValue, "scanned inet")
+
+	var null IP
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullIP(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong IP
+	err = wrong.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullIP(t, wrong, "scanned wrong")
+}
+
+func TestIPString(t *testing.T) {
+	v := IPFrom(ipValue)
+	if s := v.String(); s != "192.168.0.1" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewIP(nil, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestIPValueOrNil(t *testing.T) {
+	assertValueOrNil(t, IPFrom(ipValue), "valid")
+	assertValueOrNil(t, NewIP(nil, false), "null")
+}
+
+func TestIPMustValue(t *testing.T) {
+	v := IPFrom(ipValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewIP(nil, false).MustValue() }, "IP")
+}
+
+func assertIP(t *testing.T, i IP, ip net.IP, from string) {
+	if !i.IP.Equal(ip) {
+		t.Errorf("bad %s ip: %s ≠ %s\n", from, i.IP, ip)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullIP(t *testing.T, i IP, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
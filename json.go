@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/volatiletech/null/convert"
 	"github.com/volatiletech/sqlboiler/randomize"
@@ -39,23 +40,74 @@ func JSONFromPtr(b *[]byte) JSON {
 	return n
 }
 
+// JSONFromObject creates a new JSON holding the JSON encoding of obj.
+// It will be invalid if obj encodes to JSON null, such as a nil pointer.
+func JSONFromObject(obj interface{}) (JSON, error) {
+	var j JSON
+	err := j.Marshal(obj)
+	return j, err
+}
+
 // Unmarshal will unmarshal your JSON stored in
 // your JSON object and store the result in the
 // value pointed to by dest.
+// If this JSON is null, dest is left untouched.
 func (j JSON) Unmarshal(dest interface{}) error {
 	if dest == nil {
 		return errors.New("destination is nil, not a valid pointer to an object")
 	}
 
-	// Call our implementation of
-	// JSON MarshalJSON through json.Marshal
-	// to get the value of the JSON object
-	res, err := json.Marshal(j)
-	if err != nil {
-		return err
+	if !j.Valid || len(j.JSON) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(j.JSON, dest)
+}
+
+// UnmarshalSlice unmarshals a JSON array, such as a jsonb column holding
+// [1,2,3], into the slice pointed to by dest, which must be a non-nil pointer
+// to a slice such as *[]int. If this JSON is null, dest is left untouched, and
+// a stored JSON null sets the slice to nil as encoding/json does. Any other
+// stored value that is not an array, such as an object or a number, returns an
+// error and leaves dest untouched.
+func (j JSON) UnmarshalSlice(dest interface{}) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("null: UnmarshalSlice destination must be a non-nil pointer to a slice, not %T", dest)
 	}
 
-	return json.Unmarshal(res, dest)
+	if !j.Valid || len(j.JSON) == 0 {
+		return nil
+	}
+
+	if kind := jsonKind(j.JSON); kind != "" && kind != "array" && kind != "null" {
+		return fmt.Errorf("null: cannot unmarshal a JSON %s into %s: not an array", kind, rv.Elem().Type())
+	}
+	return json.Unmarshal(j.JSON, dest)
+}
+
+// jsonKind names the kind of the JSON value in data from its first byte, or
+// returns "" if data does not start like any JSON value.
+func jsonKind(data []byte) string {
+	data = bytes.TrimLeft(data, " \t\r\n")
+	if len(data) == 0 {
+		return ""
+	}
+	switch c := data[0]; {
+	case c == '[':
+		return "array"
+	case c == '{':
+		return "object"
+	case c == '"':
+		return "string"
+	case c == 't' || c == 'f':
+		return "boolean"
+	case c == 'n':
+		return "null"
+	case c == '-' || c >= '0' && c <= '9':
+		return "number"
+	}
+	return ""
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -112,6 +164,11 @@ func (j JSON) MarshalJSON() ([]byte, error) {
 	return j.JSON, nil
 }
 
+// MarshalJSONWith is like MarshalJSON, but encodes a null JSON as chosen by opts.
+func (j JSON) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(j)
+}
+
 // MarshalText implements encoding.TextMarshaler.
 func (j JSON) MarshalText() ([]byte, error) {
 	if !j.Valid {
@@ -120,12 +177,57 @@ func (j JSON) MarshalText() ([]byte, error) {
 	return j.JSON, nil
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null JSON is encoded as a single byte.
+func (j JSON) MarshalBinary() ([]byte, error) {
+	if !j.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, j.JSON), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (j *JSON) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "JSON")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		j.JSON = nil
+		j.Valid = false
+		return nil
+	}
+	j.JSON, err = decodeLengthPrefixed(value, "JSON")
+	if err != nil {
+		return err
+	}
+	j.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (j JSON) GobEncode() ([]byte, error) {
+	return j.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (j *JSON) GobDecode(data []byte) error {
+	return j.UnmarshalBinary(data)
+}
+
 // SetValid changes this JSON's value and also sets it to be non-null.
 func (j *JSON) SetValid(n []byte) {
 	j.JSON = n
 	j.Valid = true
 }
 
+// SetNull sets this JSON to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (j *JSON) SetNull() {
+	j.JSON = nil
+	j.Valid = false
+}
+
 // Ptr returns a pointer to this JSON's value, or a nil pointer if this JSON is null.
 func (j JSON) Ptr() *[]byte {
 	if !j.Valid {
@@ -134,19 +236,168 @@ func (j JSON) Ptr() *[]byte {
 	return &j.JSON
 }
 
-// IsZero returns true for null or zero JSON's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (j JSON) ValueOrZero() []byte {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (j JSON) ValueOr(def []byte) []byte {
+	if !j.Valid {
+		return def
+	}
+	return j.JSON
+}
+
+// Or returns this JSON if it is valid, otherwise other.
+func (j JSON) Or(other JSON) JSON {
+	if !j.Valid {
+		return other
+	}
+	return j
+}
+
+// Clone returns a copy of this JSON with its own copy of the bytes, so that
+// changes to one do not show in the other. A null JSON clones to a null.
+func (j JSON) Clone() JSON {
+	if !j.Valid {
+		return NewJSON(nil, false)
+	}
+	return NewJSON(cloneBytes(j.JSON), true)
+}
+
+// Merge applies other to this JSON as an RFC 7386 JSON Merge Patch and
+// returns the result as a new JSON, leaving both unchanged. Members of an
+// object patch are merged in recursively, a member set to null is removed,
+// and any patch that is not an object replaces the target outright. A null
+// other leaves this JSON as it is, and a null JSON is patched as if it held
+// no document at all.
+func (j JSON) Merge(other JSON) (JSON, error) {
+	if !other.Valid || len(other.JSON) == 0 {
+		return j.Clone(), nil
+	}
+	patch, err := decodeMergeValue(other.JSON)
+	if err != nil {
+		return JSON{}, err
+	}
+	var target interface{}
+	if j.Valid && len(j.JSON) > 0 {
+		if target, err = decodeMergeValue(j.JSON); err != nil {
+			return JSON{}, err
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(mergePatch(target, patch)); err != nil {
+		return JSON{}, err
+	}
+	return NewJSON(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), true), nil
+}
+
+// decodeMergeValue decodes data for Merge, keeping numbers as json.Number so
+// that they are written back exactly as they were given.
+func decodeMergeValue(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("null: cannot merge invalid JSON: %w", err)
+	}
+	if dec.More() {
+		return nil, errors.New("null: cannot merge invalid JSON: unexpected data after the top-level value")
+	}
+	return v, nil
+}
+
+// mergePatch is the MergePatch function of RFC 7386.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{}, len(p))
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+		} else {
+			t[k] = mergePatch(t[k], v)
+		}
+	}
+	return t
+}
+
+// MustValue returns the inner value, and panics if this JSON is null.
+func (j JSON) MustValue() []byte {
+	if !j.Valid {
+		panic("null.JSON: MustValue called on invalid value")
+	}
+	return j.JSON
+}
+
+// IsZero returns true if this JSON is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (j JSON) IsZero() bool {
 	return !j.Valid
 }
 
+// IsNull returns true if this JSON is null, the same as !Valid.
+func (j JSON) IsNull() bool {
+	return !j.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this JSON, or nil if it is null, for
+// a *JSON field tagged omitempty that should leave nulls out.
+func (j JSON) OmitEmpty() *JSON {
+	if !j.Valid {
+		return nil
+	}
+	return &j
+}
+
+// Equal returns true if both JSONs are null or both hold the same value.
+func (j JSON) Equal(other JSON) bool {
+	return j.Valid == other.Valid && (!j.Valid || bytes.Equal(j.JSON, other.JSON))
+}
+
+// String implements fmt.Stringer.
+// It returns the raw JSON text, or NullDisplay if this JSON is null.
+func (j JSON) String() string {
+	if !j.Valid {
+		return NullDisplay
+	}
+	return string(j.JSON)
+}
+
 // Scan implements the Scanner interface.
+// The scanned data must be syntactically valid JSON, so that a bad column
+// value is caught here rather than when it is embedded in a larger document.
 func (j *JSON) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		j.JSON, j.Valid = []byte{}, false
 		return nil
 	}
-	j.Valid = true
-	return convert.ConvertAssign(&j.JSON, value)
+
+	var data []byte
+	if err := convert.ConvertAssign(&data, value); err != nil {
+		j.JSON, j.Valid = nil, false
+		return scanError("JSON", value, &data, err)
+	}
+	if !json.Valid(data) {
+		j.JSON, j.Valid = nil, false
+		return scanError("JSON", value, nil, errors.New("invalid JSON"))
+	}
+
+	j.JSON, j.Valid = data, true
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -157,6 +408,14 @@ func (j JSON) Value() (driver.Value, error) {
 	return j.JSON, nil
 }
 
+// ValueOrNil returns nil if this JSON is null, otherwise the same value as Value.
+func (j JSON) ValueOrNil() interface{} {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
 // Randomize for sqlboiler
 func (j *JSON) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	j.JSON = []byte(`"` + randomize.Str(nextInt, 1) + `"`)
@@ -3,6 +3,7 @@ package null
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"testing"
 )
 
@@ -103,6 +104,107 @@ func TestUnmarshal(t *testing.T) {
 	}
 }
 
+func TestJSONFromObject(t *testing.T) {
+	in := Test{Name: "hello", Age: 15}
+	j, err := JSONFromObject(in)
+	maybePanic(err)
+	if !j.Valid || !bytes.Equal(j.JSON, []byte(`{"Name":"hello","Age":15}`)) {
+		t.Errorf("bad JSONFromObject(): %s", j.JSON)
+	}
+
+	var out Test
+	err = j.Unmarshal(&out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad round trip: %#v ≠ %#v", out, in)
+	}
+
+	null, err := JSONFromObject(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("JSONFromObject(nil) is valid, but should be invalid")
+	}
+
+	_, err = JSONFromObject(make(chan int))
+	if err == nil {
+		t.Error("expected error for an unsupported type")
+	}
+}
+
+func TestUnmarshalNullJSON(t *testing.T) {
+	// a null JSON must leave the destination untouched
+	dest := map[string]int{"a": 1}
+	for _, j := range []JSON{NewJSON(nil, false), NewJSON([]byte("null"), false), NewJSON([]byte{}, true)} {
+		err := j.Unmarshal(&dest)
+		maybePanic(err)
+		if dest == nil || dest["a"] != 1 {
+			t.Errorf("null JSON %q changed the destination: %#v", j.JSON, dest)
+		}
+	}
+
+	if err := JSONFrom([]byte(`{}`)).Unmarshal(nil); err == nil {
+		t.Error("expected error for a nil destination")
+	}
+}
+
+func TestJSONUnmarshalSlice(t *testing.T) {
+	var ints []int
+	err := JSONFrom([]byte(` [1,2,3]`)).UnmarshalSlice(&ints)
+	maybePanic(err)
+	if len(ints) != 3 || ints[0] != 1 || ints[1] != 2 || ints[2] != 3 {
+		t.Errorf("bad unmarshaled slice: %#v", ints)
+	}
+
+	// a null JSON must leave the destination untouched
+	dest := []int{4, 5}
+	for _, j := range []JSON{NewJSON(nil, false), NewJSON([]byte("[1]"), false), NewJSON([]byte{}, true)} {
+		err := j.UnmarshalSlice(&dest)
+		maybePanic(err)
+		if len(dest) != 2 || dest[0] != 4 || dest[1] != 5 {
+			t.Errorf("null JSON %q changed the destination: %#v", j.JSON, dest)
+		}
+	}
+
+	// as with encoding/json, a stored JSON null clears the slice
+	err = JSONFrom([]byte("null")).UnmarshalSlice(&dest)
+	maybePanic(err)
+	if dest != nil {
+		t.Errorf("JSON null should set a nil slice: %#v", dest)
+	}
+
+	tests := []struct {
+		in, err string
+	}{
+		{`{"a":1}`, "null: cannot unmarshal a JSON object into []int: not an array"},
+		{`"1,2"`, "null: cannot unmarshal a JSON string into []int: not an array"},
+		{`12`, "null: cannot unmarshal a JSON number into []int: not an array"},
+		{`true`, "null: cannot unmarshal a JSON boolean into []int: not an array"},
+	}
+	for _, test := range tests {
+		untouched := []int{4}
+		err := JSONFrom([]byte(test.in)).UnmarshalSlice(&untouched)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("%s: bad error: %v", test.in, err)
+		}
+		if len(untouched) != 1 || untouched[0] != 4 {
+			t.Errorf("%s: destination changed: %#v", test.in, untouched)
+		}
+	}
+
+	if err := JSONFrom([]byte(`["a"]`)).UnmarshalSlice(&ints); err == nil {
+		t.Error("expected error for a mismatched element type")
+	}
+	if err := JSONFrom([]byte(`[1`)).UnmarshalSlice(&ints); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+
+	for _, bad := range []interface{}{nil, ints, (*[]int)(nil), new(map[string]int)} {
+		if err := JSONFrom([]byte(`[1]`)).UnmarshalSlice(bad); err == nil {
+			t.Errorf("%T: expected error for a bad destination", bad)
+		}
+	}
+}
+
 func TestUnmarshalJSON(t *testing.T) {
 	var i JSON
 	err := json.Unmarshal(jsonJSON, &i)
@@ -204,16 +306,108 @@ func TestJSONSetValid(t *testing.T) {
 	assertJSON(t, change, "SetValid()")
 }
 
+func TestJSONSetNull(t *testing.T) {
+	change := JSONFrom([]byte(`{"a":1}`))
+	change.SetNull()
+	assertNullJSON(t, change, "SetNull()")
+	if change.JSON != nil {
+		t.Errorf("SetNull() should zero the value, got %#v", change.JSON)
+	}
+}
+
 func TestJSONScan(t *testing.T) {
 	var i JSON
 	err := i.Scan(`"hello"`)
 	maybePanic(err)
 	assertJSON(t, i, "scanned []byte")
 
+	var obj JSON
+	err = obj.Scan([]byte(`{"a": [1, 2, null]}`))
+	maybePanic(err)
+	if !obj.Valid || string(obj.JSON) != `{"a": [1, 2, null]}` {
+		t.Errorf("bad scanned object: %#v", obj)
+	}
+
 	var null JSON
 	err = null.Scan(nil)
 	maybePanic(err)
 	assertNullJSON(t, null, "scanned null")
+
+	for _, in := range []interface{}{[]byte(`{"a": 1`), "hello", []byte{}, []byte("\xff")} {
+		invalid := JSONFrom([]byte(`"stale"`))
+		err = invalid.Scan(in)
+		if err == nil {
+			t.Errorf("expected error scanning %q", in)
+		}
+		assertNullJSON(t, invalid, "scanned invalid json")
+		if invalid.JSON != nil {
+			t.Errorf("invalid scan should not keep a value, got %q", invalid.JSON)
+		}
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	null := NewJSON(nil, false)
+	other := NewJSON([]byte(`"hello"`), false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := JSONFrom([]byte(`"hello"`))
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := JSONFrom([]byte(`"hello"`))
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := JSONFrom([]byte(`"world"`))
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestJSONValueOr(t *testing.T) {
+	valid := JSONFrom([]byte(`"hello"`))
+	if !bytes.Equal(valid.ValueOrZero(), []byte(`"hello"`)) {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if !bytes.Equal(valid.ValueOr([]byte(`"world"`)), []byte(`"hello"`)) {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr([]byte(`"world"`)))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewJSON([]byte(`"hello"`), false)
+	if null.ValueOrZero() != nil {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if !bytes.Equal(null.ValueOr([]byte(`"world"`)), []byte(`"world"`)) {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr([]byte(`"world"`)))
+	}
+}
+
+func TestJSONString(t *testing.T) {
+	v := JSONFrom([]byte(`{"a":1}`))
+	if s := v.String(); s != `{"a":1}` {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewJSON(nil, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestJSONValueOrNil(t *testing.T) {
+	assertValueOrNil(t, JSONFrom([]byte(`{"a":1}`)), "valid")
+	assertValueOrNil(t, NewJSON(nil, false), "null")
+}
+
+func TestJSONMustValue(t *testing.T) {
+	v := JSONFrom([]byte(`{"a":1}`))
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewJSON(nil, false).MustValue() }, "JSON")
 }
 
 func assertJSON(t *testing.T, i JSON, from string) {
@@ -230,3 +424,61 @@ func assertNullJSON(t *testing.T, i JSON, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestJSONClone(t *testing.T) {
+	orig := JSONFrom([]byte(`{"a":1}`))
+	clone := orig.Clone()
+	orig.JSON[5] = '2'
+	if !clone.Valid || string(clone.JSON) != `{"a":1}` {
+		t.Errorf("clone should be unchanged: %s", clone.JSON)
+	}
+
+	null := NewJSON([]byte(`{}`), false).Clone()
+	if null.Valid || null.JSON != nil {
+		t.Errorf("bad clone of null JSON: %#v", null)
+	}
+}
+
+func TestJSONMerge(t *testing.T) {
+	tests := []struct {
+		name          string
+		target, patch JSON
+		want          string
+	}{
+		{"add key", JSONFrom([]byte(`{"a":1}`)), JSONFrom([]byte(`{"b":"two"}`)), `{"a":1,"b":"two"}`},
+		{"remove key", JSONFrom([]byte(`{"a":1,"b":2}`)), JSONFrom([]byte(`{"b":null}`)), `{"a":1}`},
+		{"remove missing key", JSONFrom([]byte(`{"a":1}`)), JSONFrom([]byte(`{"x":null}`)), `{"a":1}`},
+		{"nested", JSONFrom([]byte(`{"a":{"b":1,"c":2},"d":3}`)), JSONFrom([]byte(`{"a":{"c":null,"e":[1]}}`)), `{"a":{"b":1,"e":[1]},"d":3}`},
+		{"object replaces scalar", JSONFrom([]byte(`{"a":"x"}`)), JSONFrom([]byte(`{"a":{"b":null,"c":1}}`)), `{"a":{"c":1}}`},
+		{"scalar replaces object", JSONFrom([]byte(`{"a":{"b":1}}`)), JSONFrom([]byte(`{"a":[1,2]}`)), `{"a":[1,2]}`},
+		{"non-object patch", JSONFrom([]byte(`{"a":1}`)), JSONFrom([]byte(`["x"]`)), `["x"]`},
+		{"object patches array", JSONFrom([]byte(`[1]`)), JSONFrom([]byte(`{"a":1}`)), `{"a":1}`},
+		{"null target", NewJSON(nil, false), JSONFrom([]byte(`{"a":1,"b":null}`)), `{"a":1}`},
+		{"null patch", JSONFrom([]byte(`{"a":1}`)), NewJSON(nil, false), `{"a":1}`},
+		{"exact numbers", JSONFrom([]byte(`{"a":12345678901234567890}`)), JSONFrom([]byte(`{"b":1.50}`)), `{"a":12345678901234567890,"b":1.50}`},
+		{"no html escaping", JSONFrom([]byte(`{}`)), JSONFrom([]byte(`{"a":"<&>"}`)), `{"a":"<&>"}`},
+	}
+	for _, test := range tests {
+		target := string(test.target.JSON)
+		got, err := test.target.Merge(test.patch)
+		maybePanic(err)
+		if !got.Valid || string(got.JSON) != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got.JSON, test.want)
+		}
+		if string(test.target.JSON) != target {
+			t.Errorf("%s: Merge changed the target to %s", test.name, test.target.JSON)
+		}
+	}
+
+	null, err := NewJSON(nil, false).Merge(NewJSON(nil, false))
+	maybePanic(err)
+	if null.Valid {
+		t.Errorf("merging two nulls should be null, got %s", null.JSON)
+	}
+
+	for _, bad := range []struct{ target, patch string }{{`{"a":1}`, `{`}, {`{`, `{"a":1}`}, {`{"a":1}`, `{} {}`}} {
+		if _, err := JSONFrom([]byte(bad.target)).Merge(JSONFrom([]byte(bad.patch))); err == nil {
+			t.Errorf("%s merged with %s: expected error", bad.target, bad.patch)
+		}
+	}
+}
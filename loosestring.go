@@ -0,0 +1,168 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// LooseString is a nullable string for loosely typed JSON, such as webhooks
+// that send {"id": 42} where a string is expected. UnmarshalJSON accepts a
+// JSON number or boolean as well as a string, keeping its text exactly as it
+// was written, so 42 becomes "42" and true becomes "true". It otherwise
+// behaves like String, which stays strict and rejects them.
+type LooseString String
+
+// NewLooseString creates a new LooseString
+func NewLooseString(s string, valid bool) LooseString {
+	return LooseString{
+		String: s,
+		Valid:  valid,
+	}
+}
+
+// LooseStringFrom creates a new LooseString that will never be blank.
+func LooseStringFrom(s string) LooseString {
+	return NewLooseString(s, true)
+}
+
+// LooseStringFromPtr creates a new LooseString that will be null if s is nil.
+func LooseStringFromPtr(s *string) LooseString {
+	if s == nil {
+		return NewLooseString("", false)
+	}
+	return NewLooseString(*s, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string, number or boolean. Numbers and booleans are kept
+// as their JSON text, and anything else, such as an array, is an error.
+func (l *LooseString) UnmarshalJSON(data []byte) error {
+	if isJSONNumber(string(data)) || bytes.Equal(data, []byte("true")) || bytes.Equal(data, []byte("false")) {
+		l.SetValid(string(data))
+		return nil
+	}
+	return (*String)(l).UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *LooseString) UnmarshalText(text []byte) error {
+	return (*String)(l).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l LooseString) MarshalJSON() ([]byte, error) {
+	return String(l).MarshalJSON()
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null LooseString as chosen by opts.
+func (l LooseString) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(l)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the string, or empty text if this LooseString is null.
+func (l LooseString) MarshalText() ([]byte, error) {
+	return String(l).MarshalText()
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this LooseString is null.
+func (l LooseString) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, l, l.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (l *LooseString) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, l)
+}
+
+// SetValid changes this LooseString's value and also sets it to be non-null.
+func (l *LooseString) SetValid(v string) {
+	l.String = v
+	l.Valid = true
+}
+
+// SetNull sets this LooseString to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (l *LooseString) SetNull() {
+	l.String = ""
+	l.Valid = false
+}
+
+// Ptr returns a pointer to this LooseString's value, or a nil pointer if this LooseString is null.
+func (l LooseString) Ptr() *string {
+	return String(l).Ptr()
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (l LooseString) ValueOrZero() string {
+	return String(l).ValueOrZero()
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (l LooseString) ValueOr(def string) string {
+	return String(l).ValueOr(def)
+}
+
+// Or returns this LooseString if it is valid, otherwise other.
+func (l LooseString) Or(other LooseString) LooseString {
+	if !l.Valid {
+		return other
+	}
+	return l
+}
+
+// MustValue returns the inner value, and panics if this LooseString is null.
+func (l LooseString) MustValue() string {
+	if !l.Valid {
+		panic("null.LooseString: MustValue called on invalid value")
+	}
+	return l.String
+}
+
+// IsZero returns true if this LooseString is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (l LooseString) IsZero() bool {
+	return !l.Valid
+}
+
+// IsNull returns true if this LooseString is null, the same as !Valid.
+func (l LooseString) IsNull() bool {
+	return !l.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this LooseString, or nil if it is null, for
+// a *LooseString field tagged omitempty that should leave nulls out.
+func (l LooseString) OmitEmpty() *LooseString {
+	if !l.Valid {
+		return nil
+	}
+	return &l
+}
+
+// Equal returns true if both LooseStrings are null or both hold the same value.
+func (l LooseString) Equal(other LooseString) bool {
+	return String(l).Equal(String(other))
+}
+
+// Scan implements the Scanner interface.
+func (l *LooseString) Scan(value interface{}) error {
+	return (*String)(l).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (l LooseString) Value() (driver.Value, error) {
+	return String(l).Value()
+}
+
+// ValueOrNil returns nil if this LooseString is null, otherwise the same value as Value.
+func (l LooseString) ValueOrNil() interface{} {
+	return String(l).ValueOrNil()
+}
+
+// Randomize for sqlboiler
+func (l *LooseString) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	(*String)(l).Randomize(nextInt, fieldType, shouldBeNull)
+}
@@ -0,0 +1,116 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLooseStringFrom(t *testing.T) {
+	assertLooseStr(t, LooseStringFrom("test"), "test", "LooseStringFrom()")
+
+	s := "test"
+	assertLooseStr(t, LooseStringFromPtr(&s), "test", "LooseStringFromPtr()")
+	assertNullLooseStr(t, LooseStringFromPtr(nil), "LooseStringFromPtr(nil)")
+}
+
+func TestUnmarshalLooseString(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`"test"`, "test"},
+		{`""`, ""},
+		{`42`, "42"},
+		{`-1.50`, "-1.50"},
+		{`1e21`, "1e21"},
+		{`123456789012345678901234567890`, "123456789012345678901234567890"},
+		{`true`, "true"},
+		{`false`, "false"},
+	}
+	for _, test := range tests {
+		var s LooseString
+		err := json.Unmarshal([]byte(test.in), &s)
+		maybePanic(err)
+		assertLooseStr(t, s, test.want, test.in)
+	}
+
+	var obj struct {
+		ID LooseString `json:"id"`
+	}
+	err := json.Unmarshal([]byte(`{"id": 42}`), &obj)
+	maybePanic(err)
+	assertLooseStr(t, obj.ID, "42", "number field")
+
+	var null LooseString
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullLooseStr(t, null, "null json")
+
+	for _, data := range []string{`[1]`, `{"a":1}`, `01`, `NaN`} {
+		var bad LooseString
+		if err := bad.UnmarshalJSON([]byte(data)); err == nil {
+			t.Errorf("%s: expected error", data)
+		}
+		assertNullLooseStr(t, bad, data)
+	}
+
+	// String stays strict
+	for _, data := range [][]byte{intJSON, boolJSON} {
+		var strict String
+		if err := json.Unmarshal(data, &strict); err == nil {
+			t.Errorf("String should reject %s", data)
+		}
+	}
+}
+
+func TestMarshalLooseString(t *testing.T) {
+	data, err := json.Marshal(LooseStringFrom("42"))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"42"`, "non-empty json marshal")
+
+	data, err = LooseStringFrom("42").MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "42", "non-empty text marshal")
+
+	data, err = json.Marshal(NewLooseString("", false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = NewLooseString("", false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestLooseStringScanValue(t *testing.T) {
+	var s LooseString
+	err := s.Scan("test")
+	maybePanic(err)
+	assertLooseStr(t, s, "test", "scanned string")
+	if v, err := s.Value(); v != "test" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null LooseString
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullLooseStr(t, null, "scanned null")
+}
+
+func TestLooseStringMustValue(t *testing.T) {
+	v := LooseStringFrom("test")
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewLooseString("", false).MustValue() }, "LooseString")
+}
+
+func assertLooseStr(t *testing.T, s LooseString, want string, from string) {
+	if s.String != want {
+		t.Errorf("bad %s string: %q ≠ %q\n", from, s.String, want)
+	}
+	if !s.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullLooseStr(t *testing.T, s LooseString, from string) {
+	if s.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
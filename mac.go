@@ -0,0 +1,364 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// MAC is a nullable net.HardwareAddr, such as a Postgres macaddr or macaddr8
+// column. Both 6 byte (EUI-48) and 8 byte (EUI-64) addresses are supported.
+type MAC struct {
+	MAC   net.HardwareAddr
+	Valid bool
+}
+
+// NewMAC creates a new MAC
+func NewMAC(mac net.HardwareAddr, valid bool) MAC {
+	return MAC{
+		MAC:   mac,
+		Valid: valid,
+	}
+}
+
+// MACFrom creates a new MAC that will be invalid if mac is empty.
+func MACFrom(mac net.HardwareAddr) MAC {
+	return NewMAC(mac, len(mac) != 0)
+}
+
+// MACFromPtr creates a new MAC that will be invalid if mac is nil or empty.
+func MACFromPtr(mac *net.HardwareAddr) MAC {
+	if mac == nil {
+		return NewMAC(nil, false)
+	}
+	return MACFrom(*mac)
+}
+
+// ParseMAC parses a hardware address such as "00:00:5e:00:53:01" into a valid MAC, as UnmarshalText
+// does, but returns an error rather than a null MAC for empty text.
+func ParseMAC(s string) (MAC, error) {
+	return parseText[MAC]("MAC", s)
+}
+
+// MustMAC is like ParseMAC, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustMAC(s string) MAC {
+	v, err := ParseMAC(s)
+	return mustParse("MustMAC", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// An empty string will be null.
+func (m *MAC) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		m.MAC = nil
+		m.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		m.MAC, m.Valid = nil, false
+		return err
+	}
+
+	return m.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *MAC) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		m.MAC = nil
+		m.Valid = false
+		return nil
+	}
+
+	mac, err := parseMAC(string(text))
+	if err != nil {
+		m.MAC, m.Valid = nil, false
+		return err
+	}
+
+	m.MAC = mac
+	m.Valid = true
+	return nil
+}
+
+// parseMAC parses any form net.ParseMAC accepts, such as "00:11:22:33:44:55"
+// or "0011.2233.4455", but only for 6 and 8 byte addresses.
+func parseMAC(s string) (net.HardwareAddr, error) {
+	mac, err := net.ParseMAC(s)
+	if err != nil || (len(mac) != 6 && len(mac) != 8) {
+		return nil, fmt.Errorf("null: invalid MAC address %q", s)
+	}
+	return mac, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this MAC is null or empty, and otherwise the
+// colon separated lower case form, such as "00:11:22:33:44:55".
+func (m MAC) MarshalJSON() ([]byte, error) {
+	if !m.Valid || len(m.MAC) == 0 {
+		return NullBytes, nil
+	}
+	return []byte(`"` + m.MAC.String() + `"`), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null MAC as chosen by opts.
+func (m MAC) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(m)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m MAC) MarshalText() ([]byte, error) {
+	if !m.Valid || len(m.MAC) == 0 {
+		return []byte{}, nil
+	}
+	return []byte(m.MAC.String()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this MAC is null.
+func (m MAC) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, m, m.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (m *MAC) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, m)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this MAC is null.
+func (m MAC) MarshalYAML() (interface{}, error) {
+	if !m.Valid || len(m.MAC) == 0 {
+		return nil, nil
+	}
+	return m.MAC.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (m *MAC) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return m.UnmarshalText(nil)
+	}
+	return m.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this MAC is null.
+func (m MAC) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !m.Valid || len(m.MAC) == 0 {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(m.MAC.String())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (m *MAC) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return m.UnmarshalText(nil)
+	}
+	return m.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null or empty MAC is encoded as a single byte, and otherwise the length
+// prefixed address bytes follow.
+func (m MAC) MarshalBinary() ([]byte, error) {
+	if !m.Valid || len(m.MAC) == 0 {
+		return []byte{encodedNull}, nil
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, m.MAC), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *MAC) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "MAC")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		m.MAC = nil
+		m.Valid = false
+		return nil
+	}
+	b, err := decodeLengthPrefixed(value, "MAC")
+	if err != nil {
+		return err
+	}
+	if len(b) != 6 && len(b) != 8 {
+		return fmt.Errorf("null: expected 6 or 8 bytes of data for null.MAC, got %d", len(b))
+	}
+	m.MAC = net.HardwareAddr(b)
+	m.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (m MAC) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (m *MAC) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+// SetValid changes this MAC's value and also sets it to be non-null.
+func (m *MAC) SetValid(v net.HardwareAddr) {
+	m.MAC = v
+	m.Valid = true
+}
+
+// SetNull sets this MAC to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (m *MAC) SetNull() {
+	m.MAC = nil
+	m.Valid = false
+}
+
+// Ptr returns a pointer to this MAC's value, or a nil pointer if this MAC is null.
+func (m MAC) Ptr() *net.HardwareAddr {
+	if !m.Valid {
+		return nil
+	}
+	return &m.MAC
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (m MAC) ValueOrZero() net.HardwareAddr {
+	if !m.Valid {
+		return nil
+	}
+	return m.MAC
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (m MAC) ValueOr(def net.HardwareAddr) net.HardwareAddr {
+	if !m.Valid {
+		return def
+	}
+	return m.MAC
+}
+
+// Or returns this MAC if it is valid, otherwise other.
+func (m MAC) Or(other MAC) MAC {
+	if !m.Valid {
+		return other
+	}
+	return m
+}
+
+// MustValue returns the inner value, and panics if this MAC is null.
+func (m MAC) MustValue() net.HardwareAddr {
+	if !m.Valid {
+		panic("null.MAC: MustValue called on invalid value")
+	}
+	return m.MAC
+}
+
+// IsZero returns true if this MAC is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (m MAC) IsZero() bool {
+	return !m.Valid
+}
+
+// IsNull returns true if this MAC is null, the same as !Valid.
+func (m MAC) IsNull() bool {
+	return !m.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this MAC, or nil if it is null, for
+// a *MAC field tagged omitempty that should leave nulls out.
+func (m MAC) OmitEmpty() *MAC {
+	if !m.Valid {
+		return nil
+	}
+	return &m
+}
+
+// Equal returns true if both MACs are null or both hold the same address.
+func (m MAC) Equal(other MAC) bool {
+	return m.Valid == other.Valid && (!m.Valid || bytes.Equal(m.MAC, other.MAC))
+}
+
+// String implements fmt.Stringer.
+// It returns the colon separated address, or NullDisplay if this MAC is null or empty.
+func (m MAC) String() string {
+	if !m.Valid || len(m.MAC) == 0 {
+		return NullDisplay
+	}
+	return m.MAC.String()
+}
+
+// Scan implements the Scanner interface.
+// It accepts the textual form, as Postgres macaddr and macaddr8 columns
+// return it, as a string or []byte.
+func (m *MAC) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case string:
+		m.MAC, err = parseMAC(x)
+	case []byte:
+		m.MAC, err = parseMAC(string(x))
+	case nil:
+		m.MAC, m.Valid = nil, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	m.Valid = err == nil
+	return scanError("MAC", value, nil, err)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the colon separated address as a string.
+func (m MAC) Value() (driver.Value, error) {
+	if !m.Valid || len(m.MAC) == 0 {
+		return nil, nil
+	}
+	return m.MAC.String(), nil
+}
+
+// ValueOrNil returns nil if this MAC is null, otherwise the same value as Value.
+func (m MAC) ValueOrNil() interface{} {
+	if !m.Valid || len(m.MAC) == 0 {
+		return nil
+	}
+	return m.MAC.String()
+}
+
+// Randomize for sqlboiler
+func (m *MAC) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		m.MAC = nil
+		m.Valid = false
+	} else {
+		mac := make(net.HardwareAddr, 6)
+		for i := range mac {
+			mac[i] = byte(nextInt() % 256)
+		}
+		// a locally administered unicast address
+		mac[0] = mac[0]&^0x01 | 0x02
+		m.MAC = mac
+		m.Valid = true
+	}
+}
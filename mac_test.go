@@ -0,0 +1,194 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+)
+
+var (
+	macString     = "00:11:22:33:44:55"
+	macJSON       = []byte(`"` + macString + `"`)
+	macValue, _   = net.ParseMAC(macString)
+	eui64String   = "00:11:22:ff:fe:33:44:55"
+	eui64JSON     = []byte(`"` + eui64String + `"`)
+	eui64Value, _ = net.ParseMAC(eui64String)
+)
+
+func TestMACFrom(t *testing.T) {
+	assertMAC(t, MACFrom(macValue), macValue, "MACFrom()")
+	assertNullMAC(t, MACFrom(net.HardwareAddr{}), "MACFrom(net.HardwareAddr{})")
+
+	v := macValue
+	assertMAC(t, MACFromPtr(&v), macValue, "MACFromPtr()")
+	assertNullMAC(t, MACFromPtr(nil), "MACFromPtr(nil)")
+}
+
+func TestUnmarshalMAC(t *testing.T) {
+	var m MAC
+	err := json.Unmarshal(macJSON, &m)
+	maybePanic(err)
+	assertMAC(t, m, macValue, "6 byte json")
+
+	var eui64 MAC
+	err = json.Unmarshal(eui64JSON, &eui64)
+	maybePanic(err)
+	assertMAC(t, eui64, eui64Value, "8 byte json")
+
+	var dashed MAC
+	err = json.Unmarshal([]byte(`"00-11-22-33-44-55"`), &dashed)
+	maybePanic(err)
+	assertMAC(t, dashed, macValue, "dash separated json")
+
+	var null MAC
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullMAC(t, null, "null json")
+
+	var blank MAC
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullMAC(t, blank, "blank json string")
+
+	badType := MACFrom(macValue)
+	if err := json.Unmarshal(intJSON, &badType); err == nil {
+		t.Error("expected error for wrong type json")
+	}
+	assertNullMAC(t, badType, "wrong type json")
+
+	invalid := MACFrom(macValue)
+	err = json.Unmarshal(stringJSON, &invalid)
+	if err == nil || err.Error() != `null: invalid MAC address "test"` {
+		t.Errorf("bad error: %v", err)
+	}
+	assertNullMAC(t, invalid, "invalid mac json")
+
+	// net.ParseMAC accepts 20 byte IP over InfiniBand addresses, but MAC does not
+	ipoib := MAC{}
+	err = ipoib.UnmarshalText([]byte("00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01"))
+	if err == nil {
+		t.Error("expected error for a 20 byte address")
+	}
+	assertNullMAC(t, ipoib, "20 byte address")
+}
+
+func TestMarshalMAC(t *testing.T) {
+	data, err := json.Marshal(MACFrom(macValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(macJSON), "6 byte json marshal")
+
+	data, err = json.Marshal(MACFrom(eui64Value))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(eui64JSON), "8 byte json marshal")
+
+	// invalid and empty values should be encoded as null
+	data, err = json.Marshal(NewMAC(nil, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = json.Marshal(NewMAC(net.HardwareAddr{}, true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "empty json marshal")
+}
+
+func TestMACText(t *testing.T) {
+	data, err := MACFrom(macValue).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, macString, "non-empty text marshal")
+
+	data, err = NewMAC(nil, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var m MAC
+	err = m.UnmarshalText([]byte("0011.2233.4455"))
+	maybePanic(err)
+	assertMAC(t, m, macValue, "UnmarshalText() dotted")
+
+	var blank MAC
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullMAC(t, blank, "UnmarshalText() empty")
+}
+
+func TestMACEqual(t *testing.T) {
+	if !NewMAC(nil, false).Equal(NewMAC(macValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if MACFrom(macValue).Equal(NewMAC(nil, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	if !MACFrom(macValue).Equal(MACFrom(net.HardwareAddr{0, 0x11, 0x22, 0x33, 0x44, 0x55})) {
+		t.Error("Equal() should be true for equal addresses")
+	}
+	if MACFrom(macValue).Equal(MACFrom(eui64Value)) {
+		t.Error("Equal() should be false for different addresses")
+	}
+}
+
+func TestMACScanValue(t *testing.T) {
+	var m MAC
+	err := m.Scan(macString)
+	maybePanic(err)
+	assertMAC(t, m, macValue, "scanned string")
+	if v, err := m.Value(); v != macString || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var eui64 MAC
+	err = eui64.Scan([]byte(eui64String))
+	maybePanic(err)
+	assertMAC(t, eui64, eui64Value, "scanned []byte")
+	if v, err := eui64.Value(); v != eui64String || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null MAC
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullMAC(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	wrong := MACFrom(macValue)
+	if err := wrong.Scan("00:11:22"); err == nil {
+		t.Error("expected error")
+	}
+	assertNullMAC(t, wrong, "scanned wrong")
+}
+
+func TestMACString(t *testing.T) {
+	if s := MACFrom(macValue).String(); s != macString {
+		t.Errorf("bad String(): %q", s)
+	}
+	if s := fmt.Sprint(NewMAC(nil, false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestMACValueOrNil(t *testing.T) {
+	assertValueOrNil(t, MACFrom(macValue), "valid")
+	assertValueOrNil(t, NewMAC(nil, false), "null")
+}
+
+func TestMACMustValue(t *testing.T) {
+	v := MACFrom(macValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewMAC(nil, false).MustValue() }, "MAC")
+}
+
+func assertMAC(t *testing.T, m MAC, mac net.HardwareAddr, from string) {
+	if m.MAC.String() != mac.String() {
+		t.Errorf("bad %s mac: %s ≠ %s\n", from, m.MAC, mac)
+	}
+	if !m.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullMAC(t *testing.T, m MAC, from string) {
+	if m.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,251 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/volatiletech/sqlboiler/randomize"
+)
+
+// Map is a nullable map[string]interface{}, for schemaless json and jsonb
+// columns holding an object. It marshals to and from a JSON object, and
+// scans and values the JSON text. A null column and an empty {} object are
+// kept apart: the first is null, the second is a valid, empty Map.
+type Map struct {
+	Map   map[string]interface{}
+	Valid bool
+}
+
+// NewMap creates a new Map
+func NewMap(m map[string]interface{}, valid bool) Map {
+	return Map{
+		Map:   m,
+		Valid: valid,
+	}
+}
+
+// MapFrom creates a new Map that will be invalid if nil.
+func MapFrom(m map[string]interface{}) Map {
+	return NewMap(m, m != nil)
+}
+
+// MapFromPtr creates a new Map that will be invalid if nil.
+func MapFromPtr(m *map[string]interface{}) Map {
+	if m == nil {
+		return NewMap(nil, false)
+	}
+	return NewMap(*m, true)
+}
+
+// MapValue returns the value stored under key as a T. ok is false if m is
+// null, the key is missing or the value is not a T. Values decoded from JSON
+// have the types json.Unmarshal gives an interface{}, so numbers are float64,
+// arrays are []interface{} and nested objects are map[string]interface{}.
+func MapValue[T any](m Map, key string) (v T, ok bool) {
+	if !m.Valid {
+		return v, false
+	}
+	v, ok = m.Map[key].(T)
+	return v, ok
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a JSON object. An empty object is valid.
+func (m *Map) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		m.Map = nil
+		m.Valid = false
+		return nil
+	}
+
+	v, err := parseMap(data)
+	if err != nil {
+		m.Map, m.Valid = nil, false
+		return err
+	}
+	m.Map = v
+	m.Valid = true
+	return nil
+}
+
+// parseMap decodes a JSON object, returning an empty rather than a nil map for {}.
+func parseMap(data []byte) (map[string]interface{}, error) {
+	var v map[string]interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, fmt.Errorf("null: expected a JSON object for null.Map, got %s", data)
+	}
+	return v, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Map is null, and otherwise a JSON object.
+func (m Map) MarshalJSON() ([]byte, error) {
+	if !m.Valid {
+		return NullBytes, nil
+	}
+	if m.Map == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(m.Map)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Map as chosen by opts.
+func (m Map) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(m)
+}
+
+// SetValid changes this Map's value and also sets it to be non-null.
+func (m *Map) SetValid(v map[string]interface{}) {
+	m.Map = v
+	m.Valid = true
+}
+
+// SetNull sets this Map to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (m *Map) SetNull() {
+	m.Map = nil
+	m.Valid = false
+}
+
+// Ptr returns a pointer to this Map's value, or a nil pointer if this Map is null.
+func (m Map) Ptr() *map[string]interface{} {
+	if !m.Valid {
+		return nil
+	}
+	return &m.Map
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (m Map) ValueOrZero() map[string]interface{} {
+	if !m.Valid {
+		return nil
+	}
+	return m.Map
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (m Map) ValueOr(def map[string]interface{}) map[string]interface{} {
+	if !m.Valid {
+		return def
+	}
+	return m.Map
+}
+
+// Or returns this Map if it is valid, otherwise other.
+func (m Map) Or(other Map) Map {
+	if !m.Valid {
+		return other
+	}
+	return m
+}
+
+// MustValue returns the inner value, and panics if this Map is null.
+func (m Map) MustValue() map[string]interface{} {
+	if !m.Valid {
+		panic("null.Map: MustValue called on invalid value")
+	}
+	return m.Map
+}
+
+// IsZero returns true if this Map is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (m Map) IsZero() bool {
+	return !m.Valid
+}
+
+// IsNull returns true if this Map is null, the same as !Valid.
+func (m Map) IsNull() bool {
+	return !m.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Map, or nil if it is null, for
+// a *Map field tagged omitempty that should leave nulls out.
+func (m Map) OmitEmpty() *Map {
+	if !m.Valid {
+		return nil
+	}
+	return &m
+}
+
+// Equal returns true if both Maps are null or both hold deeply equal objects.
+// A nil and an empty valid map are considered equal.
+func (m Map) Equal(other Map) bool {
+	if m.Valid != other.Valid {
+		return false
+	}
+	if !m.Valid || (len(m.Map) == 0 && len(other.Map) == 0) {
+		return true
+	}
+	return reflect.DeepEqual(m.Map, other.Map)
+}
+
+// String implements fmt.Stringer.
+// It returns the JSON object, or NullDisplay if this Map is null.
+func (m Map) String() string {
+	if !m.Valid {
+		return NullDisplay
+	}
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return fmt.Sprint(m.Map)
+	}
+	return string(data)
+}
+
+// Scan implements the Scanner interface.
+// It accepts a JSON object as a string or []byte. A JSON null is null.
+func (m *Map) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var data []byte
+	switch x := value.(type) {
+	case string:
+		data = []byte(x)
+	case []byte:
+		data = x
+	case nil:
+		m.Map, m.Valid = nil, false
+		return nil
+	default:
+		m.Map, m.Valid = nil, false
+		return scanError("Map", value, nil, errUnsupportedScanType)
+	}
+	return scanError("Map", value, nil, m.UnmarshalJSON(bytes.TrimSpace(data)))
+}
+
+// Value implements the driver Valuer interface.
+// It returns the JSON object as []byte.
+func (m Map) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+	return m.MarshalJSON()
+}
+
+// ValueOrNil returns nil if this Map is null, otherwise the same value as Value.
+func (m Map) ValueOrNil() interface{} {
+	if !m.Valid {
+		return nil
+	}
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// Randomize for sqlboiler
+func (m *Map) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		m.Map = nil
+		m.Valid = false
+	} else {
+		m.Map = map[string]interface{}{randomize.Str(nextInt, 1): randomize.Str(nextInt, 1)}
+		m.Valid = true
+	}
+}
@@ -0,0 +1,187 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+var (
+	mapJSON  = []byte(`{"a":1,"b":"two","c":{"d":[true,null]}}`)
+	mapValue = map[string]interface{}{
+		"a": float64(1),
+		"b": "two",
+		"c": map[string]interface{}{"d": []interface{}{true, nil}},
+	}
+)
+
+func TestMapFrom(t *testing.T) {
+	assertMap(t, MapFrom(mapValue), "MapFrom()")
+	assertNullMap(t, MapFrom(nil), "MapFrom(nil)")
+
+	empty := MapFrom(map[string]interface{}{})
+	if !empty.Valid {
+		t.Error("MapFrom(map[string]interface{}{})", "is invalid, but should be valid")
+	}
+
+	v := mapValue
+	assertMap(t, MapFromPtr(&v), "MapFromPtr()")
+	assertNullMap(t, MapFromPtr(nil), "MapFromPtr(nil)")
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	var m Map
+	err := json.Unmarshal(mapJSON, &m)
+	maybePanic(err)
+	assertMap(t, m, "object json")
+
+	var empty Map
+	err = json.Unmarshal([]byte(`{}`), &empty)
+	maybePanic(err)
+	if !empty.Valid || empty.Map == nil || len(empty.Map) != 0 {
+		t.Errorf("bad empty object json: %#v", empty)
+	}
+
+	var null Map
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullMap(t, null, "null json")
+
+	for _, data := range [][]byte{stringJSON, intJSON, []byte(`[1]`)} {
+		bad := MapFrom(mapValue)
+		if err := json.Unmarshal(data, &bad); err == nil {
+			t.Errorf("%s: expected error", data)
+		}
+		assertNullMap(t, bad, string(data))
+	}
+}
+
+func TestMarshalMap(t *testing.T) {
+	data, err := json.Marshal(MapFrom(mapValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(mapJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(NewMap(nil, true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "{}", "empty json marshal")
+
+	data, err = json.Marshal(NewMap(nil, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMapValue(t *testing.T) {
+	m := MapFrom(mapValue)
+	if v, ok := MapValue[string](m, "b"); !ok || v != "two" {
+		t.Errorf("bad MapValue[string](b): %v, %v", v, ok)
+	}
+	if v, ok := MapValue[float64](m, "a"); !ok || v != 1 {
+		t.Errorf("bad MapValue[float64](a): %v, %v", v, ok)
+	}
+	if v, ok := MapValue[map[string]interface{}](m, "c"); !ok || len(v) != 1 {
+		t.Errorf("bad MapValue[map[string]interface{}](c): %v, %v", v, ok)
+	}
+	if v, ok := MapValue[string](m, "a"); ok || v != "" {
+		t.Errorf("MapValue[string](a) should fail: %v, %v", v, ok)
+	}
+	if _, ok := MapValue[string](m, "missing"); ok {
+		t.Error("MapValue() should fail for a missing key")
+	}
+	if _, ok := MapValue[string](NewMap(mapValue, false), "b"); ok {
+		t.Error("MapValue() should fail for a null Map")
+	}
+}
+
+func TestMapScanValue(t *testing.T) {
+	var m Map
+	err := m.Scan(mapJSON)
+	maybePanic(err)
+	assertMap(t, m, "scanned []byte")
+	if v, err := m.Value(); err != nil || string(v.([]byte)) != string(mapJSON) {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var empty Map
+	err = empty.Scan("{}")
+	maybePanic(err)
+	if !empty.Valid || len(empty.Map) != 0 {
+		t.Errorf("bad scanned empty object: %#v", empty)
+	}
+	if v, err := empty.Value(); err != nil || string(v.([]byte)) != "{}" {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var jsonNull Map
+	err = jsonNull.Scan("null")
+	maybePanic(err)
+	assertNullMap(t, jsonNull, "scanned json null")
+
+	var null Map
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullMap(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	for _, value := range []interface{}{int64(1), "[1]", "hello"} {
+		wrong := MapFrom(mapValue)
+		if err := wrong.Scan(value); err == nil {
+			t.Errorf("%v: expected error", value)
+		}
+		assertNullMap(t, wrong, "scanned wrong")
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	if !NewMap(nil, false).Equal(NewMap(mapValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if !MapFrom(map[string]interface{}{}).Equal(NewMap(nil, true)) {
+		t.Error("Equal() should be true for an empty and a nil valid map")
+	}
+	if MapFrom(map[string]interface{}{}).Equal(NewMap(nil, false)) {
+		t.Error("Equal() should be false for an empty and a null map")
+	}
+	other := map[string]interface{}{"c": map[string]interface{}{"d": []interface{}{true, nil}}, "b": "two", "a": float64(1)}
+	if !MapFrom(mapValue).Equal(MapFrom(other)) {
+		t.Error("Equal() should be true for equal nested maps")
+	}
+	if MapFrom(mapValue).Equal(MapFrom(map[string]interface{}{"a": float64(1)})) {
+		t.Error("Equal() should be false for different maps")
+	}
+}
+
+func TestMapString(t *testing.T) {
+	if s := MapFrom(mapValue).String(); s != string(mapJSON) {
+		t.Errorf("bad String(): %s", s)
+	}
+	if s := fmt.Sprint(NewMap(nil, false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestMapValueOrNil(t *testing.T) {
+	assertValueOrNil(t, MapFrom(mapValue), "valid")
+	assertValueOrNil(t, NewMap(nil, false), "null")
+}
+
+func TestMapMustValue(t *testing.T) {
+	v := MapFrom(mapValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewMap(nil, false).MustValue() }, "Map")
+}
+
+func assertMap(t *testing.T, m Map, from string) {
+	if !m.Equal(MapFrom(mapValue)) {
+		t.Errorf("bad %s map: %v ≠ %v\n", from, m.Map, mapValue)
+	}
+	if !m.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullMap(t *testing.T, m Map, from string) {
+	if m.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,324 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/volatiletech/null/convert"
+)
+
+// Null is a nullable value of any type. It can be used to wrap domain types
+// (enums, structs) that have no dedicated type in this package.
+type Null[T any] struct {
+	Val   T
+	Valid bool
+}
+
+// NewNull creates a new Null
+func NewNull[T any](v T, valid bool) Null[T] {
+	return Null[T]{
+		Val:   v,
+		Valid: valid,
+	}
+}
+
+// NullFrom creates a new Null that will always be valid.
+func NullFrom[T any](v T) Null[T] {
+	return NewNull(v, true)
+}
+
+// NullFromPtr creates a new Null that will be null if v is nil.
+func NullFromPtr[T any](v *T) Null[T] {
+	if v == nil {
+		var zero T
+		return NewNull(zero, false)
+	}
+	return NewNull(*v, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	var zero T
+	if bytes.Equal(data, NullBytes) {
+		n.Val = zero
+		n.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.Val); err != nil {
+		n.Val = zero
+		n.Valid = false
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Null
+// to def and makes it valid, rather than null.
+func (n *Null[T]) UnmarshalJSONDefault(data []byte, def T) error {
+	if bytes.Equal(data, NullBytes) {
+		n.SetValid(def)
+		return nil
+	}
+	return n.UnmarshalJSON(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return NullBytes, nil
+	}
+	return json.Marshal(n.Val)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Null as chosen by opts.
+func (n Null[T]) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(n)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Null is null.
+func (n Null[T]) MarshalYAML() (interface{}, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Val, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (n *Null[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		var zero T
+		n.Val = zero
+		n.Valid = false
+		return nil
+	}
+	n.Val = *v
+	n.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Null is null.
+func (n Null[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.Val)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (n *Null[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *T
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		var zero T
+		n.Val = zero
+		n.Valid = false
+		return nil
+	}
+	n.Val = *v
+	n.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Null is encoded as a single byte. Valid values are gob encoded, so T
+// must itself be encodable by encoding/gob.
+func (n Null[T]) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{encodedNull}, nil
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(n.Val); err != nil {
+		return nil, err
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, buf.Bytes()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (n *Null[T]) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Null")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		var zero T
+		n.Val = zero
+		n.Valid = false
+		return nil
+	}
+	b, err := decodeLengthPrefixed(value, "Null")
+	if err != nil {
+		return err
+	}
+	var v T
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&v); err != nil {
+		return err
+	}
+	n.Val = v
+	n.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (n Null[T]) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (n *Null[T]) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// SetValid changes this Null's value and also sets it to be non-null.
+func (n *Null[T]) SetValid(v T) {
+	n.Val = v
+	n.Valid = true
+}
+
+// SetNull sets this Null to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (n *Null[T]) SetNull() {
+	var zero T
+	n.Val = zero
+	n.Valid = false
+}
+
+// Ptr returns a pointer to this Null's value, or a nil pointer if this Null is null.
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Val
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n Null[T]) ValueOrZero() T {
+	if !n.Valid {
+		var zero T
+		return zero
+	}
+	return n.Val
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (n Null[T]) ValueOr(def T) T {
+	if !n.Valid {
+		return def
+	}
+	return n.Val
+}
+
+// Or returns this Null if it is valid, otherwise other.
+func (n Null[T]) Or(other Null[T]) Null[T] {
+	if !n.Valid {
+		return other
+	}
+	return n
+}
+
+// MustValue returns the inner value, and panics if this Null is null.
+func (n Null[T]) MustValue() T {
+	if !n.Valid {
+		panic("null.Null: MustValue called on invalid value")
+	}
+	return n.Val
+}
+
+// Map returns a new Null holding fn applied to this Null's value. A null Null
+// is returned unchanged and fn is not called.
+func (n Null[T]) Map(fn func(T) T) Null[T] {
+	if !n.Valid {
+		return n
+	}
+	return NewNull(fn(n.Val), true)
+}
+
+// IsZero returns true if this Null is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (n Null[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// IsNull returns true if this Null is null, the same as !Valid.
+func (n Null[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Null, or nil if it is null, for
+// a *Null field tagged omitempty that should leave nulls out.
+func (n Null[T]) OmitEmpty() *Null[T] {
+	if !n.Valid {
+		return nil
+	}
+	return &n
+}
+
+// String implements fmt.Stringer.
+// It returns the value formatted with fmt.Sprint, or NullDisplay if this Null is null.
+func (n Null[T]) String() string {
+	if !n.Valid {
+		return NullDisplay
+	}
+	return fmt.Sprint(n.Val)
+}
+
+// Scan implements the Scanner interface.
+func (n *Null[T]) Scan(value interface{}) error {
+	var zero T
+	// a T that is itself a driver.Valuer is stored as is, not unwrapped
+	if v, ok := value.(T); ok && reflect.TypeOf(zero) != nil {
+		n.Val, n.Valid = v, true
+		return nil
+	}
+	value = sqlNullValue(value)
+	if value == nil {
+		n.Val, n.Valid = zero, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&n.Val, value); err != nil {
+		n.Val, n.Valid = zero, false
+		return scanError("Null", value, &n.Val, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface. The inner value is converted
+// with driver.DefaultParameterConverter, which also honors driver.Valuer.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.Val)
+}
+
+// ValueOrNil returns nil if this Null is null, otherwise the same value as Value.
+// If Value would return an error, the unconverted value is returned so that the
+// driver reports the error when it is used.
+func (n Null[T]) ValueOrNil() interface{} {
+	if !n.Valid {
+		return nil
+	}
+	v, err := driver.DefaultParameterConverter.ConvertValue(n.Val)
+	if err != nil {
+		return n.Val
+	}
+	return v
+}
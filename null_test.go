@@ -0,0 +1,241 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type nullPayload struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+var (
+	nullPayloadJSON = []byte(`{"name":"hello","age":15}`)
+	nullPayloadVal  = nullPayload{Name: "hello", Age: 15}
+)
+
+func TestNullFrom(t *testing.T) {
+	n := NullFrom(nullPayloadVal)
+	assertNullPayload(t, n, "NullFrom()")
+
+	zero := NullFrom(0)
+	if !zero.Valid {
+		t.Error("NullFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestNullFromPtr(t *testing.T) {
+	v := nullPayloadVal
+	n := NullFromPtr(&v)
+	assertNullPayload(t, n, "NullFromPtr()")
+
+	null := NullFromPtr[nullPayload](nil)
+	assertNullNull(t, null, "NullFromPtr(nil)")
+}
+
+func TestUnmarshalNull(t *testing.T) {
+	var n Null[nullPayload]
+	err := json.Unmarshal(nullPayloadJSON, &n)
+	maybePanic(err)
+	assertNullPayload(t, n, "struct json")
+
+	var i Null[int]
+	err = json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	if !i.Valid || i.Val != 12345 {
+		t.Errorf("bad int json: %#v", i)
+	}
+
+	var null Null[int]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullNull(t, null, "null json")
+
+	var badType Null[int]
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullNull(t, badType, "wrong type json")
+
+	var missing struct {
+		N Null[int] `json:"n"`
+	}
+	err = json.Unmarshal([]byte(`{}`), &missing)
+	maybePanic(err)
+	assertNullNull(t, missing.N, "missing key json")
+}
+
+func TestMarshalNull(t *testing.T) {
+	n := NullFrom(nullPayloadVal)
+	data, err := json.Marshal(n)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(nullPayloadJSON), "non-empty json marshal")
+
+	i := NullFrom(0)
+	data, err = json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+
+	// invalid values should be encoded as null
+	null := NewNull(nullPayloadVal, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestNullPointer(t *testing.T) {
+	n := NullFrom(126)
+	ptr := n.Ptr()
+	if *ptr != 126 {
+		t.Errorf("bad %s int: %#v ≠ %d\n", "pointer", ptr, 126)
+	}
+
+	null := NewNull(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestNullSetValid(t *testing.T) {
+	change := NewNull(nullPayload{}, false)
+	assertNullNull(t, change, "SetValid()")
+	change.SetValid(nullPayloadVal)
+	assertNullPayload(t, change, "SetValid()")
+}
+
+func TestNullSetNull(t *testing.T) {
+	change := NullFrom(-123)
+	change.SetNull()
+	assertNullNull(t, change, "SetNull()")
+	if change.Val != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Val)
+	}
+}
+
+func TestNullValueOr(t *testing.T) {
+	valid := NullFrom(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	null := NewNull(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestNullScanValue(t *testing.T) {
+	var i Null[int64]
+	err := i.Scan(int64(42))
+	maybePanic(err)
+	if !i.Valid || i.Val != 42 {
+		t.Errorf("bad scanned int64: %#v", i)
+	}
+	if v, err := i.Value(); v != int64(42) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null Null[int64]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullNull(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Null[int64]
+	err = wrong.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullNull(t, wrong, "scanned wrong")
+}
+
+func TestNullString(t *testing.T) {
+	n := NullFrom(12345)
+	if s := fmt.Sprintf("%v", n); s != "12345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewNull("", false)
+	if s := fmt.Sprintf("%s", null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestNullValueOrNil(t *testing.T) {
+	assertValueOrNil(t, NullFrom(int8(-123)), "valid")
+	assertValueOrNil(t, NewNull(0, false), "null")
+}
+
+func TestNullMustValue(t *testing.T) {
+	v := NullFrom(int8(-123))
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewNull(0, false).MustValue() }, "Null")
+}
+
+func TestNullMap(t *testing.T) {
+	mapped := NullFrom(12).Map(func(v int) int { return v * 2 })
+	if !mapped.Valid || mapped.Val != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewNull(0, false).Map(func(v int) int {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullNull(t, null, "Map() null")
+}
+
+type valueOrNiler interface {
+	Value() (driver.Value, error)
+	ValueOrNil() interface{}
+}
+
+func assertValueOrNil(t *testing.T, v valueOrNiler, from string) {
+	want, err := v.Value()
+	maybePanic(err)
+	got := v.ValueOrNil()
+	if reflect.TypeOf(got) != reflect.TypeOf(want) || !reflect.DeepEqual(got, want) {
+		t.Errorf("bad %s %T ValueOrNil(): %#v ≠ %#v", from, v, got, want)
+	}
+}
+
+func assertMustValue(t *testing.T, got, want interface{}, null func(), typ string) {
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bad %s MustValue(): %#v ≠ %#v", typ, got, want)
+	}
+	defer func() {
+		msg := "null." + typ + ": MustValue called on invalid value"
+		if r := recover(); r != msg {
+			t.Errorf("bad %s MustValue() panic: %v", typ, r)
+		}
+	}()
+	null()
+}
+
+func assertNullPayload(t *testing.T, n Null[nullPayload], from string) {
+	if n.Val != nullPayloadVal {
+		t.Errorf("bad %s payload: %#v ≠ %#v\n", from, n.Val, nullPayloadVal)
+	}
+	if !n.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullNull[T any](t *testing.T, n Null[T], from string) {
+	if n.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,204 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Every numeric type decodes JSON the same way: a bare number or a string
+// holding one is accepted, an empty string is null, and errors use the same
+// wording whatever the width. A string must hold a number exactly as JSON
+// would write it bare: an optional minus sign, digits without a leading zero,
+// and for the float types an optional fraction and exponent. Plus signs,
+// underscores, hex, surrounding whitespace and forms such as "1." or ".5" are
+// rejected, rather than left to whatever strconv happens to accept.
+
+// maxIntegerLen is the length of the longest decimal integer of any of the
+// integer types, such as math.MinInt64 or math.MaxUint64. Encoding into a
+// buffer of this capacity takes a single allocation.
+const maxIntegerLen = 20
+
+// isJSONInteger reports whether data is a JSON number written as a plain integer.
+func isJSONInteger(data []byte) bool {
+	digits := data
+	if len(digits) > 0 && digits[0] == '-' {
+		digits = digits[1:]
+	}
+	if len(digits) == 0 || (digits[0] == '0' && len(digits) > 1) {
+		return false
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonUnquoted returns the contents of data if it is a JSON string that
+// needs no unescaping.
+func jsonUnquoted(data []byte) (string, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return "", false
+	}
+	s := data[1 : len(data)-1]
+	for _, c := range s {
+		if c == '\\' || c == '"' || c < ' ' {
+			return "", false
+		}
+	}
+	return string(s), true
+}
+
+// isJSONNumber reports whether s is a number in JSON syntax, with no
+// surrounding whitespace.
+func isJSONNumber(s string) bool {
+	if len(s) == 0 || s[len(s)-1] < '0' || s[len(s)-1] > '9' {
+		return false
+	}
+	if s[0] != '-' && (s[0] < '0' || s[0] > '9') {
+		return false
+	}
+	// anything valid that starts with a minus sign or digit is a number
+	return json.Valid([]byte(s))
+}
+
+// jsonNumber returns the number held by data, which may be a bare JSON number
+// or a JSON string holding one. It returns "" for an empty string, which the
+// caller treats as null.
+func jsonNumber(data []byte, typ string) (string, error) {
+	s, ok := jsonUnquoted(data)
+	if !ok {
+		if len(data) == 0 || data[0] != '"' {
+			s = string(data)
+		} else if err := json.Unmarshal(data, &s); err != nil {
+			return "", err
+		}
+	} else if len(s) == 0 {
+		return "", nil
+	}
+
+	if isJSONNumber(s) {
+		return s, nil
+	}
+	if !json.Valid(data) {
+		// report malformed input as a *json.SyntaxError, like json.Unmarshal
+		var v interface{}
+		return "", json.Unmarshal(data, &v)
+	}
+	if data[0] == '"' {
+		return "", fmt.Errorf("json: cannot unmarshal string %q into Go value of type null.%s: not a plain JSON number", s, typ)
+	}
+	return "", fmt.Errorf("json: cannot unmarshal %s into Go value of type null.%s", data, typ)
+}
+
+// numberError converts an error other than strconv.ErrRange from strconv
+// parsing s into the error reported by UnmarshalJSON for the null type typ.
+func numberError(s string, err error, typ string) error {
+	// s is a valid JSON number, so only an integer type can fail to parse it
+	return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.%s: not an integer", s, typ)
+}
+
+// jsonFastInteger returns the integer text of data if it is a plain integer,
+// bare or in a string without escapes, which is by far the most common input.
+func jsonFastInteger(data []byte) ([]byte, bool) {
+	if len(data) > 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return data, isJSONInteger(data)
+}
+
+// parseJSONInt decodes data with jsonNumber as an integer of the given bit
+// size. valid is false, with no error, for an empty string. Plain integers are
+// parsed directly, avoiding the cost of json.Unmarshal.
+func parseJSONInt(data []byte, bits int, typ string) (x int64, valid bool, err error) {
+	if b, ok := jsonFastInteger(data); ok {
+		if x, err := strconv.ParseInt(string(b), 10, bits); err == nil {
+			return x, true, nil
+		}
+	}
+	s, err := jsonNumber(data, typ)
+	if err != nil || s == "" {
+		return 0, false, err
+	}
+	x, err = strconv.ParseInt(s, 10, bits)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, false, intOverflow(typ, s, bits).fromJSON()
+	}
+	if err != nil {
+		return 0, false, numberError(s, err, typ)
+	}
+	return x, true, nil
+}
+
+// parseJSONUint is like parseJSONInt, for an unsigned integer. Negative
+// numbers underflow.
+func parseJSONUint(data []byte, bits int, typ string) (x uint64, valid bool, err error) {
+	if b, ok := jsonFastInteger(data); ok {
+		if x, err := strconv.ParseUint(string(b), 10, bits); err == nil {
+			return x, true, nil
+		}
+	}
+	s, err := jsonNumber(data, typ)
+	if err != nil || s == "" {
+		return 0, false, err
+	}
+	if s[0] == '-' && isJSONInteger([]byte(s)) {
+		return 0, false, uintOverflow(typ, s, bits).fromJSON()
+	}
+	x, err = strconv.ParseUint(s, 10, bits)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, false, uintOverflow(typ, s, bits).fromJSON()
+	}
+	if err != nil {
+		return 0, false, numberError(s, err, typ)
+	}
+	return x, true, nil
+}
+
+// parseJSONFloat is like parseJSONInt, for a float. Values too large for the
+// bit size overflow, while values too small round to zero.
+func parseJSONFloat(data []byte, bits int, typ string) (x float64, valid bool, err error) {
+	if isNonFiniteJSON(data) {
+		return 0, false, fmt.Errorf("json: cannot unmarshal %s into Go value of type null.%s: NaN and Infinity are not valid JSON numbers", data, typ)
+	}
+	s, err := jsonNumber(data, typ)
+	if err != nil || s == "" {
+		return 0, false, err
+	}
+	x, err = strconv.ParseFloat(s, bits)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, false, floatOverflow(typ, s, bits).fromJSON()
+	}
+	if err != nil {
+		return 0, false, numberError(s, err, typ)
+	}
+	return x, true, nil
+}
+
+// appendJSONFloat appends x, a finite float of the given bit size, formatted
+// as encoding/json formats a float64 or float32: in plain decimal form, and
+// in exponent form only for magnitudes below 1e-6 or from 1e21, with the
+// exponent written without a leading zero, such as 1e-7 and 1e+21.
+func appendJSONFloat(dst []byte, x float64, bits int) []byte {
+	format := byte('f')
+	if abs := math.Abs(x); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	dst = strconv.AppendFloat(dst, x, format, -1, bits)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst
+}
@@ -0,0 +1,295 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type numberType struct {
+	name     string
+	new      func() json.Unmarshaler
+	signed   bool
+	float    bool
+	overflow string
+}
+
+// numberCase is an UnmarshalJSON input and the expected value, or with fail
+// a substring of the expected error.
+type numberCase struct {
+	in, want string
+	fail     bool
+}
+
+var numberTypes = []numberType{
+	{"Int", func() json.Unmarshaler { return new(Int) }, true, false, "9223372036854775808"},
+	{"Int8", func() json.Unmarshaler { return new(Int8) }, true, false, "128"},
+	{"Int16", func() json.Unmarshaler { return new(Int16) }, true, false, "32768"},
+	{"Int32", func() json.Unmarshaler { return new(Int32) }, true, false, "2147483648"},
+	{"Int64", func() json.Unmarshaler { return new(Int64) }, true, false, "9223372036854775808"},
+	{"Uint", func() json.Unmarshaler { return new(Uint) }, false, false, "18446744073709551616"},
+	{"Uint8", func() json.Unmarshaler { return new(Uint8) }, false, false, "256"},
+	{"Uint16", func() json.Unmarshaler { return new(Uint16) }, false, false, "65536"},
+	{"Uint32", func() json.Unmarshaler { return new(Uint32) }, false, false, "4294967296"},
+	{"Uint64", func() json.Unmarshaler { return new(Uint64) }, false, false, "18446744073709551616"},
+	{"Float32", func() json.Unmarshaler { return new(Float32) }, true, true, "1e39"},
+	{"Float64", func() json.Unmarshaler { return new(Float64) }, true, true, "1e400"},
+}
+
+func TestUnmarshalJSONNumberMatrix(t *testing.T) {
+	if strconv.IntSize == 32 {
+		numberTypes[0].overflow = "2147483648"
+		numberTypes[5].overflow = "4294967296"
+	}
+
+	const null = "<null>"
+	for _, typ := range numberTypes {
+		tests := []numberCase{
+			{in: `12`, want: "12"},
+			{in: `"12"`, want: "12"},
+			{in: `"\u0031\u0032"`, want: "12"},
+			{in: `0`, want: "0"},
+			{in: `null`, want: null},
+			{in: `""`, want: null},
+			{in: `"abc"`, want: "cannot unmarshal", fail: true},
+			{in: `" 12"`, want: "cannot unmarshal", fail: true},
+			{in: `"0x10"`, want: "cannot unmarshal", fail: true},
+			{in: `"NaN"`, want: "cannot unmarshal", fail: true},
+			{in: `true`, want: "cannot unmarshal", fail: true},
+			{in: `{}`, want: "cannot unmarshal", fail: true},
+			{in: typ.overflow, want: "overflows", fail: true},
+			{in: strconv.Quote(typ.overflow), want: "overflows", fail: true},
+		}
+		if typ.float {
+			tests = append(tests,
+				numberCase{in: `1.5`, want: "1.5"},
+				numberCase{in: `"-2.5e2"`, want: "-250"},
+				numberCase{in: `1e-400`, want: "0"},
+			)
+		} else {
+			tests = append(tests,
+				numberCase{in: `1.5`, want: "cannot unmarshal", fail: true},
+				numberCase{in: `"1e2"`, want: "cannot unmarshal", fail: true},
+			)
+		}
+		if typ.signed {
+			tests = append(tests, numberCase{in: `"-7"`, want: "-7"})
+		} else {
+			tests = append(tests,
+				numberCase{in: `"-7"`, want: "json: -7 underflows min " + strings.ToLower(typ.name) + " value", fail: true},
+				numberCase{in: `"-0"`, want: "underflows", fail: true},
+			)
+		}
+
+		for _, test := range tests {
+			v := typ.new()
+			err := json.Unmarshal([]byte(test.in), v)
+			got := numberValue(v)
+			from := fmt.Sprintf("%s from %s", typ.name, test.in)
+			switch {
+			case test.fail:
+				if err == nil || !strings.Contains(err.Error(), test.want) {
+					t.Errorf("%s: bad error %v, want %q", from, err, test.want)
+				}
+				if got != null {
+					t.Errorf("%s: should be null after an error, got %s", from, got)
+				}
+			case err != nil:
+				t.Errorf("%s: unexpected error %v", from, err)
+			case got != test.want:
+				t.Errorf("%s: bad value %s ≠ %s", from, got, test.want)
+			}
+		}
+	}
+}
+
+func TestUnmarshalJSONNumberStrings(t *testing.T) {
+	// a string must hold a number as JSON would write it bare
+	accepted := map[string]string{
+		`"0"`:       "0",
+		`"-0"`:      "0",
+		`"7"`:       "7",
+		`"-7"`:      "-7",
+		`"100"`:     "100",
+		`"1\u0030"`: "10",
+	}
+	rejected := []string{
+		`"007"`, `"00"`, `"-01"`, `"+1"`, `"1_000"`, `"1,000"`, `"0x1F"`, `"0b1"`, `"0o7"`,
+		`" 1"`, `"1 "`, `"\t1"`, `"1."`, `".5"`, `"-"`, `"1e"`, `"Inf"`, `"١٢"`,
+	}
+	floatOnly := map[string]string{
+		`"1.5"`:    "1.5",
+		`"-0.25"`:  "-0.25",
+		`"1e3"`:    "1000",
+		`"1E+3"`:   "1000",
+		`"2.5e-1"`: "0.25",
+		`"1.0"`:    "1",
+	}
+
+	for _, typ := range numberTypes {
+		for in, want := range accepted {
+			if !typ.signed && strings.HasPrefix(in, `"-`) {
+				// covered by the underflow cases of TestUnmarshalJSONNumberMatrix
+				continue
+			}
+			if typ.float && in == `"-0"` {
+				want = "-0"
+			}
+			v := typ.new()
+			if err := json.Unmarshal([]byte(in), v); err != nil || numberValue(v) != want {
+				t.Errorf("%s from %s: should be %s, got %s, %v", typ.name, in, want, numberValue(v), err)
+			}
+		}
+		for in, want := range floatOnly {
+			v := typ.new()
+			err := json.Unmarshal([]byte(in), v)
+			switch {
+			case typ.float && (err != nil || numberValue(v) != want):
+				t.Errorf("%s from %s: should be %s, got %s, %v", typ.name, in, want, numberValue(v), err)
+			case !typ.float && (err == nil || !strings.HasSuffix(err.Error(), ": not an integer")):
+				t.Errorf("%s from %s: should not be an integer, got %v", typ.name, in, err)
+			}
+		}
+		for _, in := range rejected {
+			v := typ.new()
+			err := json.Unmarshal([]byte(in), v)
+			if err == nil || !strings.HasSuffix(err.Error(), "into Go value of type null."+typ.name+": not a plain JSON number") {
+				t.Errorf("%s from %s: bad error %v", typ.name, in, err)
+			}
+			if numberValue(v) != "<null>" {
+				t.Errorf("%s from %s: should be null after an error", typ.name, in)
+			}
+		}
+	}
+}
+
+// numberValue returns the value of a numeric type as a string, or "<null>".
+func numberValue(v interface{}) string {
+	rv := reflect.ValueOf(v).Elem()
+	if !rv.FieldByName("Valid").Bool() {
+		return "<null>"
+	}
+	return fmt.Sprint(rv.Field(0).Interface())
+}
+
+func TestSetChecked(t *testing.T) {
+	// each setter starts from a valid 7, which a failed SetChecked must keep
+	check := func(typ string, n string, v fmt.Stringer, err error, ok bool) {
+		if ok && (err != nil || v.String() != n) {
+			t.Errorf("%s.SetChecked(%s): %v, %v", typ, n, v, err)
+		}
+		if !ok && (err == nil || err.Error() != "null: "+n+" overflows null."+typ || v.String() != "7") {
+			t.Errorf("%s.SetChecked(%s) should fail and leave the value unchanged: %v, %v", typ, n, v, err)
+		}
+	}
+
+	signed := []struct {
+		typ      string
+		min, max int64
+		set      func(n int64) (fmt.Stringer, error)
+	}{
+		{"Int", math.MinInt, math.MaxInt, func(n int64) (fmt.Stringer, error) { v := IntFrom(7); return &v, v.SetChecked(n) }},
+		{"Int8", math.MinInt8, math.MaxInt8, func(n int64) (fmt.Stringer, error) { v := Int8From(7); return &v, v.SetChecked(n) }},
+		{"Int16", math.MinInt16, math.MaxInt16, func(n int64) (fmt.Stringer, error) { v := Int16From(7); return &v, v.SetChecked(n) }},
+		{"Int32", math.MinInt32, math.MaxInt32, func(n int64) (fmt.Stringer, error) { v := Int32From(7); return &v, v.SetChecked(n) }},
+	}
+	for _, test := range signed {
+		for _, n := range []int64{test.min, -1, 0, test.max} {
+			v, err := test.set(n)
+			check(test.typ, strconv.FormatInt(n, 10), v, err, true)
+		}
+		if test.min > math.MinInt64 {
+			v, err := test.set(test.min - 1)
+			check(test.typ, strconv.FormatInt(test.min-1, 10), v, err, false)
+			v, err = test.set(test.max + 1)
+			check(test.typ, strconv.FormatInt(test.max+1, 10), v, err, false)
+		}
+	}
+
+	unsigned := []struct {
+		typ string
+		max uint64
+		set func(n uint64) (fmt.Stringer, error)
+	}{
+		{"Uint", math.MaxUint, func(n uint64) (fmt.Stringer, error) { v := UintFrom(7); return &v, v.SetChecked(n) }},
+		{"Uint8", math.MaxUint8, func(n uint64) (fmt.Stringer, error) { v := Uint8From(7); return &v, v.SetChecked(n) }},
+		{"Uint16", math.MaxUint16, func(n uint64) (fmt.Stringer, error) { v := Uint16From(7); return &v, v.SetChecked(n) }},
+		{"Uint32", math.MaxUint32, func(n uint64) (fmt.Stringer, error) { v := Uint32From(7); return &v, v.SetChecked(n) }},
+	}
+	for _, test := range unsigned {
+		for _, n := range []uint64{0, test.max} {
+			v, err := test.set(n)
+			check(test.typ, strconv.FormatUint(n, 10), v, err, true)
+		}
+		if test.max < math.MaxUint64 {
+			v, err := test.set(test.max + 1)
+			check(test.typ, strconv.FormatUint(test.max+1, 10), v, err, false)
+		}
+	}
+}
+
+// integerMarshalers returns valid values of every integer type at the edges
+// of its range, along with their expected decimal form.
+func integerMarshalers() map[string]interface {
+	MarshalJSON() ([]byte, error)
+	MarshalText() ([]byte, error)
+} {
+	return map[string]interface {
+		MarshalJSON() ([]byte, error)
+		MarshalText() ([]byte, error)
+	}{
+		strconv.Itoa(math.MinInt):              IntFrom(math.MinInt),
+		"-7":                                   IntFrom(-7),
+		strconv.FormatInt(math.MinInt8, 10):    Int8From(math.MinInt8),
+		strconv.FormatInt(math.MaxInt16, 10):   Int16From(math.MaxInt16),
+		strconv.FormatInt(math.MinInt32, 10):   Int32From(math.MinInt32),
+		strconv.FormatInt(math.MaxInt64, 10):   Int64From(math.MaxInt64),
+		"0":                                    UintFrom(0),
+		strconv.FormatUint(math.MaxUint8, 10):  Uint8From(math.MaxUint8),
+		strconv.FormatUint(math.MaxUint16, 10): Uint16From(math.MaxUint16),
+		strconv.FormatUint(math.MaxUint32, 10): Uint32From(math.MaxUint32),
+		strconv.FormatUint(math.MaxUint64, 10): Uint64From(math.MaxUint64),
+	}
+}
+
+func TestMarshalIntegers(t *testing.T) {
+	for want, v := range integerMarshalers() {
+		data, err := v.MarshalJSON()
+		maybePanic(err)
+		if string(data) != want {
+			t.Errorf("bad %T MarshalJSON(): %s ≠ %s", v, data, want)
+		}
+		text, err := v.MarshalText()
+		maybePanic(err)
+		if string(text) != want {
+			t.Errorf("bad %T MarshalText(): %s ≠ %s", v, text, want)
+		}
+
+		// each encoding allocates only the returned slice
+		if n := testing.AllocsPerRun(100, func() { v.MarshalJSON() }); n > 1 {
+			t.Errorf("%T MarshalJSON() allocates %v times", v, n)
+		}
+		if n := testing.AllocsPerRun(100, func() { v.MarshalText() }); n > 1 {
+			t.Errorf("%T MarshalText() allocates %v times", v, n)
+		}
+	}
+}
+
+func BenchmarkMarshalIntegers(b *testing.B) {
+	values := integerMarshalers()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, v := range values {
+			if _, err := v.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := v.MarshalText(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type omitZeroRecord struct {
+	Bool     Bool      `json:"bool,omitzero"`
+	Int      Int       `json:"int,omitzero"`
+	Int64    Int64     `json:"int64,omitzero"`
+	Uint8    Uint8     `json:"uint8,omitzero"`
+	Float64  Float64   `json:"float64,omitzero"`
+	String   String    `json:"string,omitzero"`
+	Time     Time      `json:"time,omitzero"`
+	Duration Duration  `json:"duration,omitzero"`
+	Null     Null[int] `json:"null,omitzero"`
+}
+
+func TestOmitZero(t *testing.T) {
+	// nulls are left out, even when they hold a stale value
+	nulls := omitZeroRecord{
+		Bool:     NewBool(true, false),
+		Int:      NewInt(1, false),
+		Int64:    NewInt64(1, false),
+		Uint8:    NewUint8(1, false),
+		Float64:  NewFloat64(1, false),
+		String:   NewString("stale", false),
+		Time:     NewTime(timeValue, false),
+		Duration: NewDuration(time.Second, false),
+		Null:     NewNull(1, false),
+	}
+	data, err := json.Marshal(nulls)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{}`, "omitzero nulls")
+
+	// valid zero values are still encoded
+	zeros := omitZeroRecord{
+		Bool:     BoolFrom(false),
+		Int:      IntFrom(0),
+		Int64:    Int64From(0),
+		Uint8:    Uint8From(0),
+		Float64:  Float64From(0),
+		String:   StringFrom(""),
+		Time:     TimeFrom(time.Unix(0, 0).UTC()),
+		Duration: DurationFrom(0),
+		Null:     NullFrom(0),
+	}
+	data, err = json.Marshal(zeros)
+	maybePanic(err)
+	want := `{"bool":false,"int":0,"int64":0,"uint8":0,"float64":0,"string":"","time":"1970-01-01T00:00:00Z","duration":"0s","null":0}`
+	assertJSONEquals(t, data, want, "omitzero valid zero values")
+}
+
+func TestIsZeroIsNull(t *testing.T) {
+	// every type's IsZero reports null, not the zero value of its contents
+	valids := []interface{ IsZero() bool }{
+		BoolFrom(false), ByteFrom(0), BytesFrom([]byte{}), Complex64From(0), Complex128From(0),
+		DateFrom(time.Time{}), DurationFrom(0), EnumFrom[color](""), Float32From(0), Float64From(0), HstoreFrom(map[string]String{}),
+		FormattedTimeFrom(time.Time{}, time.RFC3339), IntFrom(0), IntBoolFrom(false), Int8From(0), Int16From(0), Int32From(0), Int64From(0),
+		JSONFrom([]byte(`0`)), LooseStringFrom(""), MapFrom(map[string]interface{}{}), RuneFrom(0), StringFrom(""), StringSliceFrom([]string{}),
+		TimeFrom(time.Time{}), TimeStringFrom(time.Time{}), NewTrimmedString("", true), UintFrom(0), Uint8From(0),
+		Uint16From(0), Uint32From(0), Uint64From(0), NullFrom(0),
+	}
+	for _, v := range valids {
+		if v.IsZero() {
+			t.Errorf("%T IsZero() should be false for a valid zero value", v)
+		}
+	}
+}
+
+func TestIsNull(t *testing.T) {
+	// IsNull tracks Valid, so a valid zero value is not null
+	values := []struct {
+		v    interface{ IsNull() bool }
+		null bool
+	}{
+		{IntFrom(5), false}, {Int8From(0), false}, {NewInt8(0, false), true},
+		{StringFrom(""), false}, {NewString("stale", false), true},
+		{Float64From(0), false}, {NewFloat64(1, false), true},
+		{BoolFrom(false), false}, {NewBool(true, false), true},
+		{TimeFrom(time.Time{}), false}, {NewTime(timeValue, false), true},
+		{BytesFrom([]byte{}), false}, {NewBytes(nil, false), true},
+		{Uint64From(0), false}, {NewUint64(0, false), true},
+		{NullFrom(0), false}, {NewNull(0, false), true},
+		{CIDRFrom(cidrValue), false}, {NewCIDR(nil, false), true},
+	}
+	for _, test := range values {
+		if got := test.v.IsNull(); got != test.null {
+			t.Errorf("%T %v: IsNull() = %v, want %v", test.v, test.v, got, test.null)
+		}
+	}
+
+	// an Optional can be null without being unset, so IsNull and IsZero differ
+	if o := OptionalNull[int](); !o.IsNull() || o.IsZero() {
+		t.Errorf("bad Optional set to null: IsNull() = %v, IsZero() = %v", o.IsNull(), o.IsZero())
+	}
+	if o := (Optional[int]{}); !o.IsNull() || !o.IsZero() {
+		t.Errorf("bad unset Optional: IsNull() = %v, IsZero() = %v", o.IsNull(), o.IsZero())
+	}
+	if o := OptionalFrom(0); o.IsNull() || o.IsZero() {
+		t.Errorf("bad valid zero Optional: IsNull() = %v, IsZero() = %v", o.IsNull(), o.IsZero())
+	}
+}
+
+func TestOmitEmpty(t *testing.T) {
+	type response struct {
+		Name  *String    `json:"name,omitempty"`
+		Count *Int       `json:"count,omitempty"`
+		Tags  *Null[int] `json:"tags,omitempty"`
+	}
+
+	nulls := response{
+		Name:  NewString("stale", false).OmitEmpty(),
+		Count: NewInt(1, false).OmitEmpty(),
+		Tags:  NewNull(1, false).OmitEmpty(),
+	}
+	data, err := json.Marshal(nulls)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{}`, "omitempty nulls")
+
+	zeros := response{
+		Name:  StringFrom("").OmitEmpty(),
+		Count: IntFrom(0).OmitEmpty(),
+		Tags:  NullFrom(0).OmitEmpty(),
+	}
+	data, err = json.Marshal(zeros)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":"","count":0,"tags":0}`, "omitempty valid zero values")
+
+	// the pointer is to a copy
+	s := StringFrom("test")
+	p := s.OmitEmpty()
+	p.String = "changed"
+	assertStr(t, s, "OmitEmpty() copy")
+}
@@ -0,0 +1,124 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Optional is a nullable value that also records whether it was present in
+// the JSON it was decoded from, for PATCH style updates. After decoding into
+// a fresh value, an absent key leaves Set false, an explicit null gives
+// Set true and Valid false, and any other value gives Set and Valid true.
+//
+// encoding/json only calls UnmarshalJSON for keys that are present, so decode
+// into a zero Optional; a key absent from the input leaves the field as it
+// was.
+type Optional[T any] struct {
+	Val   T
+	Valid bool
+	Set   bool
+}
+
+// OptionalFrom creates a new Optional that is set and valid.
+func OptionalFrom[T any](v T) Optional[T] {
+	return Optional[T]{
+		Val:   v,
+		Valid: true,
+		Set:   true,
+	}
+}
+
+// OptionalNull creates a new Optional that is set to null.
+func OptionalNull[T any]() Optional[T] {
+	return Optional[T]{Set: true}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It marks this Optional as set, and a JSON null as null.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	var zero T
+	o.Set = true
+	if bytes.Equal(data, NullBytes) {
+		o.Val = zero
+		o.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &o.Val); err != nil {
+		o.Val = zero
+		o.Valid = false
+		return err
+	}
+
+	o.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Optional is null or unset. Use the omitzero
+// struct tag option (Go 1.24 and later), which calls IsZero, to leave out
+// unset values.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return NullBytes, nil
+	}
+	return json.Marshal(o.Val)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Optional as chosen by opts.
+func (o Optional[T]) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(o)
+}
+
+// Null returns this Optional's value as a Null, dropping whether it was set.
+func (o Optional[T]) Null() Null[T] {
+	return NewNull(o.Val, o.Valid)
+}
+
+// Ptr returns a pointer to this Optional's value, or a nil pointer if this Optional is null or unset.
+func (o Optional[T]) Ptr() *T {
+	if !o.Valid {
+		return nil
+	}
+	return &o.Val
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (o Optional[T]) ValueOrZero() T {
+	if !o.Valid {
+		var zero T
+		return zero
+	}
+	return o.Val
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (o Optional[T]) ValueOr(def T) T {
+	if !o.Valid {
+		return def
+	}
+	return o.Val
+}
+
+// IsZero returns true for unset Optionals, so that omitzero leaves them out.
+// An Optional set to null is not zero.
+func (o Optional[T]) IsZero() bool {
+	return !o.Set
+}
+
+// IsNull returns true if this Optional is null or unset. Unlike IsZero, it is
+// true for an Optional explicitly set to null.
+func (o Optional[T]) IsNull() bool {
+	return !o.Valid
+}
+
+// String implements fmt.Stringer.
+// It returns the value formatted with fmt.Sprint, or NullDisplay if this
+// Optional is null or unset.
+func (o Optional[T]) String() string {
+	if !o.Valid {
+		return NullDisplay
+	}
+	return fmt.Sprint(o.Val)
+}
@@ -0,0 +1,82 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type optionalPatch struct {
+	X Optional[int] `json:"x"`
+}
+
+func TestUnmarshalOptional(t *testing.T) {
+	var absent optionalPatch
+	err := json.Unmarshal([]byte(`{}`), &absent)
+	maybePanic(err)
+	if absent.X.Set || absent.X.Valid {
+		t.Errorf("absent key should be unset: %#v", absent.X)
+	}
+
+	var null optionalPatch
+	err = json.Unmarshal([]byte(`{"x":null}`), &null)
+	maybePanic(err)
+	if !null.X.Set || null.X.Valid {
+		t.Errorf("null key should be set and null: %#v", null.X)
+	}
+
+	var value optionalPatch
+	err = json.Unmarshal([]byte(`{"x":5}`), &value)
+	maybePanic(err)
+	if !value.X.Set || !value.X.Valid || value.X.Val != 5 {
+		t.Errorf("bad set value: %#v", value.X)
+	}
+
+	var bad optionalPatch
+	err = json.Unmarshal([]byte(`{"x":"five"}`), &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	if !bad.X.Set || bad.X.Valid {
+		t.Errorf("bad value should be set and null: %#v", bad.X)
+	}
+}
+
+func TestMarshalOptional(t *testing.T) {
+	data, err := json.Marshal(OptionalFrom(5))
+	maybePanic(err)
+	assertJSONEquals(t, data, "5", "valid json marshal")
+
+	data, err = json.Marshal(OptionalNull[int]())
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = json.Marshal(Optional[int]{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "unset json marshal")
+
+	data, err = OptionalNull[int]().MarshalJSONWith(MarshalOptions{Null: NullAsString})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"null"`, "null json marshal with options")
+}
+
+func TestOptionalAccessors(t *testing.T) {
+	var unset Optional[int]
+	if !unset.IsZero() || unset.Ptr() != nil || unset.ValueOr(7) != 7 || unset.String() != NullDisplay {
+		t.Errorf("bad unset accessors: %#v", unset)
+	}
+	if OptionalNull[int]().IsZero() {
+		t.Error("IsZero() should be false for a set null")
+	}
+
+	v := OptionalFrom(5)
+	if p := v.Ptr(); p == nil || *p != 5 {
+		t.Errorf("bad Ptr(): %v", p)
+	}
+	if v.ValueOrZero() != 5 || v.String() != "5" {
+		t.Errorf("bad valid accessors: %#v", v)
+	}
+	if n := v.Null(); !n.Valid || n.Val != 5 {
+		t.Errorf("bad Null(): %#v", n)
+	}
+	assertNullNull(t, OptionalNull[int]().Null(), "Null() of a set null")
+}
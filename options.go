@@ -0,0 +1,84 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+)
+
+// NullMode selects how a null value is encoded by MarshalJSONWith.
+type NullMode int
+
+const (
+	// NullAsJSON encodes a null value as JSON null. This is what MarshalJSON does.
+	NullAsJSON NullMode = iota
+	// NullAsString encodes a null value as the JSON string "null".
+	NullAsString
+	// NullAsOmitted encodes a null value as no output at all. encoding/json
+	// cannot omit a key on a Marshaler's behalf, so this is for callers that
+	// build their own objects and drop members whose encoding is empty.
+	NullAsOmitted
+)
+
+// nullStringBytes is the JSON string "null" used by NullAsString.
+var nullStringBytes = []byte(`"null"`)
+
+// MarshalOptions configures the MarshalJSONWith methods. The zero value
+// matches MarshalJSON. MarshalOptions is passed by value, so it is safe to use
+// different options concurrently.
+type MarshalOptions struct {
+	Null NullMode
+
+	// NonFiniteAsNull encodes NaN and infinite Float32 and Float64 values, and
+	// Complex64 and Complex128 values with a NaN or infinite part, as null, in
+	// the representation chosen by Null, instead of returning an error.
+	NonFiniteAsNull bool
+
+	// FloatPlaces, if positive, encodes Float64 values with exactly that many
+	// digits after the decimal point, such as 1.50 for 1.5 with FloatPlaces 2,
+	// so that the output matches a numeric(p,s) column. Ties round to even, as
+	// in Float64.Round. Zero keeps the shortest form.
+	FloatPlaces int
+
+	// TimeInUTC converts Time and FormattedTime values to UTC before encoding
+	// them, so that the same instant always encodes the same way whatever
+	// location it carries. The stored values are not changed.
+	TimeInUTC bool
+
+	// NullText is what the MarshalTextWith methods of the integer and float
+	// types encode a null as, such as "NULL" or `\N`, so that a null can be
+	// told apart from a valid value where an empty string is ambiguous. The
+	// default empty string matches MarshalText, which suits encoding/csv.
+	// UnmarshalText only reads an empty string back as null, so a caller
+	// using a token must recognise it when decoding.
+	NullText string
+}
+
+// nullJSON returns the encoding of a null value under these options.
+func (o MarshalOptions) nullJSON() []byte {
+	switch o.Null {
+	case NullAsString:
+		return nullStringBytes
+	case NullAsOmitted:
+		return nil
+	}
+	return NullBytes
+}
+
+// marshalJSON encodes m with MarshalJSON, replacing a null result with the
+// representation chosen by these options.
+func (o MarshalOptions) marshalJSON(m json.Marshaler) ([]byte, error) {
+	data, err := m.MarshalJSON()
+	if err != nil || !bytes.Equal(data, NullBytes) {
+		return data, err
+	}
+	return o.nullJSON(), nil
+}
+
+// marshalText encodes m with MarshalText, or returns NullText if it is null.
+func (o MarshalOptions) marshalText(m encoding.TextMarshaler, valid bool) ([]byte, error) {
+	if !valid {
+		return []byte(o.NullText), nil
+	}
+	return m.MarshalText()
+}
@@ -0,0 +1,254 @@
+package null
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+type jsonWither interface {
+	MarshalJSONWith(opts MarshalOptions) ([]byte, error)
+}
+
+func TestMarshalJSONWith(t *testing.T) {
+	nulls := []jsonWither{
+		NewInt8(0, false),
+		NewString("", false),
+		NewTime(time.Time{}, false),
+		NewBytes([]byte{}, true),
+		NewNull(0, false),
+	}
+	tests := []struct {
+		opts MarshalOptions
+		want string
+	}{
+		{opts: MarshalOptions{}, want: "null"},
+		{opts: MarshalOptions{Null: NullAsJSON}, want: "null"},
+		{opts: MarshalOptions{Null: NullAsString}, want: `"null"`},
+		{opts: MarshalOptions{Null: NullAsOmitted}, want: ""},
+	}
+
+	for _, test := range tests {
+		for _, v := range nulls {
+			data, err := v.MarshalJSONWith(test.opts)
+			maybePanic(err)
+			if string(data) != test.want {
+				t.Errorf("bad %T MarshalJSONWith(%+v): %q ≠ %q", v, test.opts, data, test.want)
+			}
+		}
+	}
+
+	if data, _ := NewInt8(0, false).MarshalJSONWith(MarshalOptions{Null: NullAsOmitted}); data != nil {
+		t.Errorf("omitted null should be nil, not %#v", data)
+	}
+}
+
+func TestMarshalJSONWithValid(t *testing.T) {
+	valids := []struct {
+		v    jsonWither
+		want string
+	}{
+		{v: Int8From(-123), want: "-123"},
+		{v: StringFrom("null"), want: `"null"`},
+		{v: TimeFrom(timeValue), want: string(timeJSON)},
+		{v: NullFrom(12345), want: "12345"},
+	}
+
+	for _, opts := range []MarshalOptions{{Null: NullAsJSON}, {Null: NullAsString}, {Null: NullAsOmitted}} {
+		for _, test := range valids {
+			data, err := test.v.MarshalJSONWith(opts)
+			maybePanic(err)
+			assertJSONEquals(t, data, test.want, "valid MarshalJSONWith")
+		}
+	}
+}
+
+type jsonAppender interface {
+	json.Marshaler
+	AppendJSON(dst []byte) []byte
+}
+
+// decodeDefault returns a func that decodes data into a new N with
+// UnmarshalJSONDefault and def, returning its value as a string and whether
+// it is valid.
+func decodeDefault[T any, P interface {
+	*N
+	UnmarshalJSONDefault(data []byte, def T) error
+	ValueOrZero() T
+	IsZero() bool
+}, N any](def T) func(data []byte) (string, bool, error) {
+	return func(data []byte) (string, bool, error) {
+		var v N
+		err := P(&v).UnmarshalJSONDefault(data, def)
+		return fmt.Sprint(P(&v).ValueOrZero()), !P(&v).IsZero(), err
+	}
+}
+
+func TestUnmarshalJSONDefault(t *testing.T) {
+	tests := []struct {
+		typ                 string
+		decode              func(data []byte) (string, bool, error)
+		in, wantDef, wantIn string
+	}{
+		{"Bool", decodeDefault[bool, *Bool](false), `true`, "false", "true"},
+		{"Byte", decodeDefault[byte, *Byte]('d'), `"a"`, "100", "97"},
+		{"Float32", decodeDefault[float32, *Float32](1.5), `-2.5`, "1.5", "-2.5"},
+		{"Float64", decodeDefault[float64, *Float64](1.5), `-2.5`, "1.5", "-2.5"},
+		{"Int", decodeDefault[int, *Int](7), `-3`, "7", "-3"},
+		{"Int8", decodeDefault[int8, *Int8](7), `-3`, "7", "-3"},
+		{"Int16", decodeDefault[int16, *Int16](7), `-3`, "7", "-3"},
+		{"Int32", decodeDefault[int32, *Int32](7), `-3`, "7", "-3"},
+		{"Int64", decodeDefault[int64, *Int64](7), `-3`, "7", "-3"},
+		{"Uint", decodeDefault[uint, *Uint](7), `3`, "7", "3"},
+		{"Uint8", decodeDefault[uint8, *Uint8](7), `3`, "7", "3"},
+		{"Uint16", decodeDefault[uint16, *Uint16](7), `3`, "7", "3"},
+		{"Uint32", decodeDefault[uint32, *Uint32](7), `3`, "7", "3"},
+		{"Uint64", decodeDefault[uint64, *Uint64](7), `3`, "7", "3"},
+		{"String", decodeDefault[string, *String]("default"), `"value"`, "default", "value"},
+		{"Null[int]", decodeDefault[int, *Null[int]](7), `3`, "7", "3"},
+	}
+	for _, test := range tests {
+		v, valid, err := test.decode(nullJSON)
+		maybePanic(err)
+		if !valid || v != test.wantDef {
+			t.Errorf("%s: null should give the valid default %s, got %s (valid: %v)", test.typ, test.wantDef, v, valid)
+		}
+
+		v, valid, err = test.decode([]byte(test.in))
+		maybePanic(err)
+		if !valid || v != test.wantIn {
+			t.Errorf("%s: %s should be unaffected by the default, got %s (valid: %v)", test.typ, test.in, v, valid)
+		}
+
+		if _, _, err := test.decode([]byte(`{}`)); err == nil {
+			t.Errorf("%s: expected error", test.typ)
+		}
+	}
+
+	var ti Time
+	err := ti.UnmarshalJSONDefault(nullJSON, timeValue)
+	maybePanic(err)
+	if !ti.Valid || !ti.Time.Equal(timeValue) {
+		t.Errorf("bad Time UnmarshalJSONDefault() of null: %#v", ti)
+	}
+}
+
+func TestAppendJSON(t *testing.T) {
+	values := []jsonAppender{
+		BoolFrom(true), BoolFrom(false), NewBool(false, false),
+		IntFrom(-1), NewInt(0, false),
+		Int8From(math.MinInt8), NewInt8(0, false),
+		Int16From(math.MaxInt16), NewInt16(0, false),
+		Int32From(math.MinInt32), NewInt32(0, false),
+		Int64From(math.MaxInt64), NewInt64(0, false),
+		UintFrom(7), NewUint(0, false),
+		Uint8From(math.MaxUint8), NewUint8(0, false),
+		Uint16From(math.MaxUint16), NewUint16(0, false),
+		Uint32From(math.MaxUint32), NewUint32(0, false),
+		Uint64From(math.MaxUint64), NewUint64(0, false),
+		StringFrom("a \"quoted\" <string>"), NewString("", false),
+	}
+	for _, v := range values {
+		want, err := v.MarshalJSON()
+		maybePanic(err)
+		data := v.AppendJSON([]byte("prefix:"))
+		if string(data) != "prefix:"+string(want) {
+			t.Errorf("bad %T AppendJSON(): %s ≠ prefix:%s", v, data, want)
+		}
+	}
+
+	for _, f := range []Float64{Float64From(-1.5), Float64From(1e21), NewFloat64(0, false)} {
+		want, err := f.MarshalJSON()
+		maybePanic(err)
+		data, err := f.AppendJSON([]byte("prefix:"))
+		maybePanic(err)
+		if string(data) != "prefix:"+string(want) {
+			t.Errorf("bad Float64 AppendJSON(): %s ≠ prefix:%s", data, want)
+		}
+	}
+	for _, f := range []Float32{Float32From(-1.5), NewFloat32(0, false)} {
+		want, err := f.MarshalJSON()
+		maybePanic(err)
+		data, err := f.AppendJSON([]byte("prefix:"))
+		maybePanic(err)
+		if string(data) != "prefix:"+string(want) {
+			t.Errorf("bad Float32 AppendJSON(): %s ≠ prefix:%s", data, want)
+		}
+	}
+
+	if _, err := Float64From(math.NaN()).AppendJSON(nil); err == nil {
+		t.Error("expected error for NaN")
+	}
+	if _, err := Float32From(float32(math.Inf(1))).AppendJSON(nil); err == nil {
+		t.Error("expected error for Inf")
+	}
+}
+
+type textWither interface {
+	MarshalText() ([]byte, error)
+	MarshalTextWith(opts MarshalOptions) ([]byte, error)
+}
+
+func TestMarshalTextWith(t *testing.T) {
+	nulls := []textWither{
+		NewInt(1, false), NewInt8(1, false), NewInt16(1, false), NewInt32(1, false), NewInt64(1, false),
+		NewUint(1, false), NewUint8(1, false), NewUint16(1, false), NewUint32(1, false), NewUint64(1, false),
+		NewFloat32(1, false), NewFloat64(1, false),
+	}
+	for _, v := range nulls {
+		data, err := v.MarshalTextWith(MarshalOptions{})
+		maybePanic(err)
+		assertJSONEquals(t, data, "", fmt.Sprintf("%T default", v))
+
+		data, err = v.MarshalTextWith(MarshalOptions{NullText: `\N`})
+		maybePanic(err)
+		assertJSONEquals(t, data, `\N`, fmt.Sprintf("%T NullText", v))
+	}
+
+	valids := []textWither{
+		IntFrom(0), Int8From(-1), Int16From(2), Int32From(3), Int64From(0),
+		UintFrom(0), Uint8From(5), Uint16From(6), Uint32From(7), Uint64From(0),
+		Float32From(0), Float64From(-1.5),
+	}
+	for _, v := range valids {
+		want, err := v.MarshalText()
+		maybePanic(err)
+		data, err := v.MarshalTextWith(MarshalOptions{NullText: "NULL"})
+		maybePanic(err)
+		assertJSONEquals(t, data, string(want), fmt.Sprintf("%T valid", v))
+	}
+}
+
+func TestTextNullRoundTrip(t *testing.T) {
+	// under the default, a null encodes as an empty string and decodes back as null
+	values := []interface {
+		encoding.TextUnmarshaler
+		IsZero() bool
+	}{
+		&Int{Int: 1, Valid: true}, &Int8{Int8: 1, Valid: true}, &Int16{Int16: 1, Valid: true},
+		&Int32{Int32: 1, Valid: true}, &Int64{Int64: 1, Valid: true},
+		&Uint{Uint: 1, Valid: true}, &Uint8{Uint8: 1, Valid: true}, &Uint16{Uint16: 1, Valid: true},
+		&Uint32{Uint32: 1, Valid: true}, &Uint64{Uint64: 1, Valid: true},
+		&Float32{Float32: 1, Valid: true}, &Float64{Float64: 1, Valid: true},
+	}
+	nulls := []textWither{
+		NewInt(0, false), NewInt8(0, false), NewInt16(0, false), NewInt32(0, false), NewInt64(0, false),
+		NewUint(0, false), NewUint8(0, false), NewUint16(0, false), NewUint32(0, false), NewUint64(0, false),
+		NewFloat32(0, false), NewFloat64(0, false),
+	}
+	for i, v := range values {
+		data, err := nulls[i].MarshalText()
+		maybePanic(err)
+		if len(data) != 0 {
+			t.Errorf("%T null MarshalText() should be empty, got %q", nulls[i], data)
+		}
+		err = v.UnmarshalText(data)
+		maybePanic(err)
+		if !v.IsZero() {
+			t.Errorf("%T should be null after UnmarshalText of an empty string", v)
+		}
+	}
+}
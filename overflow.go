@@ -0,0 +1,120 @@
+package null
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// OverflowError is returned when a number does not fit in the null type it is
+// decoded, scanned or set into, so that callers can detect it with errors.As
+// whichever way the number arrived.
+type OverflowError struct {
+	// Type is the null type, such as "Int8".
+	Type string
+	// Value is the number that did not fit, as it was given.
+	Value string
+	// Bound is the limit Value is past: the largest value of Type, or the
+	// smallest if Value is negative.
+	Bound string
+
+	// json is set for a number read from JSON, reported in the same words as
+	// the other UnmarshalJSON errors
+	json bool
+}
+
+// Error implements error.
+func (e *OverflowError) Error() string {
+	if !e.json {
+		return fmt.Sprintf("null: %s overflows null.%s", e.Value, e.Type)
+	}
+	if strings.HasPrefix(e.Value, "-") {
+		return fmt.Sprintf("json: %s underflows min %s value", e.Value, strings.ToLower(e.Type))
+	}
+	return fmt.Sprintf("json: %s overflows max %s value", e.Value, strings.ToLower(e.Type))
+}
+
+// fromJSON marks e as coming from UnmarshalJSON and returns it.
+func (e *OverflowError) fromJSON() *OverflowError {
+	e.json = true
+	return e
+}
+
+// intOverflow returns the OverflowError for s, a number outside the range of
+// a signed integer of the given bit size.
+func intOverflow(typ, s string, bits int) *OverflowError {
+	bound := int64(math.MaxInt64 >> (64 - bits))
+	if strings.HasPrefix(s, "-") {
+		bound = math.MinInt64 >> (64 - bits)
+	}
+	return &OverflowError{Type: typ, Value: s, Bound: strconv.FormatInt(bound, 10)}
+}
+
+// uintOverflow is like intOverflow, for an unsigned integer. The bound of a
+// negative number is zero.
+func uintOverflow(typ, s string, bits int) *OverflowError {
+	bound := "0"
+	if !strings.HasPrefix(s, "-") {
+		bound = strconv.FormatUint(math.MaxUint64>>(64-bits), 10)
+	}
+	return &OverflowError{Type: typ, Value: s, Bound: bound}
+}
+
+// floatOverflow is like intOverflow, for a float whose magnitude is too large.
+func floatOverflow(typ, s string, bits int) *OverflowError {
+	max := math.MaxFloat64
+	if bits == 32 {
+		max = math.MaxFloat32
+	}
+	if strings.HasPrefix(s, "-") {
+		max = -max
+	}
+	return &OverflowError{Type: typ, Value: s, Bound: strconv.FormatFloat(max, 'g', -1, bits)}
+}
+
+// textOverflow returns err from strconv parsing text for the null type typ,
+// or an OverflowError in its place if text is out of range for dest, a
+// pointer to the numeric field being set. A negative integer is out of range
+// for an unsigned dest, though strconv.ParseUint reports it as a syntax error.
+func textOverflow(typ string, dest interface{}, text string, err error) error {
+	if errors.Is(err, strconv.ErrSyntax) && isNegativeInteger(text) {
+		if t := reflect.TypeOf(dest).Elem(); t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64 {
+			return uintOverflow(typ, text, t.Bits())
+		}
+	}
+	if !errors.Is(err, strconv.ErrRange) {
+		return err
+	}
+	if o := overflowFor(typ, dest, text); o != nil {
+		return o
+	}
+	return err
+}
+
+// isNegativeInteger reports whether s is a negative base 10 integer, of any
+// size, as strconv.ParseInt reads one.
+func isNegativeInteger(s string) bool {
+	if !strings.HasPrefix(s, "-") {
+		return false
+	}
+	_, err := strconv.ParseInt(s, 10, 64)
+	return err == nil || errors.Is(err, strconv.ErrRange)
+}
+
+// overflowFor returns the OverflowError for s in the null type typ, whose
+// numeric field dest points to, or nil if dest is not a number.
+func overflowFor(typ string, dest interface{}, s string) *OverflowError {
+	t := reflect.TypeOf(dest).Elem()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return intOverflow(typ, s, t.Bits())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return uintOverflow(typ, s, t.Bits())
+	case reflect.Float32, reflect.Float64:
+		return floatOverflow(typ, s, t.Bits())
+	}
+	return nil
+}
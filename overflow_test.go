@@ -0,0 +1,68 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestOverflowError(t *testing.T) {
+	tests := []struct {
+		name              string
+		err               error
+		typ, value, bound string
+		message           string
+	}{
+		{"Int8 json", json.Unmarshal([]byte(`128`), new(Int8)), "Int8", "128", "127", "json: 128 overflows max int8 value"},
+		{"Int8 json negative", json.Unmarshal([]byte(`"-129"`), new(Int8)), "Int8", "-129", "-128", "json: -129 underflows min int8 value"},
+		{"Int64 json", json.Unmarshal([]byte(`9223372036854775808`), new(Int64)), "Int64", "9223372036854775808", "9223372036854775807", "json: 9223372036854775808 overflows max int64 value"},
+		{"Uint16 json", json.Unmarshal([]byte(`65536`), new(Uint16)), "Uint16", "65536", "65535", "json: 65536 overflows max uint16 value"},
+		{"Uint16 json negative", json.Unmarshal([]byte(`-1`), new(Uint16)), "Uint16", "-1", "0", "json: -1 underflows min uint16 value"},
+		{"Float32 json", json.Unmarshal([]byte(`1e39`), new(Float32)), "Float32", "1e39", "3.4028235e+38", "json: 1e39 overflows max float32 value"},
+		{"Float64 json negative", json.Unmarshal([]byte(`-1e309`), new(Float64)), "Float64", "-1e309", "-1.7976931348623157e+308", "json: -1e309 underflows min float64 value"},
+		{"Int32 text", new(Int32).UnmarshalText([]byte("2147483648")), "Int32", "2147483648", "2147483647", "null: 2147483648 overflows null.Int32"},
+		{"Uint64 text", new(Uint64).UnmarshalText([]byte("18446744073709551616")), "Uint64", "18446744073709551616", "18446744073709551615", "null: 18446744073709551616 overflows null.Uint64"},
+		{"Uint16 text negative", new(Uint16).UnmarshalText([]byte("-1")), "Uint16", "-1", "0", "null: -1 overflows null.Uint16"},
+		{"Float32 text", new(Float32).UnmarshalText([]byte("-1e39")), "Float32", "-1e39", "-3.4028235e+38", "null: -1e39 overflows null.Float32"},
+		{"Int8 binary", new(Int8).UnmarshalBinary(mustMarshalBinary(Int16From(300))), "Int8", "300", "127", "null: 300 overflows null.Int8"},
+		{"Uint16 SetChecked", new(Uint16).SetChecked(70000), "Uint16", "70000", "65535", "null: 70000 overflows null.Uint16"},
+		{"Int16 SetChecked", new(Int16).SetChecked(-40000), "Int16", "-40000", "-32768", "null: -40000 overflows null.Int16"},
+		{"Int8 scan", new(Int8).Scan(int64(200)), "Int8", "200", "127", "null.Int8.Scan: cannot scan int64: null: 200 overflows null.Int8"},
+		{"Uint32 scan", new(Uint32).Scan("4294967296"), "Uint32", "4294967296", "4294967295", "null.Uint32.Scan: cannot scan string: null: 4294967296 overflows null.Uint32"},
+		{"Uint8 scan negative", new(Uint8).Scan("-1"), "Uint8", "-1", "0", "null.Uint8.Scan: cannot scan string: null: -1 overflows null.Uint8"},
+		{"Uint64 scan json.Number negative", new(Uint64).Scan(json.Number("-1")), "Uint64", "-1", "0", "null.Uint64.Scan: cannot scan json.Number: null: -1 overflows null.Uint64"},
+		{"Uint scan int64 negative", new(Uint).Scan(int64(-5)), "Uint", "-5", "0", "null.Uint.Scan: cannot scan int64: null: -5 overflows null.Uint"},
+		{"Null scan", new(Null[int8]).Scan(int64(-200)), "Null", "-200", "-128", "null.Null.Scan: cannot scan int64: null: -200 overflows null.Null"},
+	}
+	for _, test := range tests {
+		var o *OverflowError
+		if !errors.As(test.err, &o) {
+			t.Errorf("%s: expected an *OverflowError, got %T: %v", test.name, test.err, test.err)
+			continue
+		}
+		if o.Type != test.typ || o.Value != test.value || o.Bound != test.bound {
+			t.Errorf("%s: bad OverflowError: %+v", test.name, *o)
+		}
+		if test.err.Error() != test.message {
+			t.Errorf("%s: bad message: %q, want %q", test.name, test.err.Error(), test.message)
+		}
+	}
+
+	for _, err := range []error{
+		json.Unmarshal([]byte(`1.5`), new(Int8)),
+		new(Int8).UnmarshalText([]byte("abc")),
+		new(Uint8).Scan("-1.5"),
+		new(Int8).Scan("abc"),
+	} {
+		var o *OverflowError
+		if errors.As(err, &o) {
+			t.Errorf("%v should not be an OverflowError", err)
+		}
+	}
+}
+
+func mustMarshalBinary(v interface{ MarshalBinary() ([]byte, error) }) []byte {
+	data, err := v.MarshalBinary()
+	maybePanic(err)
+	return data
+}
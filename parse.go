@@ -0,0 +1,33 @@
+package null
+
+import "fmt"
+
+// textParser is a pointer to a null type that can be parsed from its text form.
+type textParser[T any] interface {
+	*T
+	UnmarshalText(text []byte) error
+	IsNull() bool
+}
+
+// parseText parses s with the UnmarshalText method of the null type T, named
+// typ in errors, and returns an error rather than a null value if s is empty
+// or reads as null, such as the all-zeros UUID.
+func parseText[T any, P textParser[T]](typ, s string) (T, error) {
+	var v T
+	if err := P(&v).UnmarshalText([]byte(s)); err != nil {
+		return v, err
+	}
+	if P(&v).IsNull() {
+		return v, fmt.Errorf("null: cannot parse %q as a valid null.%s", s, typ)
+	}
+	return v, nil
+}
+
+// mustParse returns v, and panics with err if it is not nil. It backs the
+// MustX constructors, such as MustTime, named fn in the panic.
+func mustParse[T any](fn, s string, v T, err error) T {
+	if err != nil {
+		panic(fmt.Sprintf("null.%s(%q): %v", fn, s, err))
+	}
+	return v
+}
@@ -0,0 +1,100 @@
+package null
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMust(t *testing.T) {
+	tests := []struct {
+		name string
+		got  interface{ String() string }
+		want string
+	}{
+		{"MustTime", MustTime("2020-01-01T00:00:00Z"), "2020-01-01T00:00:00Z"},
+		{"MustDate", MustDate("2020-01-31"), "2020-01-31"},
+		{"MustUUID", MustUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"MustIP", MustIP("192.0.2.1"), "192.0.2.1"},
+		{"MustCIDR", MustCIDR("192.0.2.0/24"), "192.0.2.0/24"},
+		{"MustMAC", MustMAC("00:00:5e:00:53:01"), "00:00:5e:00:53:01"},
+		{"MustURL", MustURL("https://example.com/"), "https://example.com/"},
+		{"MustDecimal", MustDecimal("12.34"), "12.34"},
+		{"MustBigFloat", MustBigFloat("1.25"), "1.25"},
+		{"MustBigInt", MustBigInt("12345678901234567890"), "12345678901234567890"},
+		{"MustBigRat", MustBigRat("2/6"), "1/3"},
+		{"MustDuration", MustDuration("1h30m"), "1h30m0s"},
+		{"MustEnum", MustEnum[color]("green"), "green"},
+	}
+	for _, test := range tests {
+		if test.got.String() != test.want {
+			t.Errorf("bad %s(): %s ≠ %s", test.name, test.got, test.want)
+		}
+		if test.got.(interface{ IsNull() bool }).IsNull() {
+			t.Errorf("%s() should be valid", test.name)
+		}
+	}
+
+	if !MustTime("2020-01-01T00:00:00Z").Time.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("bad MustTime() instant")
+	}
+}
+
+func TestMustPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"MustTime", func() { MustTime("2020-13-01") }},
+		{"MustTime", func() { MustTime("") }},
+		{"MustDate", func() { MustDate("2020-01-01T00:00:00Z") }},
+		{"MustUUID", func() { MustUUID("not-a-uuid") }},
+		{"MustUUID", func() { MustUUID("00000000-0000-0000-0000-000000000000") }},
+		{"MustIP", func() { MustIP("192.0.2") }},
+		{"MustCIDR", func() { MustCIDR("192.0.2.0/33") }},
+		{"MustMAC", func() { MustMAC("00:00:5e") }},
+		{"MustURL", func() { MustURL("http://[::1") }},
+		{"MustDecimal", func() { MustDecimal("1.2.3") }},
+		{"MustBigFloat", func() { MustBigFloat("1,5") }},
+		{"MustBigInt", func() { MustBigInt("12a") }},
+		{"MustBigRat", func() { MustBigRat("1/0") }},
+		{"MustDuration", func() { MustDuration("90 minutes") }},
+		{"MustEnum", func() { MustEnum[color]("purple") }},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				r := recover()
+				msg, ok := r.(string)
+				if !ok || !strings.HasPrefix(msg, "null."+test.name+"(") {
+					t.Errorf("bad %s() panic: %v", test.name, r)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+func TestParse(t *testing.T) {
+	ip, err := ParseIP("192.0.2.1")
+	maybePanic(err)
+	if !ip.Valid || ip.String() != "192.0.2.1" {
+		t.Errorf("bad ParseIP(): %#v", ip)
+	}
+
+	_, err = ParseTime("")
+	if err == nil || err.Error() != `null: cannot parse "" as a valid null.Time` {
+		t.Errorf("bad ParseTime(\"\") error: %v", err)
+	}
+
+	// UnmarshalText still makes empty text null
+	var tm Time
+	maybePanic(tm.UnmarshalText(nil))
+	if tm.Valid {
+		t.Error("blank text should unmarshal to a null Time")
+	}
+
+	if _, err := ParseDuration("soon"); err == nil {
+		t.Error("expected error")
+	}
+}
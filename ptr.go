@@ -0,0 +1,347 @@
+package null
+
+import (
+	"net"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// The functions in this file convert between the types in this package and
+// optional pointer fields, such as those in generated protobuf structs. They
+// are the same as the Ptr methods and FromPtr constructors, but can be
+// passed as function values.
+
+// BoolPtr returns a pointer to the value of b, or nil if it is null.
+func BoolPtr(b Bool) *bool {
+	return b.Ptr()
+}
+
+// PtrBool returns a Bool that is null if p is nil, like BoolFromPtr.
+func PtrBool(p *bool) Bool {
+	return BoolFromPtr(p)
+}
+
+// BytePtr returns a pointer to the value of b, or nil if it is null.
+func BytePtr(b Byte) *byte {
+	return b.Ptr()
+}
+
+// PtrByte returns a Byte that is null if p is nil, like ByteFromPtr.
+func PtrByte(p *byte) Byte {
+	return ByteFromPtr(p)
+}
+
+// BytesPtr returns a pointer to the value of b, or nil if it is null.
+func BytesPtr(b Bytes) *[]byte {
+	return b.Ptr()
+}
+
+// PtrBytes returns a Bytes that is null if p is nil, like BytesFromPtr.
+func PtrBytes(p *[]byte) Bytes {
+	return BytesFromPtr(p)
+}
+
+// DatePtr returns a pointer to the value of d, or nil if it is null.
+func DatePtr(d Date) *time.Time {
+	return d.Ptr()
+}
+
+// PtrDate returns a Date that is null if p is nil, like DateFromPtr.
+func PtrDate(p *time.Time) Date {
+	return DateFromPtr(p)
+}
+
+// DecimalPtr returns a pointer to the value of d, or nil if it is null.
+func DecimalPtr(d Decimal) *decimal.Decimal {
+	return d.Ptr()
+}
+
+// PtrDecimal returns a Decimal that is null if p is nil, like DecimalFromPtr.
+func PtrDecimal(p *decimal.Decimal) Decimal {
+	return DecimalFromPtr(p)
+}
+
+// DurationPtr returns a pointer to the value of d, or nil if it is null.
+func DurationPtr(d Duration) *time.Duration {
+	return d.Ptr()
+}
+
+// PtrDuration returns a Duration that is null if p is nil, like DurationFromPtr.
+func PtrDuration(p *time.Duration) Duration {
+	return DurationFromPtr(p)
+}
+
+// Float32Ptr returns a pointer to the value of f, or nil if it is null.
+func Float32Ptr(f Float32) *float32 {
+	return f.Ptr()
+}
+
+// PtrFloat32 returns a Float32 that is null if p is nil, like Float32FromPtr.
+func PtrFloat32(p *float32) Float32 {
+	return Float32FromPtr(p)
+}
+
+// Float64Ptr returns a pointer to the value of f, or nil if it is null.
+func Float64Ptr(f Float64) *float64 {
+	return f.Ptr()
+}
+
+// PtrFloat64 returns a Float64 that is null if p is nil, like Float64FromPtr.
+func PtrFloat64(p *float64) Float64 {
+	return Float64FromPtr(p)
+}
+
+// IPPtr returns a pointer to the value of i, or nil if it is null.
+func IPPtr(i IP) *net.IP {
+	return i.Ptr()
+}
+
+// PtrIP returns a IP that is null if p is nil, like IPFromPtr.
+func PtrIP(p *net.IP) IP {
+	return IPFromPtr(p)
+}
+
+// IntPtr returns a pointer to the value of i, or nil if it is null.
+func IntPtr(i Int) *int {
+	return i.Ptr()
+}
+
+// PtrInt returns a Int that is null if p is nil, like IntFromPtr.
+func PtrInt(p *int) Int {
+	return IntFromPtr(p)
+}
+
+// IntBoolPtr returns a pointer to the value of b, or nil if it is null.
+func IntBoolPtr(b IntBool) *bool {
+	return b.Ptr()
+}
+
+// PtrIntBool returns an IntBool that is null if p is nil, like IntBoolFromPtr.
+func PtrIntBool(p *bool) IntBool {
+	return IntBoolFromPtr(p)
+}
+
+// Int8Ptr returns a pointer to the value of i, or nil if it is null.
+func Int8Ptr(i Int8) *int8 {
+	return i.Ptr()
+}
+
+// PtrInt8 returns a Int8 that is null if p is nil, like Int8FromPtr.
+func PtrInt8(p *int8) Int8 {
+	return Int8FromPtr(p)
+}
+
+// Int16Ptr returns a pointer to the value of i, or nil if it is null.
+func Int16Ptr(i Int16) *int16 {
+	return i.Ptr()
+}
+
+// PtrInt16 returns a Int16 that is null if p is nil, like Int16FromPtr.
+func PtrInt16(p *int16) Int16 {
+	return Int16FromPtr(p)
+}
+
+// Int32Ptr returns a pointer to the value of i, or nil if it is null.
+func Int32Ptr(i Int32) *int32 {
+	return i.Ptr()
+}
+
+// PtrInt32 returns a Int32 that is null if p is nil, like Int32FromPtr.
+func PtrInt32(p *int32) Int32 {
+	return Int32FromPtr(p)
+}
+
+// Int64Ptr returns a pointer to the value of i, or nil if it is null.
+func Int64Ptr(i Int64) *int64 {
+	return i.Ptr()
+}
+
+// PtrInt64 returns a Int64 that is null if p is nil, like Int64FromPtr.
+func PtrInt64(p *int64) Int64 {
+	return Int64FromPtr(p)
+}
+
+// JSONPtr returns a pointer to the value of j, or nil if it is null.
+func JSONPtr(j JSON) *[]byte {
+	return j.Ptr()
+}
+
+// PtrJSON returns a JSON that is null if p is nil, like JSONFromPtr.
+func PtrJSON(p *[]byte) JSON {
+	return JSONFromPtr(p)
+}
+
+// LooseStringPtr returns a pointer to the value of l, or nil if it is null.
+func LooseStringPtr(l LooseString) *string {
+	return l.Ptr()
+}
+
+// PtrLooseString returns a LooseString that is null if p is nil, like LooseStringFromPtr.
+func PtrLooseString(p *string) LooseString {
+	return LooseStringFromPtr(p)
+}
+
+// RunePtr returns a pointer to the value of r, or nil if it is null.
+func RunePtr(r Rune) *rune {
+	return r.Ptr()
+}
+
+// PtrRune returns a Rune that is null if p is nil, like RuneFromPtr.
+func PtrRune(p *rune) Rune {
+	return RuneFromPtr(p)
+}
+
+// StringPtr returns a pointer to the value of s, or nil if it is null.
+func StringPtr(s String) *string {
+	return s.Ptr()
+}
+
+// PtrString returns a String that is null if p is nil, like StringFromPtr.
+func PtrString(p *string) String {
+	return StringFromPtr(p)
+}
+
+// StringFromPtrPtr returns a String that is null if pp is nil or points to
+// nil, for a doubly optional **string field.
+func StringFromPtrPtr(pp **string) String {
+	return StringFromPtr(FlattenPtr(pp))
+}
+
+// StringPtrPtr returns a pointer to a pointer to the value of s, or a pointer
+// to nil if it is null. It is the inverse of StringFromPtrPtr.
+func StringPtrPtr(s String) **string {
+	return NestPtr(s.Ptr())
+}
+
+// StringSlicePtr returns a pointer to the value of s, or nil if it is null.
+func StringSlicePtr(s StringSlice) *[]string {
+	return s.Ptr()
+}
+
+// PtrStringSlice returns a StringSlice that is null if p is nil, like StringSliceFromPtr.
+func PtrStringSlice(p *[]string) StringSlice {
+	return StringSliceFromPtr(p)
+}
+
+// TextBytesPtr returns a pointer to the value of t, or nil if it is null.
+func TextBytesPtr(t TextBytes) *[]byte {
+	return t.Ptr()
+}
+
+// PtrTextBytes returns a TextBytes that is null if p is nil, like TextBytesFromPtr.
+func PtrTextBytes(p *[]byte) TextBytes {
+	return TextBytesFromPtr(p)
+}
+
+// TimePtr returns a pointer to the value of t, or nil if it is null.
+func TimePtr(t Time) *time.Time {
+	return t.Ptr()
+}
+
+// PtrTime returns a Time that is null if p is nil, like TimeFromPtr.
+func PtrTime(p *time.Time) Time {
+	return TimeFromPtr(p)
+}
+
+// TimeStringPtr returns a pointer to the value of t, or nil if it is null.
+func TimeStringPtr(t TimeString) *time.Time {
+	return t.Ptr()
+}
+
+// PtrTimeString returns a TimeString that is null if p is nil, like TimeStringFromPtr.
+func PtrTimeString(p *time.Time) TimeString {
+	return TimeStringFromPtr(p)
+}
+
+// TrimmedStringPtr returns a pointer to the value of t, or nil if it is null.
+func TrimmedStringPtr(t TrimmedString) *string {
+	return t.Ptr()
+}
+
+// PtrTrimmedString returns a TrimmedString that is null if p is nil, like TrimmedStringFromPtr.
+func PtrTrimmedString(p *string) TrimmedString {
+	return TrimmedStringFromPtr(p)
+}
+
+// UUIDPtr returns a pointer to the value of u, or nil if it is null.
+func UUIDPtr(u UUID) *uuid.UUID {
+	return u.Ptr()
+}
+
+// PtrUUID returns a UUID that is null if p is nil, like UUIDFromPtr.
+func PtrUUID(p *uuid.UUID) UUID {
+	return UUIDFromPtr(p)
+}
+
+// UintPtr returns a pointer to the value of u, or nil if it is null.
+func UintPtr(u Uint) *uint {
+	return u.Ptr()
+}
+
+// PtrUint returns a Uint that is null if p is nil, like UintFromPtr.
+func PtrUint(p *uint) Uint {
+	return UintFromPtr(p)
+}
+
+// Uint8Ptr returns a pointer to the value of u, or nil if it is null.
+func Uint8Ptr(u Uint8) *uint8 {
+	return u.Ptr()
+}
+
+// PtrUint8 returns a Uint8 that is null if p is nil, like Uint8FromPtr.
+func PtrUint8(p *uint8) Uint8 {
+	return Uint8FromPtr(p)
+}
+
+// Uint16Ptr returns a pointer to the value of u, or nil if it is null.
+func Uint16Ptr(u Uint16) *uint16 {
+	return u.Ptr()
+}
+
+// PtrUint16 returns a Uint16 that is null if p is nil, like Uint16FromPtr.
+func PtrUint16(p *uint16) Uint16 {
+	return Uint16FromPtr(p)
+}
+
+// Uint32Ptr returns a pointer to the value of u, or nil if it is null.
+func Uint32Ptr(u Uint32) *uint32 {
+	return u.Ptr()
+}
+
+// PtrUint32 returns a Uint32 that is null if p is nil, like Uint32FromPtr.
+func PtrUint32(p *uint32) Uint32 {
+	return Uint32FromPtr(p)
+}
+
+// Uint64Ptr returns a pointer to the value of u, or nil if it is null.
+func Uint64Ptr(u Uint64) *uint64 {
+	return u.Ptr()
+}
+
+// PtrUint64 returns a Uint64 that is null if p is nil, like Uint64FromPtr.
+func PtrUint64(p *uint64) Uint64 {
+	return Uint64FromPtr(p)
+}
+
+// FlattenPtr returns the pointer pp points to, or nil if pp is nil, so that a
+// doubly optional **T field, as some code generators emit, can be passed to
+// a FromPtr constructor:
+//
+//	count := null.Int64FromPtr(null.FlattenPtr(msg.Count))
+//
+// Both nil and a pointer to nil become null.
+func FlattenPtr[T any](pp **T) *T {
+	if pp == nil {
+		return nil
+	}
+	return *pp
+}
+
+// NestPtr returns a pointer to p, the inverse of FlattenPtr. A nil p gives a
+// pointer to nil, which such generated code reads as a field that is present
+// and null rather than absent; leave the field nil to omit it.
+func NestPtr[T any](p *T) **T {
+	return &p
+}
@@ -0,0 +1,89 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+)
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func TestPtrConversions(t *testing.T) {
+	tests := []struct {
+		name      string
+		got, want interface{}
+		null      interface{}
+		nullValid bool
+	}{
+		{"Bool", BoolPtr(PtrBool(ptrTo(true))), true, BoolPtr(PtrBool(nil)), PtrBool(nil).Valid},
+		{"Byte", BytePtr(PtrByte(ptrTo(byte('b')))), byte('b'), BytePtr(PtrByte(nil)), PtrByte(nil).Valid},
+		{"Bytes", BytesPtr(PtrBytes(ptrTo([]byte("hello")))), []byte("hello"), BytesPtr(PtrBytes(nil)), PtrBytes(nil).Valid},
+		{"Date", DatePtr(PtrDate(ptrTo(dateValue))), dateValue, DatePtr(PtrDate(nil)), PtrDate(nil).Valid},
+		{"Decimal", DecimalPtr(PtrDecimal(ptrTo(decimalValue))), decimalValue, DecimalPtr(PtrDecimal(nil)), PtrDecimal(nil).Valid},
+		{"Duration", DurationPtr(PtrDuration(ptrTo(durationValue))), durationValue, DurationPtr(PtrDuration(nil)), PtrDuration(nil).Valid},
+		{"Float32", Float32Ptr(PtrFloat32(ptrTo(float32(1.5)))), float32(1.5), Float32Ptr(PtrFloat32(nil)), PtrFloat32(nil).Valid},
+		{"Float64", Float64Ptr(PtrFloat64(ptrTo(1.5))), 1.5, Float64Ptr(PtrFloat64(nil)), PtrFloat64(nil).Valid},
+		{"IP", IPPtr(PtrIP(ptrTo(ipValue))), ipValue, IPPtr(PtrIP(nil)), PtrIP(nil).Valid},
+		{"Int", IntPtr(PtrInt(ptrTo(12))), 12, IntPtr(PtrInt(nil)), PtrInt(nil).Valid},
+		{"IntBool", IntBoolPtr(PtrIntBool(ptrTo(true))), true, IntBoolPtr(PtrIntBool(nil)), PtrIntBool(nil).Valid},
+		{"Int8", Int8Ptr(PtrInt8(ptrTo(int8(12)))), int8(12), Int8Ptr(PtrInt8(nil)), PtrInt8(nil).Valid},
+		{"Int16", Int16Ptr(PtrInt16(ptrTo(int16(12)))), int16(12), Int16Ptr(PtrInt16(nil)), PtrInt16(nil).Valid},
+		{"Int32", Int32Ptr(PtrInt32(ptrTo(int32(12)))), int32(12), Int32Ptr(PtrInt32(nil)), PtrInt32(nil).Valid},
+		{"Int64", Int64Ptr(PtrInt64(ptrTo(int64(12)))), int64(12), Int64Ptr(PtrInt64(nil)), PtrInt64(nil).Valid},
+		{"JSON", JSONPtr(PtrJSON(ptrTo([]byte(`{}`)))), []byte(`{}`), JSONPtr(PtrJSON(nil)), PtrJSON(nil).Valid},
+		{"LooseString", LooseStringPtr(PtrLooseString(ptrTo("test"))), "test", LooseStringPtr(PtrLooseString(nil)), PtrLooseString(nil).Valid},
+		{"Rune", RunePtr(PtrRune(ptrTo('é'))), 'é', RunePtr(PtrRune(nil)), PtrRune(nil).Valid},
+		{"String", StringPtr(PtrString(ptrTo("test"))), "test", StringPtr(PtrString(nil)), PtrString(nil).Valid},
+		{"StringSlice", StringSlicePtr(PtrStringSlice(ptrTo([]string{"a", "b"}))), []string{"a", "b"}, StringSlicePtr(PtrStringSlice(nil)), PtrStringSlice(nil).Valid},
+		{"TextBytes", TextBytesPtr(PtrTextBytes(ptrTo([]byte("hello")))), []byte("hello"), TextBytesPtr(PtrTextBytes(nil)), PtrTextBytes(nil).Valid},
+		{"Time", TimePtr(PtrTime(ptrTo(timeValue))), timeValue, TimePtr(PtrTime(nil)), PtrTime(nil).Valid},
+		{"TimeString", TimeStringPtr(PtrTimeString(ptrTo(timeValue))), timeValue, TimeStringPtr(PtrTimeString(nil)), PtrTimeString(nil).Valid},
+		{"TrimmedString", TrimmedStringPtr(PtrTrimmedString(ptrTo("test"))), "test", TrimmedStringPtr(PtrTrimmedString(nil)), PtrTrimmedString(nil).Valid},
+		{"UUID", UUIDPtr(PtrUUID(ptrTo(uuidValue))), uuidValue, UUIDPtr(PtrUUID(nil)), PtrUUID(nil).Valid},
+		{"Uint", UintPtr(PtrUint(ptrTo(uint(12)))), uint(12), UintPtr(PtrUint(nil)), PtrUint(nil).Valid},
+		{"Uint8", Uint8Ptr(PtrUint8(ptrTo(uint8(12)))), uint8(12), Uint8Ptr(PtrUint8(nil)), PtrUint8(nil).Valid},
+		{"Uint16", Uint16Ptr(PtrUint16(ptrTo(uint16(12)))), uint16(12), Uint16Ptr(PtrUint16(nil)), PtrUint16(nil).Valid},
+		{"Uint32", Uint32Ptr(PtrUint32(ptrTo(uint32(12)))), uint32(12), Uint32Ptr(PtrUint32(nil)), PtrUint32(nil).Valid},
+		{"Uint64", Uint64Ptr(PtrUint64(ptrTo(uint64(12)))), uint64(12), Uint64Ptr(PtrUint64(nil)), PtrUint64(nil).Valid},
+	}
+	for _, test := range tests {
+		got := reflect.ValueOf(test.got)
+		if got.IsNil() || !reflect.DeepEqual(got.Elem().Interface(), test.want) {
+			t.Errorf("bad %s round trip: %#v ≠ %#v", test.name, test.got, test.want)
+		}
+		if !reflect.ValueOf(test.null).IsNil() {
+			t.Errorf("%s pointer should be nil, got %#v", test.name, test.null)
+		}
+		if test.nullValid {
+			t.Errorf("Ptr%s(nil) should be null", test.name)
+		}
+	}
+
+	// the value is copied, so later changes through the pointer are not seen
+	v := "test"
+	s := PtrString(&v)
+	v = "changed"
+	assertStr(t, s, "PtrString()")
+}
+
+func TestPtrPtr(t *testing.T) {
+	var nilPtr *string
+	assertNullStr(t, StringFromPtrPtr(nil), "StringFromPtrPtr(nil)")
+	assertNullStr(t, StringFromPtrPtr(&nilPtr), "StringFromPtrPtr(pointer to nil)")
+	assertStr(t, StringFromPtrPtr(ptrTo(ptrTo("test"))), "StringFromPtrPtr(pointer to value)")
+
+	if pp := StringPtrPtr(StringFrom("test")); pp == nil || *pp == nil || **pp != "test" {
+		t.Errorf("bad StringPtrPtr: %v", pp)
+	}
+	if pp := StringPtrPtr(NewString("", false)); pp == nil || *pp != nil {
+		t.Errorf("StringPtrPtr of a null String should point to nil, got %v", pp)
+	}
+	assertStr(t, StringFromPtrPtr(StringPtrPtr(StringFrom("test"))), "StringPtrPtr round trip")
+
+	assertNullInt64(t, Int64FromPtr(FlattenPtr[int64](nil)), "FlattenPtr(nil)")
+	assertInt64(t, Int64FromPtr(FlattenPtr(NestPtr(ptrTo(int64(9223372036854775806))))), "FlattenPtr(NestPtr())")
+	if n := NullFromPtr(FlattenPtr(NestPtr[int](nil))); n.Valid {
+		t.Error("NullFromPtr(FlattenPtr(pointer to nil)) should be null")
+	}
+}
@@ -0,0 +1,101 @@
+package null
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// Randomizer is implemented by every type in this package, for sqlboiler's
+// randomized test fixtures.
+type Randomizer interface {
+	Randomize(nextInt func() int64, fieldType string, shouldBeNull bool)
+}
+
+// NextIntFrom returns a nextInt func for Randomize that draws non-negative
+// values from r. Every type, String and Bytes included, takes all of its
+// randomness from nextInt, so a seeded r gives the same values on every run.
+func NextIntFrom(r *rand.Rand) func() int64 {
+	return r.Int63
+}
+
+// RandomizeWith is like v.Randomize, but draws values from r.
+func RandomizeWith(v Randomizer, r *rand.Rand, fieldType string, shouldBeNull bool) {
+	v.Randomize(NextIntFrom(r), fieldType, shouldBeNull)
+}
+
+// fieldTypeName returns the lowercased base name of the column type
+// fieldType, without any length or modifiers, such as "varchar" for
+// "VARCHAR(10)" or "int" for "int(11) unsigned".
+func fieldTypeName(fieldType string) string {
+	t := strings.ToLower(strings.TrimSpace(fieldType))
+	if i := strings.IndexAny(t, "( "); i >= 0 {
+		t = t[:i]
+	}
+	return t
+}
+
+// fieldTypeBits returns the width in bits of the integer column type
+// fieldType, or 0 if it is not a known integer type.
+func fieldTypeBits(fieldType string) int {
+	switch fieldTypeName(fieldType) {
+	case "tinyint":
+		return 8
+	case "smallint", "int2", "smallserial", "serial2":
+		return 16
+	case "mediumint":
+		return 24
+	case "int", "integer", "int4", "serial", "serial4":
+		return 32
+	case "bigint", "int8", "bigserial", "serial8":
+		return 64
+	}
+	return 0
+}
+
+// fieldTypeLength returns the maximum length of the character column type
+// fieldType, such as 10 for "varchar(10)", or 0 if it has none.
+func fieldTypeLength(fieldType string) int {
+	if !strings.Contains(fieldTypeName(fieldType), "char") {
+		return 0
+	}
+	start, end := strings.IndexByte(fieldType, '('), strings.IndexByte(fieldType, ')')
+	if start < 0 || end < start {
+		return 0
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(fieldType[start+1 : end]))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// randomInt returns the next value of nextInt wrapped into a signed integer of
+// the given width in bits, or of the width of fieldType if that is narrower.
+// Consecutive values of nextInt cycle through the whole range, negatives
+// included.
+func randomInt(nextInt func() int64, fieldType string, bits int) int64 {
+	v, shift := randomBits(nextInt, fieldType, bits)
+	return int64(v<<shift) >> shift
+}
+
+// randomUint is like randomInt, for unsigned integers.
+func randomUint(nextInt func() int64, fieldType string, bits int) uint64 {
+	v, shift := randomBits(nextInt, fieldType, bits)
+	return v << shift >> shift
+}
+
+// randomBits returns random bits for randomInt and randomUint, along with the
+// shift that keeps the low bits of the width in use. nextInt is never
+// negative, so its values only have 63 random bits; for 64-bit integers a
+// second value is mixed in to fill the top bit too.
+func randomBits(nextInt func() int64, fieldType string, bits int) (uint64, int) {
+	if n := fieldTypeBits(fieldType); n != 0 && n < bits {
+		bits = n
+	}
+	v := uint64(nextInt())
+	if bits == 64 {
+		v = v<<1 ^ uint64(nextInt())
+	}
+	return v, 64 - bits
+}
@@ -0,0 +1,212 @@
+package null
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// counter returns a nextInt func like sqlboiler's seed, counting up from start.
+func counter(start int64) func() int64 {
+	n := start
+	return func() int64 {
+		n++
+		return n
+	}
+}
+
+func TestFieldTypeBits(t *testing.T) {
+	tests := map[string]int{
+		"tinyint":          8,
+		"smallint":         16,
+		"SMALLINT":         16,
+		"int2":             16,
+		"mediumint":        24,
+		"int(11)":          32,
+		"integer":          32,
+		"int unsigned":     32,
+		"bigint":           64,
+		"int8":             64,
+		"varchar(10)":      0,
+		"double precision": 0,
+	}
+	for fieldType, want := range tests {
+		if got := fieldTypeBits(fieldType); got != want {
+			t.Errorf("bad fieldTypeBits(%q): %d ≠ %d", fieldType, got, want)
+		}
+	}
+}
+
+func TestRandomizeSignedRange(t *testing.T) {
+	nextInt := counter(1000)
+	var min, max int8
+	for n := 0; n < 256; n++ {
+		var i Int8
+		i.Randomize(nextInt, "", false)
+		if !i.Valid {
+			t.Fatal("Randomize() should be valid")
+		}
+		if i.Int8 < min {
+			min = i.Int8
+		}
+		if i.Int8 > max {
+			max = i.Int8
+		}
+	}
+	if min != math.MinInt8 || max != math.MaxInt8 {
+		t.Errorf("Randomize() should cover the whole int8 range, got %d to %d", min, max)
+	}
+
+	var neg bool
+	for n := 0; n < 1<<16 && !neg; n++ {
+		var i Int16
+		i.Randomize(nextInt, "", false)
+		neg = i.Int16 < 0
+	}
+	if !neg {
+		t.Error("Randomize() should produce negative int16 values")
+	}
+
+	var null Int8
+	null.Randomize(nextInt, "", true)
+	assertNullInt8(t, null, "Randomize() null")
+}
+
+func TestRandomizeUnsignedRange(t *testing.T) {
+	nextInt := counter(1000)
+	seen := make(map[uint8]bool)
+	for n := 0; n < 256; n++ {
+		var u Uint8
+		u.Randomize(nextInt, "", false)
+		seen[u.Uint8] = true
+	}
+	if len(seen) != 256 {
+		t.Errorf("Randomize() should cover the whole uint8 range, got %d values", len(seen))
+	}
+}
+
+func TestRandomizeFullWidth(t *testing.T) {
+	// nextInt is never negative, so 64-bit values need more than one draw to
+	// reach the top bit
+	nextInt := NextIntFrom(rand.New(rand.NewSource(1)))
+	var negInt64, negInt, topUint64, topUint bool
+	for n := 0; n < 1000; n++ {
+		var i Int64
+		i.Randomize(nextInt, "", false)
+		negInt64 = negInt64 || i.Int64 < 0
+
+		var in Int
+		in.Randomize(nextInt, "", false)
+		negInt = negInt || in.Int < 0
+
+		var u Uint64
+		u.Randomize(nextInt, "", false)
+		topUint64 = topUint64 || u.Uint64 > math.MaxInt64
+
+		var un Uint
+		un.Randomize(nextInt, "", false)
+		topUint = topUint || un.Uint > math.MaxInt
+	}
+	if !negInt64 || !negInt {
+		t.Errorf("Randomize() should produce negative values: Int64 %t, Int %t", negInt64, negInt)
+	}
+	if !topUint64 || !topUint {
+		t.Errorf("Randomize() should set the top bit: Uint64 %t, Uint %t", topUint64, topUint)
+	}
+}
+
+func TestRandomizeFieldTypeBounds(t *testing.T) {
+	nextInt := counter(math.MaxInt32)
+	for n := 0; n < 1000; n++ {
+		var i Int64
+		i.Randomize(nextInt, "smallint", false)
+		if i.Int64 < math.MinInt16 || i.Int64 > math.MaxInt16 {
+			t.Fatalf("Randomize() smallint out of range: %d", i.Int64)
+		}
+
+		var m Int32
+		m.Randomize(nextInt, "mediumint", false)
+		if m.Int32 < -1<<23 || m.Int32 >= 1<<23 {
+			t.Fatalf("Randomize() mediumint out of range: %d", m.Int32)
+		}
+
+		var u Uint64
+		u.Randomize(nextInt, "tinyint unsigned", false)
+		if u.Uint64 > math.MaxUint8 {
+			t.Fatalf("Randomize() tinyint unsigned out of range: %d", u.Uint64)
+		}
+	}
+}
+
+func TestRandomizeStringLength(t *testing.T) {
+	nextInt := counter(0)
+	lengths := make(map[int]bool)
+	for n := 0; n < 100; n++ {
+		var s String
+		s.Randomize(nextInt, "varchar(5)", false)
+		if !s.Valid || len(s.String) < 1 || len(s.String) > 5 {
+			t.Fatalf("Randomize() varchar(5) bad value: %q", s.String)
+		}
+		lengths[len(s.String)] = true
+	}
+	if len(lengths) < 2 {
+		t.Errorf("Randomize() varchar(5) should vary the length, got %v", lengths)
+	}
+
+	var c String
+	c.Randomize(nextInt, "character varying(1)", false)
+	if len(c.String) != 1 {
+		t.Errorf("Randomize() character varying(1) bad value: %q", c.String)
+	}
+
+	if n := fieldTypeLength("CHAR(12)"); n != 12 {
+		t.Errorf("bad fieldTypeLength(CHAR(12)): %d", n)
+	}
+	if n := fieldTypeLength("numeric(10)"); n != 0 {
+		t.Errorf("bad fieldTypeLength(numeric(10)): %d", n)
+	}
+}
+
+type randomFixture struct {
+	Bool   Bool
+	Bytes  Bytes
+	Int8   Int8
+	Int64  Int64
+	Uint32 Uint32
+	Float  Float64
+	String String
+	Short  String
+	Time   Time
+	UUID   UUID
+}
+
+func randomizeFixture(seed int64) randomFixture {
+	r := rand.New(rand.NewSource(seed))
+	var f randomFixture
+	RandomizeWith(&f.Bool, r, "", false)
+	RandomizeWith(&f.Bytes, r, "", false)
+	RandomizeWith(&f.Int8, r, "", false)
+	RandomizeWith(&f.Int64, r, "", false)
+	RandomizeWith(&f.Uint32, r, "", false)
+	RandomizeWith(&f.Float, r, "", false)
+	RandomizeWith(&f.String, r, "", false)
+	RandomizeWith(&f.Short, r, "varchar(10)", false)
+	RandomizeWith(&f.Time, r, "", false)
+	f.UUID.Randomize(NextIntFrom(r), "", false)
+	return f
+}
+
+func TestRandomizeWithSeed(t *testing.T) {
+	a, b := randomizeFixture(42), randomizeFixture(42)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("the same seed should give the same values:\n%+v\n%+v", a, b)
+	}
+	if c := randomizeFixture(43); reflect.DeepEqual(a, c) {
+		t.Errorf("different seeds should give different values: %+v", c)
+	}
+
+	var null String
+	RandomizeWith(&null, rand.New(rand.NewSource(42)), "", true)
+	assertNullStr(t, null, "RandomizeWith() null")
+}
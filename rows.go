@@ -0,0 +1,30 @@
+package null
+
+import "database/sql"
+
+// RowScanner is the Scan method shared by *sql.Rows and *sql.Row.
+type RowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// ScanAll scans the current row of rows into dest, one column per null field
+// in column order, such as:
+//
+//	var u struct {
+//		ID    null.Int64
+//		Name  null.String
+//		Email null.String
+//	}
+//	err := null.ScanAll(rows, &u.ID, &u.Name, &u.Email)
+//
+// Each field decodes its own column through its Scan method, so unlike
+// reflection based row mappers nothing walks the struct or matches column
+// names. Listing the fields in a method of the struct keeps the order in one
+// place for every query that selects the same columns.
+func ScanAll(rows RowScanner, dest ...sql.Scanner) error {
+	args := make([]interface{}, len(dest))
+	for i, d := range dest {
+		args[i] = d
+	}
+	return rows.Scan(args...)
+}
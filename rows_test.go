@@ -0,0 +1,183 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// rowsDriver is a database/sql driver whose queries return rowsDriverRows. A
+// query that is a number n returns n rows, cycling through them.
+type rowsDriver struct{}
+
+var (
+	rowsDriverColumns = []string{"id", "name", "email", "score", "active", "created"}
+	rowsDriverRows    = [][]driver.Value{
+		{int64(1), "alice", nil, 1.5, true, timeValue},
+		{int64(2), []byte("bob"), "bob@example.com", nil, nil, nil},
+	}
+)
+
+func init() {
+	sql.Register("null-rows-test", rowsDriver{})
+}
+
+func (rowsDriver) Open(string) (driver.Conn, error) { return rowsConn{}, nil }
+
+type rowsConn struct{}
+
+func (rowsConn) Prepare(query string) (driver.Stmt, error) {
+	n, err := strconv.Atoi(query)
+	if err != nil {
+		n = len(rowsDriverRows)
+	}
+	return rowsStmt{n: n}, nil
+}
+
+func (rowsConn) Close() error              { return nil }
+func (rowsConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type rowsStmt struct{ n int }
+
+func (rowsStmt) Close() error                                { return nil }
+func (rowsStmt) NumInput() int                               { return 0 }
+func (rowsStmt) Exec([]driver.Value) (driver.Result, error)  { return nil, errors.New("not supported") }
+func (s rowsStmt) Query([]driver.Value) (driver.Rows, error) { return &fakeRows{n: s.n}, nil }
+
+type fakeRows struct{ next, n int }
+
+func (r *fakeRows) Columns() []string { return rowsDriverColumns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next == r.n {
+		return io.EOF
+	}
+	copy(dest, rowsDriverRows[r.next%len(rowsDriverRows)])
+	r.next++
+	return nil
+}
+
+type scannedUser struct {
+	ID      Int64   `db:"id"`
+	Name    String  `db:"name"`
+	Email   String  `db:"email"`
+	Score   Float64 `db:"score"`
+	Active  Bool    `db:"active"`
+	Created Time    `db:"created"`
+}
+
+func (u *scannedUser) fields() []sql.Scanner {
+	return []sql.Scanner{&u.ID, &u.Name, &u.Email, &u.Score, &u.Active, &u.Created}
+}
+
+func queryRows(t testing.TB, query string) *sql.Rows {
+	db, err := sql.Open("null-rows-test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rows.Close() })
+	return rows
+}
+
+func TestScanAll(t *testing.T) {
+	rows := queryRows(t, "SELECT")
+	var users []scannedUser
+	for rows.Next() {
+		var u scannedUser
+		maybePanic(ScanAll(rows, u.fields()...))
+		users = append(users, u)
+	}
+	maybePanic(rows.Err())
+
+	want := []scannedUser{
+		{ID: Int64From(1), Name: StringFrom("alice"), Score: Float64From(1.5), Active: BoolFrom(true), Created: TimeFrom(timeValue)},
+		{ID: Int64From(2), Name: StringFrom("bob"), Email: StringFrom("bob@example.com")},
+	}
+	if len(users) != len(want) {
+		t.Fatalf("scanned %d rows, want %d", len(users), len(want))
+	}
+	for i := range want {
+		got, w := users[i], want[i]
+		if !got.ID.Equal(w.ID) || !got.Name.Equal(w.Name) || !got.Email.Equal(w.Email) ||
+			!got.Score.Equal(w.Score) || !got.Active.Equal(w.Active) || !got.Created.Equal(w.Created) {
+			t.Errorf("row %d: got %+v, want %+v", i, got, w)
+		}
+	}
+}
+
+func TestScanAllErrors(t *testing.T) {
+	rows := queryRows(t, "SELECT")
+	if !rows.Next() {
+		t.Fatal("expected a row")
+	}
+	var u scannedUser
+	if err := ScanAll(rows, &u.ID, &u.Name); err == nil || !strings.Contains(err.Error(), "expected 6 destination arguments") {
+		t.Errorf("expected a column count error, got %v", err)
+	}
+
+	// alice's name cannot be scanned into an Int64
+	var id, name Int64
+	var rest [4]String
+	err := ScanAll(rows, &id, &name, &rest[0], &rest[1], &rest[2], &rest[3])
+	if err == nil || !strings.Contains(err.Error(), "null.Int64.Scan") {
+		t.Errorf("expected a scan error from the name column, got %v", err)
+	}
+}
+
+func BenchmarkScanAll(b *testing.B) {
+	rows := queryRows(b, strconv.Itoa(b.N))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for rows.Next() {
+		var u scannedUser
+		if err := ScanAll(rows, u.fields()...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// scanReflect is a minimal reflection based row mapper, matching columns to
+// db struct tags as libraries such as sqlx do.
+func scanReflect(rows *sql.Rows, dest interface{}) error {
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	v := reflect.ValueOf(dest).Elem()
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		for j := 0; j < v.NumField(); j++ {
+			if v.Type().Field(j).Tag.Get("db") == column {
+				args[i] = v.Field(j).Addr().Interface()
+				break
+			}
+		}
+		if args[i] == nil {
+			return errors.New("no field for column " + column)
+		}
+	}
+	return rows.Scan(args...)
+}
+
+func BenchmarkScanReflect(b *testing.B) {
+	rows := queryRows(b, strconv.Itoa(b.N))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for rows.Next() {
+		var u scannedUser
+		if err := scanReflect(rows, &u); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Rune is a nullable rune, for columns holding a single character such as a
+// status flag or a grade. It marshals to and from a one character string.
+type Rune struct {
+	Rune  rune
+	Valid bool
+}
+
+// NewRune creates a new Rune
+func NewRune(r rune, valid bool) Rune {
+	return Rune{
+		Rune:  r,
+		Valid: valid,
+	}
+}
+
+// RuneFrom creates a new Rune that will always be valid.
+func RuneFrom(r rune) Rune {
+	return NewRune(r, true)
+}
+
+// RuneFromPtr creates a new Rune that will be null if r is nil.
+func RuneFromPtr(r *rune) Rune {
+	if r == nil {
+		return NewRune(0, false)
+	}
+	return NewRune(*r, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a string of exactly one character, and an empty string will be null.
+func (r *Rune) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		r.Rune = 0
+		r.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		r.Rune = 0
+		r.Valid = false
+		return err
+	}
+
+	return r.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Rune if the input is blank.
+func (r *Rune) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		r.Rune = 0
+		r.Valid = false
+		return nil
+	}
+
+	var err error
+	r.Rune, err = parseRune(string(text))
+	r.Valid = err == nil
+	return err
+}
+
+// parseRune returns the only character of s, which must not be empty.
+func parseRune(s string) (rune, error) {
+	c, size := utf8.DecodeRuneInString(s)
+	if c == utf8.RuneError && size <= 1 {
+		return 0, fmt.Errorf("null: invalid UTF-8 %q for null.Rune", s)
+	}
+	if size != len(s) {
+		return 0, fmt.Errorf("null: cannot convert %q to null.Rune, it is more than one character", s)
+	}
+	return c, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Rune is null.
+func (r Rune) MarshalJSON() ([]byte, error) {
+	if !r.Valid {
+		return NullBytes, nil
+	}
+	return appendJSONString(nil, string(r.Rune)), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Rune as chosen by opts.
+func (r Rune) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(r)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Rune is null.
+func (r Rune) MarshalText() ([]byte, error) {
+	if !r.Valid {
+		return []byte{}, nil
+	}
+	return utf8.AppendRune(nil, r.Rune), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Rune is null.
+func (r Rune) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, r, r.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (r *Rune) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, r)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Rune is null.
+func (r Rune) MarshalYAML() (interface{}, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return string(r.Rune), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (r *Rune) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return r.UnmarshalText(nil)
+	}
+	return r.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Rune is null.
+func (r Rune) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !r.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(string(r.Rune))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (r *Rune) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return r.UnmarshalText(nil)
+	}
+	return r.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Rune is encoded as a single byte.
+func (r Rune) MarshalBinary() ([]byte, error) {
+	if !r.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendVarint([]byte{encodedValid}, int64(r.Rune)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *Rune) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Rune")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		r.Rune = 0
+		r.Valid = false
+		return nil
+	}
+	x, err := decodeVarint(value, "Rune")
+	if err != nil {
+		return err
+	}
+	if int64(rune(x)) != x {
+		return intOverflow("Rune", strconv.FormatInt(x, 10), 32)
+	}
+	r.Rune = rune(x)
+	r.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (r Rune) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (r *Rune) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
+
+// SetValid changes this Rune's value and also sets it to be non-null.
+func (r *Rune) SetValid(n rune) {
+	r.Rune = n
+	r.Valid = true
+}
+
+// SetNull sets this Rune to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (r *Rune) SetNull() {
+	r.Rune = 0
+	r.Valid = false
+}
+
+// Ptr returns a pointer to this Rune's value, or a nil pointer if this Rune is null.
+func (r Rune) Ptr() *rune {
+	if !r.Valid {
+		return nil
+	}
+	return &r.Rune
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (r Rune) ValueOrZero() rune {
+	if !r.Valid {
+		return 0
+	}
+	return r.Rune
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (r Rune) ValueOr(def rune) rune {
+	if !r.Valid {
+		return def
+	}
+	return r.Rune
+}
+
+// Or returns this Rune if it is valid, otherwise other.
+func (r Rune) Or(other Rune) Rune {
+	if !r.Valid {
+		return other
+	}
+	return r
+}
+
+// MustValue returns the inner value, and panics if this Rune is null.
+func (r Rune) MustValue() rune {
+	if !r.Valid {
+		panic("null.Rune: MustValue called on invalid value")
+	}
+	return r.Rune
+}
+
+// IsZero returns true if this Rune is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (r Rune) IsZero() bool {
+	return !r.Valid
+}
+
+// IsNull returns true if this Rune is null, the same as !Valid.
+func (r Rune) IsNull() bool {
+	return !r.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Rune, or nil if it is null, for
+// a *Rune field tagged omitempty that should leave nulls out.
+func (r Rune) OmitEmpty() *Rune {
+	if !r.Valid {
+		return nil
+	}
+	return &r
+}
+
+// Equal returns true if both Runes are null or both hold the same value.
+func (r Rune) Equal(other Rune) bool {
+	return r.Valid == other.Valid && (!r.Valid || r.Rune == other.Rune)
+}
+
+// String implements fmt.Stringer.
+// It returns the rune as a one character string, or NullDisplay if this Rune is null.
+func (r Rune) String() string {
+	if !r.Valid {
+		return NullDisplay
+	}
+	return string(r.Rune)
+}
+
+// Scan implements the Scanner interface.
+// It accepts a string or []byte holding exactly one character, and an empty
+// string will be null.
+func (r *Rune) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case string:
+		err = r.UnmarshalText([]byte(x))
+	case []byte:
+		err = r.UnmarshalText(x)
+	case nil:
+		r.Rune, r.Valid = 0, false
+		return nil
+	default:
+		r.Rune, r.Valid = 0, false
+		err = errUnsupportedScanType
+	}
+	return scanError("Rune", value, nil, err)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the rune as a one character string.
+func (r Rune) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return string(r.Rune), nil
+}
+
+// ValueOrNil returns nil if this Rune is null, otherwise the same value as Value.
+func (r Rune) ValueOrNil() interface{} {
+	if !r.Valid {
+		return nil
+	}
+	return string(r.Rune)
+}
+
+// Randomize for sqlboiler
+func (r *Rune) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		r.Rune = 0
+		r.Valid = false
+	} else {
+		r.Rune = rune(nextInt()%26 + 'A')
+		r.Valid = true
+	}
+}
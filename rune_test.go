@@ -0,0 +1,227 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+var (
+	runeValue = 'é'
+	runeJSON  = []byte(`"é"`)
+)
+
+func TestRuneFrom(t *testing.T) {
+	r := RuneFrom(runeValue)
+	assertRune(t, r, "RuneFrom()")
+
+	zero := RuneFrom(0)
+	if !zero.Valid {
+		t.Error("RuneFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestRuneFromPtr(t *testing.T) {
+	v := runeValue
+	r := RuneFromPtr(&v)
+	assertRune(t, r, "RuneFromPtr()")
+
+	null := RuneFromPtr(nil)
+	assertNullRune(t, null, "RuneFromPtr(nil)")
+}
+
+func TestUnmarshalRune(t *testing.T) {
+	var r Rune
+	err := json.Unmarshal(runeJSON, &r)
+	maybePanic(err)
+	assertRune(t, r, "rune json")
+
+	var escaped Rune
+	err = json.Unmarshal([]byte(`"\u00e9"`), &escaped)
+	maybePanic(err)
+	assertRune(t, escaped, "escaped rune json")
+
+	var ascii Rune
+	err = json.Unmarshal([]byte(`"A"`), &ascii)
+	maybePanic(err)
+	if !ascii.Valid || ascii.Rune != 'A' {
+		t.Errorf("bad ascii rune: %#v", ascii)
+	}
+
+	var null Rune
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullRune(t, null, "null json")
+
+	var blank Rune
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullRune(t, blank, "blank json string")
+
+	var multi Rune
+	err = json.Unmarshal([]byte(`"éa"`), &multi)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullRune(t, multi, "multi-rune json")
+
+	var badType Rune
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullRune(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalRune(t *testing.T) {
+	var r Rune
+	err := r.UnmarshalText([]byte("é"))
+	maybePanic(err)
+	assertRune(t, r, "UnmarshalText() rune")
+
+	var blank Rune
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullRune(t, blank, "UnmarshalText() empty rune")
+
+	var invalid Rune
+	err = invalid.UnmarshalText([]byte("\xe9"))
+	if err == nil {
+		t.Error("expected error for invalid UTF-8")
+	}
+	assertNullRune(t, invalid, "UnmarshalText() invalid UTF-8")
+}
+
+func TestMarshalRune(t *testing.T) {
+	r := RuneFrom(runeValue)
+	data, err := json.Marshal(r)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(runeJSON), "non-empty json marshal")
+
+	quote := RuneFrom('"')
+	data, err = json.Marshal(quote)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"\""`, "quote json marshal")
+
+	// invalid values should be encoded as null
+	null := NewRune(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalRuneText(t *testing.T) {
+	r := RuneFrom(runeValue)
+	data, err := r.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "é", "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewRune(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestRuneBinary(t *testing.T) {
+	for _, in := range []Rune{RuneFrom(runeValue), RuneFrom('🎉'), NewRune(0, false)} {
+		data, err := in.MarshalBinary()
+		maybePanic(err)
+		var out Rune
+		err = out.UnmarshalBinary(data)
+		maybePanic(err)
+		if !out.Equal(in) {
+			t.Errorf("bad binary round trip: %v ≠ %v", out, in)
+		}
+	}
+}
+
+func TestRuneSetValid(t *testing.T) {
+	change := NewRune(0, false)
+	assertNullRune(t, change, "SetValid()")
+	change.SetValid(runeValue)
+	assertRune(t, change, "SetValid()")
+}
+
+func TestRuneSetNull(t *testing.T) {
+	change := RuneFrom(runeValue)
+	change.SetNull()
+	assertNullRune(t, change, "SetNull()")
+	if change.Rune != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Rune)
+	}
+}
+
+func TestRuneScanValue(t *testing.T) {
+	var r Rune
+	err := r.Scan("é")
+	maybePanic(err)
+	assertRune(t, r, "scanned string")
+	if v, err := r.Value(); v != "é" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var b Rune
+	err = b.Scan([]byte("é"))
+	maybePanic(err)
+	assertRune(t, b, "scanned []byte")
+
+	var null Rune
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullRune(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var multi Rune
+	err = multi.Scan("ab")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullRune(t, multi, "scanned multi-rune")
+
+	var wrong Rune
+	err = wrong.Scan(int64(65))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullRune(t, wrong, "scanned wrong type")
+}
+
+func TestRuneString(t *testing.T) {
+	v := RuneFrom(runeValue)
+	if s := v.String(); s != "é" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewRune(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestRuneValueOrNil(t *testing.T) {
+	assertValueOrNil(t, RuneFrom(runeValue), "valid")
+	assertValueOrNil(t, NewRune(0, false), "null")
+}
+
+func TestRuneMustValue(t *testing.T) {
+	v := RuneFrom(runeValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewRune(0, false).MustValue() }, "Rune")
+}
+
+func assertRune(t *testing.T, r Rune, from string) {
+	if r.Rune != runeValue {
+		t.Errorf("bad %s rune: %q ≠ %q\n", from, r.Rune, runeValue)
+	}
+	if !r.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullRune(t *testing.T, r Rune, from string) {
+	if r.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,48 @@
+package null
+
+// AnyNull reports whether any of in is null. Like Coalesce, it works with
+// every type in this package, including Null[T].
+func AnyNull[T interface{ IsNull() bool }](in []T) bool {
+	for _, v := range in {
+		if v.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterValid returns the values of in that are not null, in order. It
+// always returns a new slice, which is empty rather than nil if every value is
+// null.
+func FilterValid[T interface{ IsNull() bool }](in []T) []T {
+	out := make([]T, 0, len(in))
+	for _, v := range in {
+		if !v.IsNull() {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// ValidStrings returns the underlying strings of the values of in that are
+// not null, in order.
+func ValidStrings(in []String) []string {
+	out := make([]string, 0, len(in))
+	for _, s := range in {
+		if s.Valid {
+			out = append(out, s.String)
+		}
+	}
+	return out
+}
+
+// ValidValues is like ValidStrings, for Null[T].
+func ValidValues[T any](in []Null[T]) []T {
+	out := make([]T, 0, len(in))
+	for _, n := range in {
+		if n.Valid {
+			out = append(out, n.Val)
+		}
+	}
+	return out
+}
@@ -0,0 +1,75 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnyNull(t *testing.T) {
+	if AnyNull([]String{StringFrom("a"), StringFrom("")}) {
+		t.Error("AnyNull() should be false with no nulls")
+	}
+	if !AnyNull([]String{StringFrom("a"), NewString("b", false)}) {
+		t.Error("AnyNull() should be true for mixed input")
+	}
+	if !AnyNull([]String{NewString("", false), NewString("", false)}) {
+		t.Error("AnyNull() should be true when all are null")
+	}
+	if AnyNull([]String(nil)) {
+		t.Error("AnyNull() should be false for no values")
+	}
+	if !AnyNull([]Null[int]{NullFrom(1), NewNull(0, false)}) {
+		t.Error("AnyNull() should be true for a null Null[int]")
+	}
+}
+
+func TestFilterValid(t *testing.T) {
+	mixed := []Int64{Int64From(1), NewInt64(2, false), Int64From(0), NewInt64(0, false)}
+	if got, want := FilterValid(mixed), []Int64{Int64From(1), Int64From(0)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bad FilterValid() of mixed input: %v ≠ %v", got, want)
+	}
+
+	none := []Int64{Int64From(1), Int64From(2)}
+	if got := FilterValid(none); !reflect.DeepEqual(got, none) {
+		t.Errorf("bad FilterValid() with no nulls: %v", got)
+	}
+
+	all := []Int64{NewInt64(1, false), NewInt64(2, false)}
+	if got := FilterValid(all); got == nil || len(got) != 0 {
+		t.Errorf("FilterValid() should return an empty slice when all are null: %#v", got)
+	}
+}
+
+func TestValidStrings(t *testing.T) {
+	tests := []struct {
+		in   []String
+		want []string
+	}{
+		{nil, []string{}},
+		{[]String{NewString("a", false), NewString("", false)}, []string{}},
+		{[]String{StringFrom("a"), StringFrom("")}, []string{"a", ""}},
+		{[]String{StringFrom("a"), NewString("b", false), StringFrom("c")}, []string{"a", "c"}},
+	}
+	for _, test := range tests {
+		if got := ValidStrings(test.in); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("bad ValidStrings(%v): %q ≠ %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestValidValues(t *testing.T) {
+	all := []Null[int]{NewNull(1, false), NewNull(2, false)}
+	if got := ValidValues(all); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("bad ValidValues() when all are null: %v", got)
+	}
+
+	none := []Null[int]{NullFrom(1), NullFrom(0)}
+	if got := ValidValues(none); !reflect.DeepEqual(got, []int{1, 0}) {
+		t.Errorf("bad ValidValues() with no nulls: %v", got)
+	}
+
+	mixed := []Null[string]{NullFrom("a"), NewNull("b", false), NullFrom("c")}
+	if got := ValidValues(mixed); !reflect.DeepEqual(got, []string{"a", "c"}) {
+		t.Errorf("bad ValidValues() of mixed input: %v", got)
+	}
+}
@@ -0,0 +1,62 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// sqlNullValue unwraps a driver.Valuer, such as the database/sql Null* types
+// or another null type, which some drivers, ORMs and helper layers hand to
+// Scan in place of a plain driver value. An invalid wrapper or a nil pointer
+// becomes nil. A Valuer whose Value fails is returned unchanged, so that Scan
+// reports it as a type it cannot scan, and any other value is returned as is.
+func sqlNullValue(value interface{}) interface{} {
+	switch value.(type) {
+	case sql.NullString, sql.NullInt64, sql.NullInt32, sql.NullInt16,
+		sql.NullByte, sql.NullFloat64, sql.NullBool, sql.NullTime:
+		// their Value methods never fail
+		v, _ := value.(driver.Valuer).Value()
+		return v
+	}
+	valuer, ok := value.(driver.Valuer)
+	if !ok {
+		return value
+	}
+	// like database/sql, treat a nil pointer as NULL rather than calling a
+	// value receiver Value method through it
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	v, err := valuer.Value()
+	if err != nil {
+		return value
+	}
+	return v
+}
+
+// errUnsupportedScanType is the cause of a Scan error for a value of a type
+// the null type cannot scan at all, such as a time.Time into a null.IP.
+var errUnsupportedScanType = errors.New("unsupported type")
+
+// scanError wraps an error from scanning value with the name of the null type
+// being scanned into and the Go type of value, such as
+// "null.Int8.Scan: cannot scan string: ...", so that a mismatch between a
+// column and its field shows what the driver sent. If dest is not nil, a
+// number out of range for dest is reported as an OverflowError. It returns
+// nil if err is nil.
+func scanError(typ string, value, dest interface{}, err error) error {
+	if err == nil {
+		return nil
+	}
+	var ne *strconv.NumError
+	if dest != nil && errors.As(err, &ne) && errors.Is(ne, strconv.ErrRange) {
+		if o := overflowFor(typ, dest, ne.Num); o != nil {
+			err = o
+		}
+	}
+	return fmt.Errorf("null.%s.Scan: cannot scan %T: %w", typ, value, err)
+}
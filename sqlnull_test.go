@@ -0,0 +1,366 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScanSQLNull(t *testing.T) {
+	var s String
+	maybePanic(s.Scan(sql.NullString{String: "test", Valid: true}))
+	assertStr(t, s, "scanned sql.NullString")
+	maybePanic(s.Scan(sql.NullString{}))
+	assertNullStr(t, s, "scanned invalid sql.NullString")
+
+	var i64 Int64
+	maybePanic(i64.Scan(sql.NullInt64{Int64: 9223372036854775806, Valid: true}))
+	assertInt64(t, i64, "scanned sql.NullInt64")
+	maybePanic(i64.Scan(sql.NullInt64{}))
+	assertNullInt64(t, i64, "scanned invalid sql.NullInt64")
+
+	var i32 Int32
+	maybePanic(i32.Scan(sql.NullInt32{Int32: 12345, Valid: true}))
+	if !i32.Valid || i32.Int32 != 12345 {
+		t.Errorf("bad scanned sql.NullInt32: %#v", i32)
+	}
+	maybePanic(i32.Scan(sql.NullInt32{}))
+	assertNullInt32(t, i32, "scanned invalid sql.NullInt32")
+
+	var i16 Int16
+	maybePanic(i16.Scan(sql.NullInt16{Int16: 12345, Valid: true}))
+	if !i16.Valid || i16.Int16 != 12345 {
+		t.Errorf("bad scanned sql.NullInt16: %#v", i16)
+	}
+	maybePanic(i16.Scan(sql.NullInt16{}))
+	assertNullInt16(t, i16, "scanned invalid sql.NullInt16")
+
+	var b Byte
+	maybePanic(b.Scan(sql.NullByte{Byte: 'b', Valid: true}))
+	if !b.Valid || b.Byte != 'b' {
+		t.Errorf("bad scanned sql.NullByte: %#v", b)
+	}
+	maybePanic(b.Scan(sql.NullByte{}))
+	assertNullByte(t, b, "scanned invalid sql.NullByte")
+
+	var u8 Uint8
+	maybePanic(u8.Scan(sql.NullByte{Byte: 255, Valid: true}))
+	if !u8.Valid || u8.Uint8 != 255 {
+		t.Errorf("bad scanned sql.NullByte: %#v", u8)
+	}
+	maybePanic(u8.Scan(sql.NullByte{}))
+	assertNullUint8(t, u8, "scanned invalid sql.NullByte")
+
+	var f Float64
+	maybePanic(f.Scan(sql.NullFloat64{Float64: 1.2345, Valid: true}))
+	assertFloat64(t, f, "scanned sql.NullFloat64")
+	maybePanic(f.Scan(sql.NullFloat64{}))
+	assertNullFloat64(t, f, "scanned invalid sql.NullFloat64")
+
+	var bo Bool
+	maybePanic(bo.Scan(sql.NullBool{Bool: true, Valid: true}))
+	assertBool(t, bo, "scanned sql.NullBool")
+	maybePanic(bo.Scan(sql.NullBool{}))
+	assertNullBool(t, bo, "scanned invalid sql.NullBool")
+
+	var ti Time
+	maybePanic(ti.Scan(sql.NullTime{Time: timeValue, Valid: true}))
+	assertTime(t, ti, "scanned sql.NullTime")
+	maybePanic(ti.Scan(sql.NullTime{}))
+	assertNullTime(t, ti, "scanned invalid sql.NullTime")
+}
+
+type scanZeroer interface {
+	Scan(value interface{}) error
+	IsZero() bool
+}
+
+func TestScanIncompatible(t *testing.T) {
+	// every value starts valid, so a failed Scan must set it to null
+	tests := []struct {
+		v   scanZeroer
+		in  interface{}
+		typ string
+	}{
+		{&Bool{Bool: true, Valid: true}, "not a bool", "Bool"},
+		{&Bytes{Bytes: []byte("x"), Valid: true}, struct{}{}, "Bytes"},
+		{&Float32{Float32: 1, Valid: true}, "abc", "Float32"},
+		{&Float64{Float64: 1, Valid: true}, "abc", "Float64"},
+		{&Int{Int: 1, Valid: true}, "abc", "Int"},
+		{&Int8{Int8: 1, Valid: true}, "abc", "Int8"},
+		{&Int16{Int16: 1, Valid: true}, "abc", "Int16"},
+		{&Int32{Int32: 1, Valid: true}, "abc", "Int32"},
+		{&Int64{Int64: 1, Valid: true}, "abc", "Int64"},
+		{&Uint{Uint: 1, Valid: true}, "abc", "Uint"},
+		{&Uint8{Uint8: 1, Valid: true}, "abc", "Uint8"},
+		{&Uint16{Uint16: 1, Valid: true}, "abc", "Uint16"},
+		{&Uint32{Uint32: 1, Valid: true}, "abc", "Uint32"},
+		{&Uint64{Uint64: 1, Valid: true}, "abc", "Uint64"},
+		{&String{String: "x", Valid: true}, struct{}{}, "String"},
+		{&JSON{JSON: []byte("1"), Valid: true}, struct{}{}, "JSON"},
+		{&Null[int]{Val: 1, Valid: true}, "abc", "Null"},
+	}
+	for _, test := range tests {
+		err := test.v.Scan(test.in)
+		if err == nil {
+			t.Errorf("expected error scanning %T into %s", test.in, test.typ)
+			continue
+		}
+		if prefix := fmt.Sprintf("null.%s.Scan: cannot scan %T: ", test.typ, test.in); !strings.HasPrefix(err.Error(), prefix) {
+			t.Errorf("bad %s error, should start with %q: %v", test.typ, prefix, err)
+		}
+		if !test.v.IsZero() {
+			t.Errorf("%s should be null after a failed Scan: %#v", test.typ, test.v)
+		}
+	}
+}
+
+func TestScanErrorType(t *testing.T) {
+	// a value of a type Scan accepts but cannot parse names the type too
+	tests := []struct {
+		v    sql.Scanner
+		in   interface{}
+		want string
+	}{
+		{new(IP), "test", `null.IP.Scan: cannot scan string: null: invalid IP address "test"`},
+		{new(IP), []byte("test"), `null.IP.Scan: cannot scan []uint8: null: invalid IP address "test"`},
+		{new(Time), "yesterday", `null.Time.Scan: cannot scan string: null: cannot parse "yesterday" as a timestamp for null.Time`},
+		{new(Date), []byte("2020-13-01"), `null.Date.Scan: cannot scan []uint8: null: cannot parse "2020-13-01" as a timestamp for null.Time`},
+		{new(Date), int64(20200101), "null.Date.Scan: cannot scan int64: unsupported type"},
+		{new(UUID), int64(1), "null.UUID.Scan: cannot scan int64: unsupported type"},
+		{new(JSON), "{", "null.JSON.Scan: cannot scan string: invalid JSON"},
+		{new(Int8), int64(200), "null.Int8.Scan: cannot scan int64: null: 200 overflows null.Int8"},
+		{new(Bool), float64(1), "null.Bool.Scan: cannot scan float64: "},
+		{new(Enum[color]), "purple", "null.Enum.Scan: cannot scan string: "},
+		{new(Decimal), "1.2.3", "null.Decimal.Scan: cannot scan string: "},
+		{new(Int), sql.NullString{String: "abc", Valid: true}, "null.Int.Scan: cannot scan string: "},
+	}
+	for _, test := range tests {
+		err := test.v.Scan(test.in)
+		if err == nil || !strings.HasPrefix(err.Error(), test.want) {
+			t.Errorf("%T.Scan(%#v): bad error %v, want %q", test.v, test.in, err, test.want)
+		}
+	}
+
+	// the cause is still there for errors.As
+	var o *OverflowError
+	if !errors.As(new(Int8).Scan(int64(200)), &o) {
+		t.Error("expected an *OverflowError")
+	}
+}
+
+func TestScanJSONNumber(t *testing.T) {
+	// a json.Decoder with UseNumber decodes numbers into interface{} values
+	// as json.Number
+	tests := []struct {
+		v    sql.Scanner
+		in   json.Number
+		want string
+	}{
+		{new(Int64), "-9223372036854775808", "-9223372036854775808"},
+		{new(Int8), "-128", "-128"},
+		{new(Float64), "1.5e3", "1500"},
+		{new(Float32), "0.25", "0.25"},
+		{new(Uint), "42", "42"},
+		{new(Uint64), "18446744073709551615", "18446744073709551615"},
+		{new(BigInt), "123456789012345678901234567890", "123456789012345678901234567890"},
+		{new(BigFloat), "1.5", "1.5"},
+		{new(BigRat), "0.125", "0.125"},
+		{new(Decimal), "1.50", "1.5"},
+		{new(Complex128), "2.5", "(2.5+0i)"},
+		{new(Complex64), "2.5", "(2.5+0i)"},
+		{new(Duration), "1500000000", "1.5s"},
+	}
+	for _, test := range tests {
+		if err := test.v.Scan(test.in); err != nil {
+			t.Errorf("%T.Scan(json.Number(%q)): %v", test.v, test.in, err)
+			continue
+		}
+		if got := fmt.Sprint(test.v); got != test.want {
+			t.Errorf("%T.Scan(json.Number(%q)): got %s, want %s", test.v, test.in, got, test.want)
+		}
+	}
+
+	overflows := []struct {
+		v  sql.Scanner
+		in json.Number
+	}{
+		{new(Int64), "9223372036854775808"},
+		{new(Int8), "128"},
+		{new(Float32), "1e39"},
+		{new(Uint), "18446744073709551616"},
+		{new(Uint), "-1"},
+		{new(Uint64), "-1"},
+		{new(Uint8), "256"},
+		{new(Uint8), "-1"},
+		{new(Byte), "-1"},
+	}
+	for _, test := range overflows {
+		var o *OverflowError
+		err := test.v.Scan(test.in)
+		if !errors.As(err, &o) || !strings.HasPrefix(err.Error(), "null.") || !strings.Contains(err.Error(), "cannot scan json.Number: ") {
+			t.Errorf("%T.Scan(json.Number(%q)): expected an overflow error, got %v", test.v, test.in, err)
+		}
+		if v, _ := test.v.(driver.Valuer).Value(); v != nil {
+			t.Errorf("%T.Scan(json.Number(%q)): should be null after overflowing, got %v", test.v, test.in, v)
+		}
+	}
+
+	for _, v := range []sql.Scanner{new(Int64), new(Uint), new(BigInt)} {
+		if err := v.Scan(json.Number("1.5")); err == nil {
+			t.Errorf("%T.Scan(json.Number(\"1.5\")): expected an error", v)
+		}
+	}
+}
+
+func TestScanBogusValue(t *testing.T) {
+	// every value starts valid, so a failed Scan must set it to null
+	values := []scanZeroer{
+		&Byte{Byte: 'a', Valid: true},
+		&CIDR{CIDR: cidrValue, Valid: true},
+		&Complex64{Complex64: 1i, Valid: true},
+		&Complex128{Complex128: 1i, Valid: true},
+		&BigFloat{BigFloat: bigFloatValue, Valid: true},
+		&BigInt{BigInt: bigIntValue, Valid: true},
+		&BigRat{BigRat: bigRatValue, Valid: true},
+		&Date{Date: dateValue, Valid: true},
+		&Decimal{Decimal: decimalValue, Valid: true},
+		&Duration{Duration: durationValue, Valid: true},
+		&Enum[color]{Enum: "red", Valid: true},
+		&FormattedTime{Time: timeValue, Valid: true},
+		&Hstore{Hstore: hstoreValue, Valid: true},
+		&IntBool{Bool: true, Valid: true},
+		&IP{IP: ipValue, Valid: true},
+		&LooseString{String: "x", Valid: true},
+		&MAC{MAC: macValue, Valid: true},
+		&Map{Map: mapValue, Valid: true},
+		&Rune{Rune: runeValue, Valid: true},
+		&StringSlice{StringSlice: []string{"a"}, Valid: true},
+		&TextBytes{Bytes: []byte("x"), Valid: true},
+		&Time{Time: timeValue, Valid: true},
+		&TimeString{Time: timeValue, Valid: true},
+		&TrimmedString{String: "x", Valid: true},
+		&URL{URL: urlValue, Valid: true},
+		&UUID{UUID: uuidValue, Valid: true},
+	}
+	for _, v := range values {
+		if err := v.Scan(struct{}{}); err == nil {
+			t.Errorf("expected error scanning a struct into %T", v)
+		} else if !strings.Contains(err.Error(), "cannot scan struct {}: ") {
+			t.Errorf("%T error should mention the scanned type: %v", v, err)
+		}
+		if !v.IsZero() {
+			t.Errorf("%T should be null after a failed Scan: %#v", v, v)
+		}
+	}
+}
+
+func TestSQLNullConversions(t *testing.T) {
+	conversions := []struct {
+		typ         string
+		valid, null bool
+	}{
+		{"String",
+			StringFromSQL(sql.NullString{String: "test", Valid: true}) == StringFrom("test") &&
+				StringFrom("test").ToSQL() == sql.NullString{String: "test", Valid: true},
+			!StringFromSQL(sql.NullString{}).Valid && NewString("", false).ToSQL() == sql.NullString{}},
+		{"Int64",
+			Int64FromSQL(sql.NullInt64{Int64: -1, Valid: true}) == Int64From(-1) &&
+				Int64From(-1).ToSQL() == sql.NullInt64{Int64: -1, Valid: true},
+			!Int64FromSQL(sql.NullInt64{}).Valid && NewInt64(0, false).ToSQL() == sql.NullInt64{}},
+		{"Int32",
+			Int32FromSQL(sql.NullInt32{Int32: -1, Valid: true}) == Int32From(-1) &&
+				Int32From(-1).ToSQL() == sql.NullInt32{Int32: -1, Valid: true},
+			!Int32FromSQL(sql.NullInt32{}).Valid && NewInt32(0, false).ToSQL() == sql.NullInt32{}},
+		{"Int16",
+			Int16FromSQL(sql.NullInt16{Int16: -1, Valid: true}) == Int16From(-1) &&
+				Int16From(-1).ToSQL() == sql.NullInt16{Int16: -1, Valid: true},
+			!Int16FromSQL(sql.NullInt16{}).Valid && NewInt16(0, false).ToSQL() == sql.NullInt16{}},
+		{"Byte",
+			ByteFromSQL(sql.NullByte{Byte: 'b', Valid: true}) == ByteFrom('b') &&
+				ByteFrom('b').ToSQL() == sql.NullByte{Byte: 'b', Valid: true},
+			!ByteFromSQL(sql.NullByte{}).Valid && NewByte(0, false).ToSQL() == sql.NullByte{}},
+		{"Float64",
+			Float64FromSQL(sql.NullFloat64{Float64: 1.5, Valid: true}) == Float64From(1.5) &&
+				Float64From(1.5).ToSQL() == sql.NullFloat64{Float64: 1.5, Valid: true},
+			!Float64FromSQL(sql.NullFloat64{}).Valid && NewFloat64(0, false).ToSQL() == sql.NullFloat64{}},
+		{"Bool",
+			BoolFromSQL(sql.NullBool{Bool: true, Valid: true}) == BoolFrom(true) &&
+				BoolFrom(true).ToSQL() == sql.NullBool{Bool: true, Valid: true},
+			!BoolFromSQL(sql.NullBool{}).Valid && NewBool(false, false).ToSQL() == sql.NullBool{}},
+		{"Time",
+			TimeFromSQL(sql.NullTime{Time: timeValue, Valid: true}).Equal(TimeFrom(timeValue)) &&
+				TimeFrom(timeValue).ToSQL() == sql.NullTime{Time: timeValue, Valid: true},
+			!TimeFromSQL(sql.NullTime{}).Valid && NewTime(time.Time{}, false).ToSQL() == sql.NullTime{}},
+	}
+	for _, c := range conversions {
+		if !c.valid {
+			t.Errorf("bad %s conversion of a valid value to or from database/sql", c.typ)
+		}
+		if !c.null {
+			t.Errorf("bad %s conversion of a null value to or from database/sql", c.typ)
+		}
+	}
+}
+
+// valuer is a custom driver.Valuer, as handed to Scan by some ORMs.
+type valuer struct {
+	v   driver.Value
+	err error
+}
+
+func (v valuer) Value() (driver.Value, error) {
+	return v.v, v.err
+}
+
+func TestScanValuer(t *testing.T) {
+	var s String
+	maybePanic(s.Scan(valuer{v: "test"}))
+	assertStr(t, s, "scanned valuer")
+	maybePanic(s.Scan(valuer{}))
+	assertNullStr(t, s, "scanned nil valuer")
+
+	var i Int64
+	maybePanic(i.Scan(Int64From(9223372036854775806)))
+	assertInt64(t, i, "scanned null.Int64")
+	maybePanic(i.Scan(NewInt64(1, false)))
+	assertNullInt64(t, i, "scanned null null.Int64")
+
+	var f Float64
+	maybePanic(f.Scan(StringFrom("1.2345")))
+	assertFloat64(t, f, "scanned null.String")
+
+	var u UUID
+	maybePanic(u.Scan(uuidValue))
+	if !u.Valid || u.UUID != uuidValue {
+		t.Errorf("bad scanned uuid.UUID: %#v", u)
+	}
+
+	var n Null[int]
+	maybePanic(n.Scan(valuer{v: int64(7)}))
+	if !n.Valid || n.Val != 7 {
+		t.Errorf("bad scanned valuer into Null[int]: %#v", n)
+	}
+
+	var direct Null[valuer]
+	maybePanic(direct.Scan(valuer{v: "kept"}))
+	if !direct.Valid || direct.Val.v != "kept" {
+		t.Errorf("a Null[valuer] should store a scanned valuer as is: %#v", direct)
+	}
+
+	var nilPtr *String
+	s = StringFrom("test")
+	maybePanic(s.Scan(nilPtr))
+	assertNullStr(t, s, "scanned nil *null.String")
+
+	s = StringFrom("test")
+	if err := s.Scan(valuer{err: errors.New("boom")}); err == nil {
+		t.Error("expected error scanning a failing valuer")
+	}
+	assertNullStr(t, s, "scanned failing valuer")
+}
@@ -2,14 +2,22 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
 	"github.com/volatiletech/sqlboiler/randomize"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // String is a nullable string. It supports SQL and JSON serialization.
+// Unlike the other types it does not implement fmt.Stringer, since its String
+// field takes that name; use ValueOr(NullDisplay) for the same output.
 type String struct {
 	String string
 	Valid  bool
@@ -28,6 +36,20 @@ func StringFromPtr(s *string) String {
 	return NewString(*s, true)
 }
 
+// StringFromSQL creates a new String from the database/sql NullString n.
+func StringFromSQL(n sql.NullString) String {
+	return NewString(n.String, n.Valid)
+}
+
+// StringFromWrapperpb creates a new String from a protobuf StringValue.
+// It will be null if w is nil.
+func StringFromWrapperpb(w *wrapperspb.StringValue) String {
+	if w == nil {
+		return NewString("", false)
+	}
+	return NewString(w.Value, true)
+}
+
 // NewString creates a new String
 func NewString(s string, valid bool) String {
 	return String{
@@ -45,6 +67,7 @@ func (s *String) UnmarshalJSON(data []byte) error {
 	}
 
 	if err := json.Unmarshal(data, &s.String); err != nil {
+		s.String, s.Valid = "", false
 		return err
 	}
 
@@ -52,12 +75,91 @@ func (s *String) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this String
+// to def and makes it valid, rather than null.
+func (s *String) UnmarshalJSONDefault(data []byte, def string) error {
+	if bytes.Equal(data, NullBytes) {
+		s.SetValid(def)
+		return nil
+	}
+	return s.UnmarshalJSON(data)
+}
+
 // MarshalJSON implements json.Marshaler.
 func (s String) MarshalJSON() ([]byte, error) {
 	if !s.Valid {
 		return NullBytes, nil
 	}
-	return json.Marshal(s.String)
+	return appendJSONString(make([]byte, 0, len(s.String)+2), s.String), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this String to dst and returns
+// the extended buffer. It does not allocate when dst has room.
+func (s String) AppendJSON(dst []byte) []byte {
+	if !s.Valid {
+		return append(dst, NullBytes...)
+	}
+	return appendJSONString(dst, s.String)
+}
+
+// appendJSONString appends s to dst as a quoted JSON string, escaped exactly
+// as json.Marshal does: HTML characters and U+2028 and U+2029 are escaped, and
+// each invalid UTF-8 byte is replaced with U+FFFD.
+func appendJSONString(dst []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, "\uFFFD"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null String as chosen by opts.
+func (s String) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(s)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -68,6 +170,109 @@ func (s String) MarshalText() ([]byte, error) {
 	return []byte(s.String), nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this String is null.
+func (s String) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, s, s.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (s *String) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, s)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this String is null.
+func (s String) MarshalYAML() (interface{}, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.String, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (s *String) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		s.String = ""
+		s.Valid = false
+		return nil
+	}
+	s.String = *v
+	s.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this String is null.
+func (s String) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !s.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(s.String)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (s *String) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		s.String = ""
+		s.Valid = false
+		return nil
+	}
+	s.String = *v
+	s.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null String is encoded as a single byte.
+func (s String) MarshalBinary() ([]byte, error) {
+	if !s.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, []byte(s.String)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *String) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "String")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		s.String = ""
+		s.Valid = false
+		return nil
+	}
+	b, err := decodeLengthPrefixed(value, "String")
+	if err != nil {
+		return err
+	}
+	s.String = string(b)
+	s.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (s String) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (s *String) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (s *String) UnmarshalText(text []byte) error {
 	if text == nil || len(text) == 0 {
@@ -86,6 +291,13 @@ func (s *String) SetValid(v string) {
 	s.Valid = true
 }
 
+// SetNull sets this String to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (s *String) SetNull() {
+	s.String = ""
+	s.Valid = false
+}
+
 // Ptr returns a pointer to this String's value, or a nil pointer if this String is null.
 func (s String) Ptr() *string {
 	if !s.Valid {
@@ -94,19 +306,100 @@ func (s String) Ptr() *string {
 	return &s.String
 }
 
-// IsZero returns true for null strings, for potential future omitempty support.
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (s String) ValueOrZero() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.String
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (s String) ValueOr(def string) string {
+	if !s.Valid {
+		return def
+	}
+	return s.String
+}
+
+// Or returns this String if it is valid, otherwise other.
+func (s String) Or(other String) String {
+	if !s.Valid {
+		return other
+	}
+	return s
+}
+
+// MustValue returns the inner value, and panics if this String is null.
+func (s String) MustValue() string {
+	if !s.Valid {
+		panic("null.String: MustValue called on invalid value")
+	}
+	return s.String
+}
+
+// Map returns a new String holding fn applied to this String's value. A null String is
+// returned unchanged and fn is not called.
+func (s String) Map(fn func(string) string) String {
+	if !s.Valid {
+		return s
+	}
+	return NewString(fn(s.String), true)
+}
+
+// IsZero returns true if this String is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (s String) IsZero() bool {
 	return !s.Valid
 }
 
+// IsNull returns true if this String is null, the same as !Valid.
+func (s String) IsNull() bool {
+	return !s.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this String, or nil if it is null, for
+// a *String field tagged omitempty that should leave nulls out.
+func (s String) OmitEmpty() *String {
+	if !s.Valid {
+		return nil
+	}
+	return &s
+}
+
+// Equal returns true if both Strings are null or both hold the same value.
+func (s String) Equal(other String) bool {
+	return s.Valid == other.Valid && (!s.Valid || s.String == other.String)
+}
+
+// Compare returns -1 if this String sorts before other, 0 if they are equal
+// and +1 if it sorts after. A null String sorts before every valid one, like
+// SQL's NULLS FIRST, so it can be used with sort.Slice and slices.SortFunc.
+func (s String) Compare(other String) int {
+	switch {
+	case !s.Valid && !other.Valid:
+		return 0
+	case !s.Valid:
+		return -1
+	case !other.Valid:
+		return 1
+	}
+	return strings.Compare(s.String, other.String)
+}
+
 // Scan implements the Scanner interface.
 func (s *String) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		s.String, s.Valid = "", false
 		return nil
 	}
+	if err := convert.ConvertAssign(&s.String, value); err != nil {
+		s.String, s.Valid = "", false
+		return scanError("String", value, &s.String, err)
+	}
 	s.Valid = true
-	return convert.ConvertAssign(&s.String, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -117,6 +410,27 @@ func (s String) Value() (driver.Value, error) {
 	return s.String, nil
 }
 
+// ValueOrNil returns nil if this String is null, otherwise the same value as Value.
+func (s String) ValueOrNil() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.String
+}
+
+// ToSQL returns this String as a database/sql NullString.
+func (s String) ToSQL() sql.NullString {
+	return sql.NullString{String: s.String, Valid: s.Valid}
+}
+
+// ToWrapperpb returns this String as a protobuf StringValue, or nil if this String is null.
+func (s String) ToWrapperpb() *wrapperspb.StringValue {
+	if !s.Valid {
+		return nil
+	}
+	return wrapperspb.String(s.String)
+}
+
 // Randomize for sqlboiler
 func (s *String) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	str, ok := randomize.FormattedString(nextInt, fieldType)
@@ -130,7 +444,12 @@ func (s *String) Randomize(nextInt func() int64, fieldType string, shouldBeNull
 		s.String = ""
 		s.Valid = false
 	} else {
-		s.String = randomize.Str(nextInt, 1)
+		// stay within a length such as varchar(10)
+		ln := 1
+		if max := fieldTypeLength(fieldType); max > 1 {
+			ln += int(uint64(nextInt()) % uint64(max))
+		}
+		s.String = randomize.Str(nextInt, ln)
 		s.Valid = true
 	}
 }
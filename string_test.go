@@ -2,7 +2,11 @@ package null
 
 import (
 	"encoding/json"
+	"sort"
+	"strings"
 	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
 )
 
 var (
@@ -117,6 +121,58 @@ func TestMarshalString(t *testing.T) {
 // 	assertJSONEquals(t, data, `{}`, "null string in struct")
 // }
 
+func TestMarshalStringEscaping(t *testing.T) {
+	inputs := []string{
+		"",
+		"plain",
+		`quote " and backslash \\`,
+		"control \x00\x01\b\f\n\r\t\x1f\x7f",
+		"html <a href=\"x\">&amp;</a>",
+		"unicode héllo 世界 🎉",
+		"separators \u2028 and \u2029",
+	}
+	for _, in := range inputs {
+		want, err := json.Marshal(in)
+		maybePanic(err)
+
+		data, err := StringFrom(in).MarshalJSON()
+		maybePanic(err)
+		if string(data) != string(want) {
+			t.Errorf("bad MarshalJSON() of %q: %s ≠ %s", in, data, want)
+		}
+
+		data = StringFrom(in).AppendJSON([]byte("prefix:"))
+		if string(data) != "prefix:"+string(want) {
+			t.Errorf("bad AppendJSON() of %q: %s ≠ prefix:%s", in, data, want)
+		}
+	}
+
+	// older versions of encoding/json escape U+FFFD, so compare decoded values
+	invalid := "invalid \xff\xfe utf-8 \xe4\xb8"
+	var want, got string
+	maybePanic(json.Unmarshal(StringFrom(invalid).AppendJSON(nil), &got))
+	data, err := json.Marshal(invalid)
+	maybePanic(err)
+	maybePanic(json.Unmarshal(data, &want))
+	if got != want {
+		t.Errorf("bad AppendJSON() of %q: %q ≠ %q", invalid, got, want)
+	}
+
+	data = NewString("", false).AppendJSON(nil)
+	assertJSONEquals(t, data, "null", "null AppendJSON()")
+}
+
+func TestStringAppendJSONAllocs(t *testing.T) {
+	s := StringFrom("hello <world>\n")
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = s.AppendJSON(buf[:0])
+	})
+	if allocs != 0 {
+		t.Errorf("AppendJSON() allocated %v times, want 0", allocs)
+	}
+}
+
 func TestStringPointer(t *testing.T) {
 	str := StringFrom("test")
 	ptr := str.Ptr()
@@ -160,6 +216,15 @@ func TestStringSetValid(t *testing.T) {
 	assertStr(t, change, "SetValid()")
 }
 
+func TestStringSetNull(t *testing.T) {
+	change := StringFrom("test")
+	change.SetNull()
+	assertNullStr(t, change, "SetNull()")
+	if change.String != "" {
+		t.Errorf("SetNull() should zero the value, got %#v", change.String)
+	}
+}
+
 func TestStringScan(t *testing.T) {
 	var str String
 	err := str.Scan("test")
@@ -172,6 +237,111 @@ func TestStringScan(t *testing.T) {
 	assertNullStr(t, null, "scanned null")
 }
 
+func TestStringEqual(t *testing.T) {
+	null := NewString("", false)
+	other := NewString("test", false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := StringFrom("test")
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := StringFrom("test")
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := StringFrom("other")
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestStringCompare(t *testing.T) {
+	vals := []String{StringFrom("b"), NewString("", false), StringFrom(""), StringFrom("a")}
+	sort.Slice(vals, func(i, j int) bool { return vals[i].Compare(vals[j]) < 0 })
+	want := []String{NewString("", false), StringFrom(""), StringFrom("a"), StringFrom("b")}
+	for i := range want {
+		if !vals[i].Equal(want[i]) {
+			t.Errorf("bad sorted value %d: %#v ≠ %#v", i, vals[i], want[i])
+		}
+	}
+
+	if c := StringFrom("a").Compare(StringFrom("a")); c != 0 {
+		t.Errorf("bad Compare() of equal strings: %d", c)
+	}
+}
+
+func TestStringValueOr(t *testing.T) {
+	valid := StringFrom("test")
+	if valid.ValueOrZero() != "test" {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr("other") != "test" {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr("other"))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewString("test", false)
+	if null.ValueOrZero() != "" {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr("other") != "other" {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr("other"))
+	}
+}
+
+func TestStringMsgpack(t *testing.T) {
+	s := StringFrom("test")
+	data, err := msgpack.Marshal(s)
+	maybePanic(err)
+
+	var str string
+	err = msgpack.Unmarshal(data, &str)
+	maybePanic(err)
+	if str != "test" {
+		t.Errorf("bad msgpack string: %q", str)
+	}
+
+	var out String
+	err = msgpack.Unmarshal(data, &out)
+	maybePanic(err)
+	assertStr(t, out, "msgpack")
+
+	data, err = msgpack.Marshal(NewString("", false))
+	maybePanic(err)
+	null := StringFrom("test")
+	err = msgpack.Unmarshal(data, &null)
+	maybePanic(err)
+	assertNullStr(t, null, "msgpack nil")
+}
+
+func TestStringValueOrNil(t *testing.T) {
+	assertValueOrNil(t, StringFrom("test"), "valid")
+	assertValueOrNil(t, NewString("", false), "null")
+}
+
+func TestStringMustValue(t *testing.T) {
+	v := StringFrom("test")
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewString("", false).MustValue() }, "String")
+}
+
+func TestStringMap(t *testing.T) {
+	mapped := StringFrom("test").Map(strings.ToUpper)
+	if !mapped.Valid || mapped.String != "TEST" {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewString("", false).Map(func(v string) string {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullStr(t, null, "Map() null")
+}
+
 func maybePanic(err error) {
 	if err != nil {
 		panic(err)
@@ -198,3 +368,45 @@ func assertJSONEquals(t *testing.T, data []byte, cmp string, from string) {
 		t.Errorf("bad %s data: %s ≠ %s\n", from, data, cmp)
 	}
 }
+
+var benchmarkStrings = []String{
+	StringFrom("test"),
+	StringFrom("a somewhat longer string with \"quotes\" and <html>"),
+	StringFrom("unicode héllo 世界"),
+	NewString("", false),
+}
+
+func BenchmarkMarshalStringStdlib(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, s := range benchmarkStrings {
+			if !s.Valid {
+				continue
+			}
+			if _, err := json.Marshal(s.String); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkMarshalString(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, s := range benchmarkStrings {
+			if _, err := s.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkStringAppendJSON(b *testing.B) {
+	buf := make([]byte, 0, 128)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, s := range benchmarkStrings {
+			buf = s.AppendJSON(buf[:0])
+		}
+	}
+}
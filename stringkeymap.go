@@ -0,0 +1,53 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// errNullMapKey is returned when marshaling a StringKeyMap with a null key.
+var errNullMapKey = errors.New("null: cannot use a null null.String as a JSON object key")
+
+// StringKeyMap is a map keyed by String that marshals to and from a JSON
+// object. A plain map[String]V encodes its keys with String.MarshalText, which
+// turns a null key into "" and so reads back as a valid empty string. JSON
+// object keys cannot be null, so StringKeyMap returns an error for a null key
+// instead, and every key it decodes is valid.
+type StringKeyMap[V any] map[String]V
+
+// MarshalJSON implements json.Marshaler.
+// It encodes a JSON object with the keys sorted, like encoding/json does for
+// any map, and returns an error if any key is null. A nil map encodes as null.
+func (m StringKeyMap[V]) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+	obj := make(map[string]V, len(m))
+	for k, v := range m {
+		if !k.Valid {
+			return nil, errNullMapKey
+		}
+		obj[k.String] = v
+	}
+	return json.Marshal(obj)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Each key of the JSON object becomes a valid String, including "". A JSON
+// null sets the map to nil.
+func (m *StringKeyMap[V]) UnmarshalJSON(data []byte) error {
+	var obj map[string]V
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if obj == nil {
+		*m = nil
+		return nil
+	}
+	out := make(StringKeyMap[V], len(obj))
+	for k, v := range obj {
+		out[StringFrom(k)] = v
+	}
+	*m = out
+	return nil
+}
@@ -0,0 +1,43 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestStringKeyMap(t *testing.T) {
+	data, err := json.Marshal(StringKeyMap[int]{StringFrom("b"): 2, StringFrom("a"): 1, StringFrom(""): 0})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"":0,"a":1,"b":2}`, "StringKeyMap json marshal")
+
+	var m StringKeyMap[int]
+	err = json.Unmarshal([]byte(`{"a":1,"":0}`), &m)
+	maybePanic(err)
+	if len(m) != 2 || m[StringFrom("a")] != 1 || m[StringFrom("")] != 0 {
+		t.Errorf("bad StringKeyMap json unmarshal: %v", m)
+	}
+	if _, ok := m[NewString("", false)]; ok {
+		t.Error("an empty key should unmarshal as a valid String")
+	}
+
+	_, err = json.Marshal(StringKeyMap[int]{StringFrom("a"): 1, NewString("", false): 0})
+	if !errors.Is(err, errNullMapKey) {
+		t.Errorf("expected null key error, got %v", err)
+	}
+
+	var nilMap StringKeyMap[int]
+	data, err = json.Marshal(nilMap)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "nil StringKeyMap json marshal")
+	m = StringKeyMap[int]{StringFrom("a"): 1}
+	maybePanic(json.Unmarshal(nullJSON, &m))
+	if m != nil {
+		t.Errorf("null should unmarshal as a nil StringKeyMap, got %v", m)
+	}
+
+	// a plain map[String]V still encodes a null key through MarshalText, as ""
+	data, err = json.Marshal(map[String]int{NewString("", false): 0})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"":0}`, "map[String]int json marshal")
+}
@@ -0,0 +1,349 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/randomize"
+)
+
+// StringSlice is a nullable []string, for Postgres text[] and varchar[]
+// columns. It marshals to and from a JSON array, and scans and values the
+// Postgres array literal form, such as {a,b,"c,d"}.
+type StringSlice struct {
+	StringSlice []string
+	Valid       bool
+}
+
+// NewStringSlice creates a new StringSlice
+func NewStringSlice(s []string, valid bool) StringSlice {
+	return StringSlice{
+		StringSlice: s,
+		Valid:       valid,
+	}
+}
+
+// StringSliceFrom creates a new StringSlice that will be invalid if nil.
+func StringSliceFrom(s []string) StringSlice {
+	return NewStringSlice(s, s != nil)
+}
+
+// StringSliceFromPtr creates a new StringSlice that will be invalid if nil.
+func StringSliceFromPtr(s *[]string) StringSlice {
+	if s == nil {
+		return NewStringSlice(nil, false)
+	}
+	return NewStringSlice(*s, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a JSON array of strings. An empty array is valid.
+func (s *StringSlice) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		s.StringSlice = nil
+		s.Valid = false
+		return nil
+	}
+
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		s.StringSlice, s.Valid = nil, false
+		return err
+	}
+	if v == nil {
+		v = []string{}
+	}
+	s.StringSlice = v
+	s.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this StringSlice is null, and otherwise a JSON array.
+func (s StringSlice) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return NullBytes, nil
+	}
+	if s.StringSlice == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.StringSlice)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null StringSlice as chosen by opts.
+func (s StringSlice) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(s)
+}
+
+// SetValid changes this StringSlice's value and also sets it to be non-null.
+func (s *StringSlice) SetValid(v []string) {
+	s.StringSlice = v
+	s.Valid = true
+}
+
+// SetNull sets this StringSlice to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (s *StringSlice) SetNull() {
+	s.StringSlice = nil
+	s.Valid = false
+}
+
+// Ptr returns a pointer to this StringSlice's value, or a nil pointer if this StringSlice is null.
+func (s StringSlice) Ptr() *[]string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.StringSlice
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (s StringSlice) ValueOrZero() []string {
+	if !s.Valid {
+		return nil
+	}
+	return s.StringSlice
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (s StringSlice) ValueOr(def []string) []string {
+	if !s.Valid {
+		return def
+	}
+	return s.StringSlice
+}
+
+// Or returns this StringSlice if it is valid, otherwise other.
+func (s StringSlice) Or(other StringSlice) StringSlice {
+	if !s.Valid {
+		return other
+	}
+	return s
+}
+
+// MustValue returns the inner value, and panics if this StringSlice is null.
+func (s StringSlice) MustValue() []string {
+	if !s.Valid {
+		panic("null.StringSlice: MustValue called on invalid value")
+	}
+	return s.StringSlice
+}
+
+// IsZero returns true if this StringSlice is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (s StringSlice) IsZero() bool {
+	return !s.Valid
+}
+
+// IsNull returns true if this StringSlice is null, the same as !Valid.
+func (s StringSlice) IsNull() bool {
+	return !s.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this StringSlice, or nil if it is null, for
+// a *StringSlice field tagged omitempty that should leave nulls out.
+func (s StringSlice) OmitEmpty() *StringSlice {
+	if !s.Valid {
+		return nil
+	}
+	return &s
+}
+
+// Equal returns true if both StringSlices are null or both hold the same elements.
+func (s StringSlice) Equal(other StringSlice) bool {
+	if s.Valid != other.Valid {
+		return false
+	}
+	if !s.Valid {
+		return true
+	}
+	if len(s.StringSlice) != len(other.StringSlice) {
+		return false
+	}
+	for i := range s.StringSlice {
+		if s.StringSlice[i] != other.StringSlice[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements fmt.Stringer.
+// It returns the quoted elements, such as ["a" "b"], or NullDisplay if this StringSlice is null.
+func (s StringSlice) String() string {
+	if !s.Valid {
+		return NullDisplay
+	}
+	return fmt.Sprintf("%q", s.StringSlice)
+}
+
+// Scan implements the Scanner interface.
+// It accepts a one-dimensional Postgres array literal as a string or []byte.
+// NULL elements cannot be held in a []string and are an error.
+func (s *StringSlice) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case string:
+		s.StringSlice, err = parseStringArray(x)
+	case []byte:
+		s.StringSlice, err = parseStringArray(string(x))
+	case nil:
+		s.StringSlice, s.Valid = nil, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	s.Valid = err == nil
+	return scanError("StringSlice", value, nil, err)
+}
+
+// parseStringArray parses a one-dimensional Postgres array literal. Elements
+// may be double quoted, and backslash escapes the next character. Whitespace
+// around unquoted elements is dropped, as Postgres does.
+func parseStringArray(literal string) ([]string, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("null: invalid array literal for null.StringSlice: %s: %q", reason, literal)
+	}
+
+	t := strings.TrimSpace(literal)
+	if len(t) < 2 || t[0] != '{' || t[len(t)-1] != '}' {
+		return nil, invalid("not enclosed in braces")
+	}
+	body := t[1 : len(t)-1]
+	elems := []string{}
+	if strings.TrimSpace(body) == "" {
+		return elems, nil
+	}
+
+	isSpace := func(c byte) bool {
+		return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+	}
+	i := 0
+	for {
+		for i < len(body) && isSpace(body[i]) {
+			i++
+		}
+
+		var elem strings.Builder
+		var value string
+		if i < len(body) && body[i] == '"' {
+			i++
+			for {
+				if i >= len(body) {
+					return nil, invalid("unterminated quoted element")
+				}
+				c := body[i]
+				i++
+				if c == '"' {
+					break
+				}
+				if c == '\\' {
+					if i >= len(body) {
+						return nil, invalid("unterminated escape")
+					}
+					c = body[i]
+					i++
+				}
+				elem.WriteByte(c)
+			}
+			value = elem.String()
+			for i < len(body) && isSpace(body[i]) {
+				i++
+			}
+		} else {
+			// kept is the length without trailing unescaped whitespace
+			kept, escaped := 0, false
+			for i < len(body) && body[i] != ',' {
+				c := body[i]
+				i++
+				switch c {
+				case '{', '}':
+					return nil, invalid("nested arrays are not supported")
+				case '"':
+					return nil, invalid("unexpected quote")
+				case '\\':
+					if i >= len(body) {
+						return nil, invalid("unterminated escape")
+					}
+					elem.WriteByte(body[i])
+					i++
+					kept, escaped = elem.Len(), true
+					continue
+				}
+				elem.WriteByte(c)
+				if !isSpace(c) {
+					kept = elem.Len()
+				}
+			}
+			value = elem.String()[:kept]
+			if value == "" {
+				return nil, invalid("empty element")
+			}
+			if !escaped && strings.EqualFold(value, "NULL") {
+				return nil, invalid("NULL elements are not supported")
+			}
+		}
+
+		elems = append(elems, value)
+		if i == len(body) {
+			return elems, nil
+		}
+		if body[i] != ',' {
+			return nil, invalid("expected a comma")
+		}
+		i++
+	}
+}
+
+// Value implements the driver Valuer interface.
+// It returns a Postgres array literal with every element quoted.
+func (s StringSlice) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return formatStringArray(s.StringSlice), nil
+}
+
+// ValueOrNil returns nil if this StringSlice is null, otherwise the same value as Value.
+func (s StringSlice) ValueOrNil() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return formatStringArray(s.StringSlice)
+}
+
+// formatStringArray returns elems as a Postgres array literal, quoting every
+// element and escaping quotes and backslashes.
+func formatStringArray(elems []string) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, e := range elems {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('"')
+		for j := 0; j < len(e); j++ {
+			if e[j] == '"' || e[j] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(e[j])
+		}
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// Randomize for sqlboiler
+func (s *StringSlice) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		s.StringSlice = nil
+		s.Valid = false
+	} else {
+		s.StringSlice = []string{randomize.Str(nextInt, 1), randomize.Str(nextInt, 1)}
+		s.Valid = true
+	}
+}
@@ -0,0 +1,169 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+var (
+	stringSliceValue = []string{"a", "b,c", `d"e`, `f\g`, "{h}", " i ", "NULL", ""}
+	stringSliceJSON  = []byte(`["a","b,c","d\"e","f\\g","{h}"," i ","NULL",""]`)
+	stringSliceArray = `{"a","b,c","d\"e","f\\g","{h}"," i ","NULL",""}`
+)
+
+func TestStringSliceFrom(t *testing.T) {
+	assertStringSlice(t, StringSliceFrom(stringSliceValue), "StringSliceFrom()")
+	assertNullStringSlice(t, StringSliceFrom(nil), "StringSliceFrom(nil)")
+
+	empty := StringSliceFrom([]string{})
+	if !empty.Valid {
+		t.Error("StringSliceFrom([]string{})", "is invalid, but should be valid")
+	}
+
+	v := stringSliceValue
+	assertStringSlice(t, StringSliceFromPtr(&v), "StringSliceFromPtr()")
+	assertNullStringSlice(t, StringSliceFromPtr(nil), "StringSliceFromPtr(nil)")
+}
+
+func TestUnmarshalStringSlice(t *testing.T) {
+	var s StringSlice
+	err := json.Unmarshal(stringSliceJSON, &s)
+	maybePanic(err)
+	assertStringSlice(t, s, "array json")
+
+	var empty StringSlice
+	err = json.Unmarshal([]byte(`[]`), &empty)
+	maybePanic(err)
+	if !empty.Valid || empty.StringSlice == nil || len(empty.StringSlice) != 0 {
+		t.Errorf("bad empty array json: %#v", empty)
+	}
+
+	var null StringSlice
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullStringSlice(t, null, "null json")
+
+	var badType StringSlice
+	err = json.Unmarshal(stringJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullStringSlice(t, badType, "wrong type json")
+}
+
+func TestMarshalStringSlice(t *testing.T) {
+	data, err := json.Marshal(StringSliceFrom(stringSliceValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(stringSliceJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(NewStringSlice(nil, true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "[]", "empty json marshal")
+
+	data, err = json.Marshal(NewStringSlice(nil, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestStringSliceScanValue(t *testing.T) {
+	var s StringSlice
+	err := s.Scan([]byte(stringSliceArray))
+	maybePanic(err)
+	assertStringSlice(t, s, "scanned []byte")
+	if v, err := s.Value(); v != stringSliceArray || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var empty StringSlice
+	err = empty.Scan("{}")
+	maybePanic(err)
+	if !empty.Valid || len(empty.StringSlice) != 0 {
+		t.Errorf("bad scanned empty array: %#v", empty)
+	}
+	if v, err := empty.Value(); v != "{}" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null StringSlice
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullStringSlice(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong StringSlice
+	err = wrong.Scan(int64(1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullStringSlice(t, wrong, "scanned wrong")
+}
+
+func TestParseStringArray(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{in: `{}`, want: []string{}},
+		{in: `{a,b,c}`, want: []string{"a", "b", "c"}},
+		{in: `{ a , b b }`, want: []string{"a", "b b"}},
+		{in: `{"a,b","{c}"," d "}`, want: []string{"a,b", "{c}", " d "}},
+		{in: `{"a\"b","c\\d",e\,f,g\ }`, want: []string{`a"b`, `c\d`, "e,f", "g "}},
+		{in: `{"NULL",\NULL}`, want: []string{"NULL", "NULL"}},
+		{in: `{""}`, want: []string{""}},
+	}
+	for _, test := range tests {
+		got, err := parseStringArray(test.in)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.in, err)
+			continue
+		}
+		if !StringSliceFrom(got).Equal(StringSliceFrom(test.want)) {
+			t.Errorf("%s: %q ≠ %q", test.in, got, test.want)
+		}
+	}
+
+	for _, in := range []string{``, `a,b`, `{a,NULL}`, `{{a},{b}}`, `{"a}`, `{a,,b}`, `{a"b}`, `{"a"b}`, `{a\}`} {
+		if _, err := parseStringArray(in); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+	}
+}
+
+func TestStringSliceEqual(t *testing.T) {
+	if !NewStringSlice(nil, false).Equal(NewStringSlice([]string{"a"}, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if !StringSliceFrom([]string{}).Equal(NewStringSlice(nil, true)) {
+		t.Error("Equal() should be true for an empty and a nil valid slice")
+	}
+	if StringSliceFrom([]string{"a"}).Equal(StringSliceFrom([]string{"a", "b"})) {
+		t.Error("Equal() should be false for different slices")
+	}
+}
+
+func TestStringSliceString(t *testing.T) {
+	if s := StringSliceFrom([]string{"a", "b c"}).String(); s != `["a" "b c"]` {
+		t.Errorf("bad String(): %s", s)
+	}
+	if s := fmt.Sprint(NewStringSlice(nil, false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func assertStringSlice(t *testing.T, s StringSlice, from string) {
+	if !s.Equal(StringSliceFrom(stringSliceValue)) {
+		t.Errorf("bad %s []string: %q ≠ %q\n", from, s.StringSlice, stringSliceValue)
+	}
+	if !s.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullStringSlice(t *testing.T, s StringSlice, from string) {
+	if s.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
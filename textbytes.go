@@ -0,0 +1,186 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// TextBytes is a nullable []byte whose JSON form is a plain string of its
+// contents rather than base64. It is meant for text held as bytes; invalid
+// UTF-8 is replaced with U+FFFD when marshaling to JSON. It otherwise behaves
+// like Bytes.
+type TextBytes Bytes
+
+// NewTextBytes creates a new TextBytes
+func NewTextBytes(b []byte, valid bool) TextBytes {
+	return TextBytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// TextBytesFrom creates a new TextBytes that will be invalid if nil.
+func TextBytesFrom(b []byte) TextBytes {
+	return NewTextBytes(b, b != nil)
+}
+
+// TextBytesFromPtr creates a new TextBytes that will be invalid if nil.
+func TextBytesFromPtr(b *[]byte) TextBytes {
+	if b == nil {
+		return NewTextBytes(nil, false)
+	}
+	return NewTextBytes(*b, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON string and keeps its contents as-is.
+func (t *TextBytes) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		t.Valid = false
+		t.Bytes = nil
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Bytes, t.Valid = nil, false
+		return err
+	}
+
+	t.Bytes = []byte(s)
+	t.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TextBytes) UnmarshalText(text []byte) error {
+	return (*Bytes)(t).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this TextBytes is null or empty, and otherwise a
+// JSON string of its contents.
+func (t TextBytes) MarshalJSON() ([]byte, error) {
+	if !t.Valid || len(t.Bytes) == 0 {
+		return NullBytes, nil
+	}
+	return json.Marshal(string(t.Bytes))
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null TextBytes as chosen by opts.
+func (t TextBytes) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TextBytes) MarshalText() ([]byte, error) {
+	return Bytes(t).MarshalText()
+}
+
+// SetValid changes this TextBytes's value and also sets it to be non-null.
+func (t *TextBytes) SetValid(n []byte) {
+	t.Bytes = n
+	t.Valid = true
+}
+
+// SetNull sets this TextBytes to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (t *TextBytes) SetNull() {
+	t.Bytes = nil
+	t.Valid = false
+}
+
+// Ptr returns a pointer to this TextBytes's value, or a nil pointer if this TextBytes is null.
+func (t TextBytes) Ptr() *[]byte {
+	return Bytes(t).Ptr()
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t TextBytes) ValueOrZero() []byte {
+	return Bytes(t).ValueOrZero()
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (t TextBytes) ValueOr(def []byte) []byte {
+	return Bytes(t).ValueOr(def)
+}
+
+// Or returns this TextBytes if it is valid, otherwise other.
+func (t TextBytes) Or(other TextBytes) TextBytes {
+	if !t.Valid {
+		return other
+	}
+	return t
+}
+
+// Clone returns a copy of this TextBytes with its own copy of the bytes, so that
+// changes to one do not show in the other. A null TextBytes clones to a null.
+func (t TextBytes) Clone() TextBytes {
+	if !t.Valid {
+		return NewTextBytes(nil, false)
+	}
+	return NewTextBytes(cloneBytes(t.Bytes), true)
+}
+
+// MustValue returns the inner value, and panics if this TextBytes is null.
+func (t TextBytes) MustValue() []byte {
+	if !t.Valid {
+		panic("null.TextBytes: MustValue called on invalid value")
+	}
+	return t.Bytes
+}
+
+// IsZero returns true if this TextBytes is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (t TextBytes) IsZero() bool {
+	return !t.Valid
+}
+
+// IsNull returns true if this TextBytes is null, the same as !Valid.
+func (t TextBytes) IsNull() bool {
+	return !t.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this TextBytes, or nil if it is null, for
+// a *TextBytes field tagged omitempty that should leave nulls out.
+func (t TextBytes) OmitEmpty() *TextBytes {
+	if !t.Valid {
+		return nil
+	}
+	return &t
+}
+
+// Equal returns true if both TextBytes are null or both hold the same value.
+func (t TextBytes) Equal(other TextBytes) bool {
+	return Bytes(t).Equal(Bytes(other))
+}
+
+// String implements fmt.Stringer.
+// It returns the contents as a string, or NullDisplay if this TextBytes is null.
+func (t TextBytes) String() string {
+	if !t.Valid {
+		return NullDisplay
+	}
+	return string(t.Bytes)
+}
+
+// Scan implements the Scanner interface.
+func (t *TextBytes) Scan(value interface{}) error {
+	return (*Bytes)(t).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (t TextBytes) Value() (driver.Value, error) {
+	return Bytes(t).Value()
+}
+
+// ValueOrNil returns nil if this TextBytes is null, otherwise the same value as Value.
+func (t TextBytes) ValueOrNil() interface{} {
+	return Bytes(t).ValueOrNil()
+}
+
+// Randomize for sqlboiler
+func (t *TextBytes) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	(*Bytes)(t).Randomize(nextInt, fieldType, shouldBeNull)
+}
@@ -0,0 +1,130 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+var textBytesJSON = []byte(`"hello"`)
+
+func TestTextBytesFrom(t *testing.T) {
+	b := TextBytesFrom([]byte("hello"))
+	assertTextBytes(t, b, "TextBytesFrom()")
+
+	null := TextBytesFrom(nil)
+	assertNullTextBytes(t, null, "TextBytesFrom(nil)")
+
+	v := []byte("hello")
+	assertTextBytes(t, TextBytesFromPtr(&v), "TextBytesFromPtr()")
+	assertNullTextBytes(t, TextBytesFromPtr(nil), "TextBytesFromPtr(nil)")
+}
+
+func TestUnmarshalTextBytes(t *testing.T) {
+	var b TextBytes
+	err := json.Unmarshal(textBytesJSON, &b)
+	maybePanic(err)
+	assertTextBytes(t, b, "string json")
+
+	var base64 TextBytes
+	err = json.Unmarshal(bytesJSON, &base64)
+	maybePanic(err)
+	if string(base64.Bytes) != "aGVsbG8=" {
+		t.Errorf("base64 should be kept as-is, got %q", base64.Bytes)
+	}
+
+	var null TextBytes
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullTextBytes(t, null, "null json")
+
+	var badType TextBytes
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullTextBytes(t, badType, "wrong type json")
+}
+
+func TestMarshalTextBytes(t *testing.T) {
+	b := TextBytesFrom([]byte("hello"))
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(textBytesJSON), "non-empty json marshal")
+
+	var roundTrip TextBytes
+	err = json.Unmarshal(data, &roundTrip)
+	maybePanic(err)
+	assertTextBytes(t, roundTrip, "json round trip")
+
+	quoted := TextBytesFrom([]byte(`say "hi"`))
+	data, err = json.Marshal(quoted)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"say \"hi\""`, "escaped json marshal")
+
+	// invalid values should be encoded as null
+	null := NewTextBytes(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestTextBytesScanValue(t *testing.T) {
+	var b TextBytes
+	err := b.Scan([]byte("hello"))
+	maybePanic(err)
+	assertTextBytes(t, b, "scanned []byte")
+	if v, err := b.Value(); string(v.([]byte)) != "hello" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null TextBytes
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullTextBytes(t, null, "scanned null")
+}
+
+func TestTextBytesSetNull(t *testing.T) {
+	change := TextBytesFrom([]byte("hello"))
+	change.SetNull()
+	assertNullTextBytes(t, change, "SetNull()")
+	if change.Bytes != nil {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Bytes)
+	}
+}
+
+func TestTextBytesString(t *testing.T) {
+	if s := TextBytesFrom([]byte("hello")).String(); s != "hello" {
+		t.Errorf("bad String(): %q", s)
+	}
+	if s := fmt.Sprint(NewTextBytes(nil, false)); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func assertTextBytes(t *testing.T, b TextBytes, from string) {
+	if string(b.Bytes) != "hello" {
+		t.Errorf("bad %s []byte: %q ≠ %q\n", from, b.Bytes, "hello")
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullTextBytes(t *testing.T, b TextBytes, from string) {
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func TestTextBytesClone(t *testing.T) {
+	orig := TextBytesFrom([]byte("hello"))
+	clone := orig.Clone()
+	orig.Bytes[0] = 'j'
+	if !clone.Valid || string(clone.Bytes) != "hello" {
+		t.Errorf("clone should be unchanged: %q", clone.Bytes)
+	}
+	if null := NewTextBytes([]byte("stale"), false).Clone(); null.Valid || null.Bytes != nil {
+		t.Errorf("bad clone of null TextBytes: %#v", null)
+	}
+}
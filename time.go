@@ -2,10 +2,13 @@ package null
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/xml"
 	"fmt"
 	"time"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/sqlboiler/randomize"
 )
 
@@ -36,6 +39,36 @@ func TimeFromPtr(t *time.Time) Time {
 	return NewTime(*t, true)
 }
 
+// TimeFromSQL creates a new Time from the database/sql NullTime n.
+func TimeFromSQL(n sql.NullTime) Time {
+	return NewTime(n.Time, n.Valid)
+}
+
+// TimeFromUnix creates a new Time, in UTC, from seconds since the Unix epoch.
+// It will always be valid.
+func TimeFromUnix(sec int64) Time {
+	return NewTime(time.Unix(sec, 0).UTC(), true)
+}
+
+// TimeFromUnixMilli creates a new Time, in UTC, from milliseconds since the
+// Unix epoch. It will always be valid.
+func TimeFromUnixMilli(ms int64) Time {
+	return NewTime(time.UnixMilli(ms).UTC(), true)
+}
+
+// ParseTime parses an RFC3339 time such as "2020-01-01T00:00:00Z" into a valid Time, as UnmarshalText
+// does, but returns an error rather than a null Time for empty text.
+func ParseTime(s string) (Time, error) {
+	return parseText[Time]("Time", s)
+}
+
+// MustTime is like ParseTime, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustTime(s string) Time {
+	v, err := ParseTime(s)
+	return mustParse("MustTime", s, v, err)
+}
+
 // MarshalJSON implements json.Marshaler.
 func (t Time) MarshalJSON() ([]byte, error) {
 	if !t.Valid {
@@ -44,6 +77,14 @@ func (t Time) MarshalJSON() ([]byte, error) {
 	return t.Time.MarshalJSON()
 }
 
+// MarshalJSONWith is like MarshalJSON, but encodes a null Time as chosen by opts.
+func (t Time) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	if opts.TimeInUTC {
+		t = t.InUTC()
+	}
+	return opts.marshalJSON(t)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (t *Time) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
@@ -53,6 +94,7 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	}
 
 	if err := t.Time.UnmarshalJSON(data); err != nil {
+		t.Time, t.Valid = time.Time{}, false
 		return err
 	}
 
@@ -60,14 +102,159 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Time
+// to def and makes it valid, rather than null.
+func (t *Time) UnmarshalJSONDefault(data []byte, def time.Time) error {
+	if bytes.Equal(data, NullBytes) {
+		t.SetValid(def)
+		return nil
+	}
+	return t.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONUnix is like UnmarshalJSON, but also accepts a bare JSON
+// integer counting units since the Unix epoch, such as 1700000000 with unit
+// time.Second or 1700000000000 with time.Millisecond. The time is in UTC. A
+// JSON string is still decoded as RFC 3339 and a JSON null is null. unit must
+// be a whole number of seconds or divide a second evenly.
+func (t *Time) UnmarshalJSONUnix(data []byte, unit time.Duration) error {
+	if len(data) == 0 || data[0] == '"' || bytes.Equal(data, NullBytes) {
+		return t.UnmarshalJSON(data)
+	}
+	if unit <= 0 || (unit%time.Second != 0 && time.Second%unit != 0) {
+		return fmt.Errorf("null: unsupported Unix time unit %v for null.Time", unit)
+	}
+
+	n, _, err := parseJSONInt(data, 64, "Time")
+	if err != nil {
+		t.Time, t.Valid = time.Time{}, false
+		return err
+	}
+	if unit >= time.Second {
+		t.Time = time.Unix(n*int64(unit/time.Second), 0).UTC()
+	} else {
+		perSecond := int64(time.Second / unit)
+		t.Time = time.Unix(n/perSecond, n%perSecond*int64(unit)).UTC()
+	}
+	t.Valid = true
+	return nil
+}
+
 // MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Time is null.
 func (t Time) MarshalText() ([]byte, error) {
 	if !t.Valid {
-		return NullBytes, nil
+		return []byte{}, nil
 	}
 	return t.Time.MarshalText()
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Time is null.
+func (t Time) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, t, t.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (t *Time) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, t)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Time is null.
+func (t Time) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (t *Time) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *time.Time
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		t.Time = time.Time{}
+		t.Valid = false
+		return nil
+	}
+	t.Time = *v
+	t.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Time is null.
+func (t Time) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !t.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeTime(t.Time)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (t *Time) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *time.Time
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		t.Time = time.Time{}
+		t.Valid = false
+		return nil
+	}
+	t.Time = *v
+	t.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Time is encoded as a single byte.
+// Valid values use time.Time's binary encoding, which keeps the zone offset.
+func (t Time) MarshalBinary() ([]byte, error) {
+	if !t.Valid {
+		return []byte{encodedNull}, nil
+	}
+	b, err := t.Time.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{encodedValid}, b...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Time")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		t.Time = time.Time{}
+		t.Valid = false
+		return nil
+	}
+	if err := t.Time.UnmarshalBinary(value); err != nil {
+		return err
+	}
+	t.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (t *Time) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (t *Time) UnmarshalText(text []byte) error {
 	if text == nil || len(text) == 0 {
@@ -87,6 +274,13 @@ func (t *Time) SetValid(v time.Time) {
 	t.Valid = true
 }
 
+// SetNull sets this Time to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (t *Time) SetNull() {
+	t.Time = time.Time{}
+	t.Valid = false
+}
+
 // Ptr returns a pointer to this Time's value, or a nil pointer if this Time is null.
 func (t Time) Ptr() *time.Time {
 	if !t.Valid {
@@ -95,20 +289,197 @@ func (t Time) Ptr() *time.Time {
 	return &t.Time
 }
 
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t Time) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (t Time) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
+// Or returns this Time if it is valid, otherwise other.
+func (t Time) Or(other Time) Time {
+	if !t.Valid {
+		return other
+	}
+	return t
+}
+
+// MustValue returns the inner value, and panics if this Time is null.
+func (t Time) MustValue() time.Time {
+	if !t.Valid {
+		panic("null.Time: MustValue called on invalid value")
+	}
+	return t.Time
+}
+
+// IsZero returns true if this Time is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+// A valid zero time.Time is not zero.
+func (t Time) IsZero() bool {
+	return !t.Valid
+}
+
+// IsNull returns true if this Time is null, the same as !Valid.
+func (t Time) IsNull() bool {
+	return !t.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Time, or nil if it is null, for
+// a *Time field tagged omitempty that should leave nulls out.
+func (t Time) OmitEmpty() *Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t
+}
+
+// Equal returns true if both Times are null or both hold the same instant.
+func (t Time) Equal(other Time) bool {
+	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
+}
+
+// Compare returns -1 if this Time sorts before other, 0 if they are equal
+// and +1 if it sorts after. A null Time sorts before every valid one, like
+// SQL's NULLS FIRST, so it can be used with sort.Slice and slices.SortFunc.
+func (t Time) Compare(other Time) int {
+	switch {
+	case !t.Valid && !other.Valid:
+		return 0
+	case !t.Valid:
+		return -1
+	case !other.Valid:
+		return 1
+	}
+	switch {
+	case t.Time.Before(other.Time):
+		return -1
+	case t.Time.After(other.Time):
+		return 1
+	}
+	return 0
+}
+
+// Before reports whether this Time is before other. ok is false, and before
+// is false, if either is null, so that a null never compares as before or
+// after anything, unlike the zero time.Time.
+func (t Time) Before(other Time) (before, ok bool) {
+	if !t.Valid || !other.Valid {
+		return false, false
+	}
+	return t.Time.Before(other.Time), true
+}
+
+// After is like Before, but reports whether this Time is after other.
+func (t Time) After(other Time) (after, ok bool) {
+	if !t.Valid || !other.Valid {
+		return false, false
+	}
+	return t.Time.After(other.Time), true
+}
+
+// Sub returns the duration t-other, like time.Time.Sub. ok is false, and the
+// duration is zero, if either is null.
+func (t Time) Sub(other Time) (d time.Duration, ok bool) {
+	if !t.Valid || !other.Valid {
+		return 0, false
+	}
+	return t.Time.Sub(other.Time), true
+}
+
+// Unix returns this Time as seconds since the Unix epoch, like
+// time.Time.Unix. ok is false, and sec is zero, if this Time is null.
+func (t Time) Unix() (sec int64, ok bool) {
+	if !t.Valid {
+		return 0, false
+	}
+	return t.Time.Unix(), true
+}
+
+// UnixMilli returns this Time as milliseconds since the Unix epoch, like
+// time.Time.UnixMilli. ok is false, and ms is zero, if this Time is null.
+func (t Time) UnixMilli() (ms int64, ok bool) {
+	if !t.Valid {
+		return 0, false
+	}
+	return t.Time.UnixMilli(), true
+}
+
+// InUTC returns a copy of this Time with its value in UTC. It holds the same
+// instant, but marshals with a "Z" offset whatever location it was scanned or
+// parsed with. A null Time is returned unchanged.
+func (t Time) InUTC() Time {
+	if !t.Valid {
+		return t
+	}
+	return NewTime(t.Time.UTC(), true)
+}
+
+// String implements fmt.Stringer.
+// It returns the time formatted as RFC3339, or NullDisplay if this Time is null.
+func (t Time) String() string {
+	if !t.Valid {
+		return NullDisplay
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
 // Scan implements the Scanner interface.
+// Besides time.Time it accepts a string or []byte timestamp in one of the
+// timestampLayouts, as returned for DATETIME columns by some MySQL and SQLite
+// drivers.
 func (t *Time) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	if value == nil {
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	}
 	var err error
+	t.Time, err = scanTime(value)
+	t.Valid = err == nil
+	return scanError("Time", value, nil, err)
+}
+
+// scanTime converts a non-nil value passed to Scan into a time.Time, returning
+// the zero time and an error if it cannot.
+func scanTime(value interface{}) (time.Time, error) {
 	switch x := value.(type) {
 	case time.Time:
-		t.Time = x
-	case nil:
-		t.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("null: cannot scan type %T into null.Time: %v", value, value)
+		return x, nil
+	case string:
+		return parseTimestamp(x)
+	case []byte:
+		return parseTimestamp(string(x))
 	}
-	t.Valid = err == nil
-	return err
+	return time.Time{}, errUnsupportedScanType
+}
+
+// timestampLayouts are the layouts accepted by Scan, tried in order.
+// Timestamps without a zone are parsed as UTC. Fractional seconds are
+// accepted after the seconds field of any layout.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	time.DateOnly,
+}
+
+func parseTimestamp(s string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if ti, err := time.Parse(layout, s); err == nil {
+			return ti, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("null: cannot parse %q as a timestamp for null.Time", s)
 }
 
 // Value implements the driver Valuer interface.
@@ -119,6 +490,19 @@ func (t Time) Value() (driver.Value, error) {
 	return t.Time, nil
 }
 
+// ValueOrNil returns nil if this Time is null, otherwise the same value as Value.
+func (t Time) ValueOrNil() interface{} {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time
+}
+
+// ToSQL returns this Time as a database/sql NullTime.
+func (t Time) ToSQL() sql.NullTime {
+	return sql.NullTime{Time: t.Time, Valid: t.Valid}
+}
+
 // Randomize for sqlboiler
 func (t *Time) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
@@ -2,8 +2,12 @@ package null
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 	"testing"
 	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
 )
 
 var (
@@ -58,6 +62,11 @@ func TestUnmarshalTimeText(t *testing.T) {
 	maybePanic(err)
 	assertTime(t, unmarshal, "unmarshal text")
 
+	null := NewTime(timeValue, false)
+	txt, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "", "null marshal text")
+
 	var invalid Time
 	err = invalid.UnmarshalText([]byte("hello world"))
 	if err == nil {
@@ -99,6 +108,15 @@ func TestTimeSetValid(t *testing.T) {
 	assertTime(t, change, "SetValid()")
 }
 
+func TestTimeSetNull(t *testing.T) {
+	change := TimeFrom(timeValue)
+	change.SetNull()
+	assertNullTime(t, change, "SetNull()")
+	if !change.Time.IsZero() {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Time)
+	}
+}
+
 func TestTimePointer(t *testing.T) {
 	ti := TimeFrom(timeValue)
 	ptr := ti.Ptr()
@@ -114,6 +132,18 @@ func TestTimePointer(t *testing.T) {
 	}
 }
 
+func TestTimeIsZero(t *testing.T) {
+	if TimeFrom(timeValue).IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+	if TimeFrom(time.Time{}).IsZero() {
+		t.Errorf("IsZero() should be false for a valid zero time")
+	}
+	if !NewTime(timeValue, false).IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
 func TestTimeScanValue(t *testing.T) {
 	var ti Time
 	err := ti.Scan(timeValue)
@@ -139,6 +169,236 @@ func TestTimeScanValue(t *testing.T) {
 	assertNullTime(t, wrong, "scanned wrong")
 }
 
+func TestTimeEqual(t *testing.T) {
+	null := NewTime(time.Time{}, false)
+	other := NewTime(timeValue, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := TimeFrom(timeValue)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := TimeFrom(timeValue)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	zoned := TimeFrom(timeValue.In(time.FixedZone("UTC+1", 60*60)))
+	if !valid.Equal(zoned) {
+		t.Error("Equal() should be true for the same instant in different locations")
+	}
+
+	different := TimeFrom(timeValue.Add(time.Hour))
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestTimeCompare(t *testing.T) {
+	null := NewTime(time.Time{}, false)
+	earlier := TimeFrom(timeValue.Add(-time.Hour))
+	later := TimeFrom(timeValue.Add(time.Hour))
+	vals := []Time{later, null, TimeFrom(timeValue), earlier}
+	sort.Slice(vals, func(i, j int) bool { return vals[i].Compare(vals[j]) < 0 })
+	want := []Time{null, earlier, TimeFrom(timeValue), later}
+	for i := range want {
+		if !vals[i].Equal(want[i]) {
+			t.Errorf("bad sorted value %d: %v ≠ %v", i, vals[i], want[i])
+		}
+	}
+
+	// the same instant in another zone is equal
+	if c := TimeFrom(timeValue).Compare(TimeFrom(timeValue.In(time.FixedZone("UTC+1", 3600)))); c != 0 {
+		t.Errorf("bad Compare() of the same instant: %d", c)
+	}
+}
+
+func TestTimeBeforeAfterSub(t *testing.T) {
+	earlier, later := TimeFrom(timeValue), TimeFrom(timeValue.Add(time.Hour))
+	null := NewTime(time.Time{}, false)
+
+	if before, ok := earlier.Before(later); !before || !ok {
+		t.Errorf("Before() should be true, true: %v, %v", before, ok)
+	}
+	if before, ok := later.Before(earlier); before || !ok {
+		t.Errorf("Before() should be false, true: %v, %v", before, ok)
+	}
+	if after, ok := later.After(earlier); !after || !ok {
+		t.Errorf("After() should be true, true: %v, %v", after, ok)
+	}
+	if after, ok := earlier.After(earlier); after || !ok {
+		t.Errorf("After() of an equal Time should be false, true: %v, %v", after, ok)
+	}
+	if d, ok := later.Sub(earlier); d != time.Hour || !ok {
+		t.Errorf("bad Sub(): %v, %v", d, ok)
+	}
+	if d, ok := earlier.Sub(later); d != -time.Hour || !ok {
+		t.Errorf("bad negative Sub(): %v, %v", d, ok)
+	}
+
+	// a null is neither before nor after anything, even the zero time
+	zero := TimeFrom(time.Time{})
+	for _, pair := range [][2]Time{{null, earlier}, {earlier, null}, {null, null}, {null, zero}} {
+		if before, ok := pair[0].Before(pair[1]); before || ok {
+			t.Errorf("Before(%v, %v) should be false, false", pair[0], pair[1])
+		}
+		if after, ok := pair[0].After(pair[1]); after || ok {
+			t.Errorf("After(%v, %v) should be false, false", pair[0], pair[1])
+		}
+		if d, ok := pair[0].Sub(pair[1]); d != 0 || ok {
+			t.Errorf("Sub(%v, %v) should be 0, false", pair[0], pair[1])
+		}
+	}
+}
+
+func TestTimeInUTC(t *testing.T) {
+	eastern := time.FixedZone("UTC-5", -5*60*60)
+	local := TimeFrom(timeValue.In(eastern))
+	utc := TimeFrom(timeValue)
+
+	data, err := json.Marshal(local)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T16:21:21-05:00"`, "zoned json marshal")
+
+	data, err = json.Marshal(local.InUTC())
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeJSON), "InUTC() json marshal")
+	if !local.InUTC().Equal(local) {
+		t.Error("InUTC() should hold the same instant")
+	}
+	if local.Time.Location() != eastern {
+		t.Error("InUTC() should not change the receiver")
+	}
+
+	opts := MarshalOptions{TimeInUTC: true}
+	a, err := local.MarshalJSONWith(opts)
+	maybePanic(err)
+	b, err := utc.MarshalJSONWith(opts)
+	maybePanic(err)
+	assertJSONEquals(t, a, string(b), "TimeInUTC marshal")
+	assertJSONEquals(t, a, string(timeJSON), "TimeInUTC marshal")
+
+	ft := FormattedTimeFrom(timeValue.In(eastern), time.RFC3339)
+	data, err = ft.MarshalJSONWith(opts)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeJSON), "TimeInUTC FormattedTime marshal")
+
+	null := NewTime(time.Time{}, false)
+	assertNullTime(t, null.InUTC(), "InUTC() null")
+	data, err = null.MarshalJSONWith(opts)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "TimeInUTC null marshal")
+}
+
+func TestTimeValueOr(t *testing.T) {
+	valid := TimeFrom(timeValue)
+	if !valid.ValueOrZero().Equal(timeValue) {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if !valid.ValueOr(timeValue.Add(time.Hour)).Equal(timeValue) {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(timeValue.Add(time.Hour)))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewTime(timeValue, false)
+	if !null.ValueOrZero().Equal(time.Time{}) {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if !null.ValueOr(timeValue.Add(time.Hour)).Equal(timeValue.Add(time.Hour)) {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(timeValue.Add(time.Hour)))
+	}
+}
+
+func TestTimeString(t *testing.T) {
+	ti := TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 500, time.UTC))
+	if s := ti.String(); s != "2012-12-21T21:21:21Z" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewTime(time.Time{}, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestTimeMsgpack(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	data, err := msgpack.Marshal(ti)
+	maybePanic(err)
+
+	var v time.Time
+	err = msgpack.Unmarshal(data, &v)
+	maybePanic(err)
+	if !v.Equal(timeValue) {
+		t.Errorf("bad msgpack time: %v", v)
+	}
+
+	// msgpack decodes times in the local time zone
+	var out Time
+	err = msgpack.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.Valid || !out.Time.Equal(timeValue) {
+		t.Errorf("bad msgpack null.Time: %#v", out)
+	}
+
+	data, err = msgpack.Marshal(NewTime(time.Time{}, false))
+	maybePanic(err)
+	null := TimeFrom(timeValue)
+	err = msgpack.Unmarshal(data, &null)
+	maybePanic(err)
+	assertNullTime(t, null, "msgpack nil")
+}
+
+func TestTimeValueOrNil(t *testing.T) {
+	assertValueOrNil(t, TimeFrom(timeValue), "valid")
+	assertValueOrNil(t, NewTime(time.Time{}, false), "null")
+}
+
+func TestTimeMustValue(t *testing.T) {
+	v := TimeFrom(timeValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewTime(time.Time{}, false).MustValue() }, "Time")
+}
+
+func TestTimeScanText(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want time.Time
+	}{
+		{in: "2012-12-21 21:21:21", want: timeValue},
+		{in: []byte("2012-12-21 21:21:21"), want: timeValue},
+		{in: "2012-12-21 21:21:21.123456", want: timeValue.Add(123456 * time.Microsecond)},
+		{in: []byte("2012-12-21 21:21:21.5"), want: timeValue.Add(500 * time.Millisecond)},
+		{in: "2012-12-21T21:21:21", want: timeValue},
+		{in: timeString, want: timeValue},
+		{in: []byte("2012-12-21T23:21:21.25+02:00"), want: timeValue.Add(250 * time.Millisecond)},
+		{in: "2012-12-21 23:21:21+02:00", want: timeValue},
+		{in: "2012-12-21", want: time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		var ti Time
+		err := ti.Scan(test.in)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.in, err)
+		}
+		if !ti.Valid || !ti.Time.Equal(test.want) {
+			t.Errorf("%s: bad scanned time: %v ≠ %v", test.in, ti.Time, test.want)
+		}
+	}
+
+	for _, in := range []interface{}{"", "hello", []byte("2012-13-45 25:00:00"), "21/12/2012"} {
+		var ti Time
+		err := ti.Scan(in)
+		if err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+		assertNullTime(t, ti, "scanned bad timestamp")
+	}
+}
+
 func assertTime(t *testing.T, ti Time, from string) {
 	if ti.Time != timeValue {
 		t.Errorf("bad %v time: %v ≠ %v\n", from, ti.Time, timeValue)
@@ -153,3 +413,87 @@ func assertNullTime(t *testing.T, ti Time, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestTimeUnix(t *testing.T) {
+	sec := TimeFromUnix(1700000000)
+	if !sec.Valid || !sec.Time.Equal(time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)) || sec.Time.Location() != time.UTC {
+		t.Errorf("bad TimeFromUnix(): %v", sec)
+	}
+	if s, ok := sec.Unix(); !ok || s != 1700000000 {
+		t.Errorf("bad Unix(): %v, %v", s, ok)
+	}
+
+	ms := TimeFromUnixMilli(1700000000123)
+	if !ms.Valid || ms.Time.Nanosecond() != 123000000 || ms.Time.Unix() != 1700000000 {
+		t.Errorf("bad TimeFromUnixMilli(): %v", ms)
+	}
+	if m, ok := ms.UnixMilli(); !ok || m != 1700000000123 {
+		t.Errorf("bad UnixMilli(): %v, %v", m, ok)
+	}
+	// seconds drop the milliseconds
+	if s, ok := ms.Unix(); !ok || s != 1700000000 {
+		t.Errorf("bad Unix() of milliseconds: %v, %v", s, ok)
+	}
+
+	pre := TimeFromUnixMilli(-1500)
+	if m, _ := pre.UnixMilli(); m != -1500 || pre.Time.Unix() != -2 {
+		t.Errorf("bad TimeFromUnixMilli() before the epoch: %v", pre)
+	}
+
+	null := NewTime(timeValue, false)
+	if s, ok := null.Unix(); ok || s != 0 {
+		t.Errorf("bad null Unix(): %v, %v", s, ok)
+	}
+	if m, ok := null.UnixMilli(); ok || m != 0 {
+		t.Errorf("bad null UnixMilli(): %v, %v", m, ok)
+	}
+}
+
+func TestUnmarshalJSONUnix(t *testing.T) {
+	tests := []struct {
+		data string
+		unit time.Duration
+		want time.Time
+	}{
+		{`1700000000`, time.Second, time.Unix(1700000000, 0)},
+		{`1700000000123`, time.Millisecond, time.UnixMilli(1700000000123)},
+		{`-1500`, time.Millisecond, time.UnixMilli(-1500)},
+		{`1700000000123456`, time.Microsecond, time.UnixMicro(1700000000123456)},
+		{`28333333`, time.Minute, time.Unix(28333333*60, 0)},
+		{`"2023-11-14T22:13:20Z"`, time.Millisecond, time.Unix(1700000000, 0)},
+	}
+	for _, test := range tests {
+		var ti Time
+		err := ti.UnmarshalJSONUnix([]byte(test.data), test.unit)
+		maybePanic(err)
+		if !ti.Valid || !ti.Time.Equal(test.want) {
+			t.Errorf("%s in %v: %v ≠ %v", test.data, test.unit, ti.Time, test.want)
+		}
+	}
+
+	var null Time
+	err := null.UnmarshalJSONUnix(nullJSON, time.Second)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should be null")
+	}
+
+	for _, data := range []string{`1.5`, `true`, `1e3`} {
+		bad := TimeFrom(timeValue)
+		if err := bad.UnmarshalJSONUnix([]byte(data), time.Second); err == nil {
+			t.Errorf("%s: expected error", data)
+		}
+		if bad.Valid {
+			t.Errorf("%s: should be null after an error", data)
+		}
+	}
+
+	// a string is RFC 3339, not an epoch
+	var ti Time
+	if err := ti.UnmarshalJSONUnix([]byte(`"1700000000"`), time.Second); err == nil {
+		t.Error("expected error for an epoch in a string")
+	}
+	if err := ti.UnmarshalJSONUnix([]byte(`1`), 7*time.Millisecond/3); err == nil {
+		t.Error("expected error for an unsupported unit")
+	}
+}
@@ -0,0 +1,184 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"time"
+)
+
+// TimeStringLayout is the layout TimeString values are stored in.
+const TimeStringLayout = "2006-01-02 15:04:05.999999"
+
+// TimeString is a nullable time.Time stored in the database as a UTC string in
+// TimeStringLayout, such as "2012-12-21 21:21:21.123456", rather than passed
+// to the driver as a time.Time. The same instant then reads the same way in
+// MySQL, Postgres and SQLite whatever their time zone settings. Fractional
+// seconds past microseconds are dropped, and trailing zeros are not written.
+// It otherwise behaves like Time, which keeps returning a time.Time from Value.
+type TimeString Time
+
+// NewTimeString creates a new TimeString.
+func NewTimeString(t time.Time, valid bool) TimeString {
+	return TimeString{
+		Time:  t,
+		Valid: valid,
+	}
+}
+
+// TimeStringFrom creates a new TimeString that will always be valid.
+func TimeStringFrom(t time.Time) TimeString {
+	return NewTimeString(t, true)
+}
+
+// TimeStringFromPtr creates a new TimeString that will be null if t is nil.
+func TimeStringFromPtr(t *time.Time) TimeString {
+	if t == nil {
+		return NewTimeString(time.Time{}, false)
+	}
+	return NewTimeString(*t, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the same input as Time.UnmarshalJSON.
+func (t *TimeString) UnmarshalJSON(data []byte) error {
+	return (*Time)(t).UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the same input as Time.UnmarshalText.
+func (t *TimeString) UnmarshalText(text []byte) error {
+	return (*Time)(t).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It encodes the same RFC3339 string as Time, not TimeStringLayout.
+func (t TimeString) MarshalJSON() ([]byte, error) {
+	return Time(t).MarshalJSON()
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null TimeString as chosen by opts.
+func (t TimeString) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the same RFC3339 text as Time, or an empty string if null.
+func (t TimeString) MarshalText() ([]byte, error) {
+	return Time(t).MarshalText()
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this TimeString is null.
+func (t TimeString) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, t, t.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (t *TimeString) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, t)
+}
+
+// SetValid changes this TimeString's value and sets it to be non-null.
+func (t *TimeString) SetValid(v time.Time) {
+	t.Time = v
+	t.Valid = true
+}
+
+// SetNull sets this TimeString to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (t *TimeString) SetNull() {
+	t.Time = time.Time{}
+	t.Valid = false
+}
+
+// Ptr returns a pointer to this TimeString's value, or a nil pointer if this TimeString is null.
+func (t TimeString) Ptr() *time.Time {
+	return Time(t).Ptr()
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t TimeString) ValueOrZero() time.Time {
+	return Time(t).ValueOrZero()
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (t TimeString) ValueOr(def time.Time) time.Time {
+	return Time(t).ValueOr(def)
+}
+
+// Or returns this TimeString if it is valid, otherwise other.
+func (t TimeString) Or(other TimeString) TimeString {
+	if !t.Valid {
+		return other
+	}
+	return t
+}
+
+// MustValue returns the inner value, and panics if this TimeString is null.
+func (t TimeString) MustValue() time.Time {
+	if !t.Valid {
+		panic("null.TimeString: MustValue called on invalid value")
+	}
+	return t.Time
+}
+
+// IsZero returns true if this TimeString is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (t TimeString) IsZero() bool {
+	return !t.Valid
+}
+
+// IsNull returns true if this TimeString is null, the same as !Valid.
+func (t TimeString) IsNull() bool {
+	return !t.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this TimeString, or nil if it is null, for
+// a *TimeString field tagged omitempty that should leave nulls out.
+func (t TimeString) OmitEmpty() *TimeString {
+	if !t.Valid {
+		return nil
+	}
+	return &t
+}
+
+// Equal returns true if both TimeStrings are null or both hold the same instant.
+func (t TimeString) Equal(other TimeString) bool {
+	return Time(t).Equal(Time(other))
+}
+
+// String implements fmt.Stringer.
+// It returns the time formatted as RFC3339, or NullDisplay if this TimeString is null.
+func (t TimeString) String() string {
+	return Time(t).String()
+}
+
+// Scan implements the Scanner interface.
+// It accepts the same values as Time.Scan, including a string in
+// TimeStringLayout, which is read as UTC.
+func (t *TimeString) Scan(value interface{}) error {
+	return (*Time)(t).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the time in UTC as a string in TimeStringLayout.
+func (t TimeString) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.UTC().Format(TimeStringLayout), nil
+}
+
+// ValueOrNil returns nil if this TimeString is null, otherwise the same value as Value.
+func (t TimeString) ValueOrNil() interface{} {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time.UTC().Format(TimeStringLayout)
+}
+
+// Randomize for sqlboiler
+func (t *TimeString) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	(*Time)(t).Randomize(nextInt, fieldType, shouldBeNull)
+}
@@ -0,0 +1,93 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeStringValue(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		in   time.Time
+		want string
+	}{
+		{time.Date(2012, 12, 22, 6, 21, 21, 0, tokyo), "2012-12-21 21:21:21"},
+		{time.Date(2012, 12, 21, 21, 21, 21, 123456000, time.UTC), "2012-12-21 21:21:21.123456"},
+		{time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.UTC), "2012-12-21 21:21:21.123456"},
+		{time.Date(2012, 12, 21, 21, 21, 21, 500000000, time.UTC), "2012-12-21 21:21:21.5"},
+		{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), "0001-01-01 00:00:00"},
+	}
+	for _, test := range tests {
+		ts := TimeStringFrom(test.in)
+		if v, err := ts.Value(); v != test.want || err != nil {
+			t.Errorf("%v: bad value or err: %#v, %v", test.in, v, err)
+		}
+		if v := ts.ValueOrNil(); v != test.want {
+			t.Errorf("%v: bad ValueOrNil(): %#v", test.in, v)
+		}
+	}
+
+	if v, err := NewTimeString(timeValue, false).Value(); v != nil || err != nil {
+		t.Error("bad null value or err:", v, err)
+	}
+	// Time itself still hands the driver a time.Time
+	if v, err := TimeFrom(timeValue).Value(); v != timeValue || err != nil {
+		t.Error("bad Time value or err:", v, err)
+	}
+}
+
+func TestTimeStringScan(t *testing.T) {
+	var ts TimeString
+	err := ts.Scan("2012-12-21 21:21:21.123456")
+	maybePanic(err)
+	want := time.Date(2012, 12, 21, 21, 21, 21, 123456000, time.UTC)
+	if !ts.Valid || !ts.Time.Equal(want) {
+		t.Errorf("bad scanned string: %v", ts)
+	}
+	if v, err := ts.Value(); v != "2012-12-21 21:21:21.123456" || err != nil {
+		t.Error("bad round trip value or err:", v, err)
+	}
+
+	var ti TimeString
+	err = ti.Scan(timeValue)
+	maybePanic(err)
+	if !ti.Valid || !ti.Time.Equal(timeValue) {
+		t.Errorf("bad scanned time.Time: %v", ti)
+	}
+
+	null := TimeStringFrom(timeValue)
+	err = null.Scan(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned nil should be null")
+	}
+}
+
+func TestTimeStringJSON(t *testing.T) {
+	data, err := json.Marshal(TimeStringFrom(timeValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeJSON), "non-empty json marshal")
+
+	var ts TimeString
+	err = json.Unmarshal(timeJSON, &ts)
+	maybePanic(err)
+	if !ts.Valid || !ts.Time.Equal(timeValue) {
+		t.Errorf("bad unmarshaled json: %v", ts)
+	}
+
+	var null TimeString
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should be null")
+	}
+	if s := null.String(); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestTimeStringMustValue(t *testing.T) {
+	v := TimeStringFrom(timeValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewTimeString(time.Time{}, false).MustValue() }, "TimeString")
+}
@@ -0,0 +1,185 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"strings"
+)
+
+// TrimmedString is a nullable string for user input such as form fields.
+// Surrounding whitespace is trimmed when unmarshaling, and a value that is
+// empty after trimming is null. It otherwise behaves like String.
+type TrimmedString String
+
+// NewTrimmedString creates a new TrimmedString. s is used as-is.
+func NewTrimmedString(s string, valid bool) TrimmedString {
+	return TrimmedString{
+		String: s,
+		Valid:  valid,
+	}
+}
+
+// TrimmedStringFrom creates a new TrimmedString from s with surrounding
+// whitespace trimmed. It will be null if s is empty after trimming.
+func TrimmedStringFrom(s string) TrimmedString {
+	s = strings.TrimSpace(s)
+	return NewTrimmedString(s, s != "")
+}
+
+// TrimmedStringFromPtr creates a new TrimmedString like TrimmedStringFrom, or
+// a null TrimmedString if s is nil.
+func TrimmedStringFromPtr(s *string) TrimmedString {
+	if s == nil {
+		return NewTrimmedString("", false)
+	}
+	return TrimmedStringFrom(*s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The string is trimmed, and a blank or whitespace-only string will be null.
+func (t *TrimmedString) UnmarshalJSON(data []byte) error {
+	var s String
+	if err := s.UnmarshalJSON(data); err != nil {
+		t.SetNull()
+		return err
+	}
+	*t = trimString(s)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The text is trimmed, and blank or whitespace-only text will be null.
+func (t *TrimmedString) UnmarshalText(text []byte) error {
+	var s String
+	if err := s.UnmarshalText(text); err != nil {
+		return err
+	}
+	*t = trimString(s)
+	return nil
+}
+
+// trimString trims the value of s, making it null if nothing is left.
+func trimString(s String) TrimmedString {
+	if !s.Valid {
+		return NewTrimmedString("", false)
+	}
+	return TrimmedStringFrom(s.String)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TrimmedString) MarshalJSON() ([]byte, error) {
+	return String(t).MarshalJSON()
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null TrimmedString as chosen by opts.
+func (t TrimmedString) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TrimmedString) MarshalText() ([]byte, error) {
+	return String(t).MarshalText()
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this TrimmedString is null.
+func (t TrimmedString) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, t, t.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (t *TrimmedString) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, t)
+}
+
+// SetValid changes this TrimmedString's value and also sets it to be non-null.
+// v is used as-is.
+func (t *TrimmedString) SetValid(v string) {
+	t.String = v
+	t.Valid = true
+}
+
+// SetNull sets this TrimmedString to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (t *TrimmedString) SetNull() {
+	t.String = ""
+	t.Valid = false
+}
+
+// Ptr returns a pointer to this TrimmedString's value, or a nil pointer if this TrimmedString is null.
+func (t TrimmedString) Ptr() *string {
+	return String(t).Ptr()
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t TrimmedString) ValueOrZero() string {
+	return String(t).ValueOrZero()
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (t TrimmedString) ValueOr(def string) string {
+	return String(t).ValueOr(def)
+}
+
+// Or returns this TrimmedString if it is valid, otherwise other.
+func (t TrimmedString) Or(other TrimmedString) TrimmedString {
+	if !t.Valid {
+		return other
+	}
+	return t
+}
+
+// MustValue returns the inner value, and panics if this TrimmedString is null.
+func (t TrimmedString) MustValue() string {
+	if !t.Valid {
+		panic("null.TrimmedString: MustValue called on invalid value")
+	}
+	return t.String
+}
+
+// IsZero returns true if this TrimmedString is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (t TrimmedString) IsZero() bool {
+	return !t.Valid
+}
+
+// IsNull returns true if this TrimmedString is null, the same as !Valid.
+func (t TrimmedString) IsNull() bool {
+	return !t.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this TrimmedString, or nil if it is null, for
+// a *TrimmedString field tagged omitempty that should leave nulls out.
+func (t TrimmedString) OmitEmpty() *TrimmedString {
+	if !t.Valid {
+		return nil
+	}
+	return &t
+}
+
+// Equal returns true if both TrimmedStrings are null or both hold the same value.
+func (t TrimmedString) Equal(other TrimmedString) bool {
+	return String(t).Equal(String(other))
+}
+
+// Scan implements the Scanner interface.
+// Values from the database are not trimmed.
+func (t *TrimmedString) Scan(value interface{}) error {
+	return (*String)(t).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (t TrimmedString) Value() (driver.Value, error) {
+	return String(t).Value()
+}
+
+// ValueOrNil returns nil if this TrimmedString is null, otherwise the same value as Value.
+func (t TrimmedString) ValueOrNil() interface{} {
+	return String(t).ValueOrNil()
+}
+
+// Randomize for sqlboiler
+func (t *TrimmedString) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	(*String)(t).Randomize(nextInt, fieldType, shouldBeNull)
+}
@@ -0,0 +1,139 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTrimmedStringFrom(t *testing.T) {
+	str := TrimmedStringFrom("  hello  ")
+	assertTrimmedStr(t, str, "hello", "TrimmedStringFrom()")
+
+	blank := TrimmedStringFrom("\t\n")
+	assertNullTrimmedStr(t, blank, "TrimmedStringFrom() whitespace")
+
+	s := " test "
+	ptr := TrimmedStringFromPtr(&s)
+	assertTrimmedStr(t, ptr, "test", "TrimmedStringFromPtr()")
+
+	null := TrimmedStringFromPtr(nil)
+	assertNullTrimmedStr(t, null, "TrimmedStringFromPtr(nil)")
+}
+
+func TestUnmarshalTrimmedString(t *testing.T) {
+	var str TrimmedString
+	err := json.Unmarshal([]byte(`"  hello  "`), &str)
+	maybePanic(err)
+	assertTrimmedStr(t, str, "hello", "trimmed json")
+
+	var ws TrimmedString
+	err = json.Unmarshal([]byte(`"\t\n"`), &ws)
+	maybePanic(err)
+	assertNullTrimmedStr(t, ws, "whitespace json")
+
+	var blank TrimmedString
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullTrimmedStr(t, blank, "blank json string")
+
+	var null TrimmedString
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullTrimmedStr(t, null, "null json")
+
+	var badType TrimmedString
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullTrimmedStr(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalTrimmedString(t *testing.T) {
+	var str TrimmedString
+	err := str.UnmarshalText([]byte("  hello  "))
+	maybePanic(err)
+	assertTrimmedStr(t, str, "hello", "UnmarshalText() trimmed")
+
+	var ws TrimmedString
+	err = ws.UnmarshalText([]byte("\t\n"))
+	maybePanic(err)
+	assertNullTrimmedStr(t, ws, "UnmarshalText() whitespace")
+
+	var blank TrimmedString
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullTrimmedStr(t, blank, "UnmarshalText() empty")
+}
+
+func TestMarshalTrimmedString(t *testing.T) {
+	str := TrimmedStringFrom("test")
+	data, err := json.Marshal(str)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"test"`, "non-empty json marshal")
+
+	data, err = str.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "test", "non-empty text marshal")
+
+	null := NewTrimmedString("", false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestTrimmedStringScanValue(t *testing.T) {
+	var str TrimmedString
+	err := str.Scan(" test ")
+	maybePanic(err)
+	assertTrimmedStr(t, str, " test ", "scanned string")
+	if v, err := str.Value(); v != " test " || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null TrimmedString
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullTrimmedStr(t, null, "scanned null")
+}
+
+func TestTrimmedStringEqual(t *testing.T) {
+	if !NewTrimmedString("", false).Equal(NewTrimmedString("a", false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if !TrimmedStringFrom(" a").Equal(TrimmedStringFrom("a ")) {
+		t.Error("Equal() should be true for the same trimmed value")
+	}
+	if TrimmedStringFrom("a").Equal(TrimmedStringFrom("b")) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestTrimmedStringMustValue(t *testing.T) {
+	v := TrimmedStringFrom("test")
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewTrimmedString("", false).MustValue() }, "TrimmedString")
+}
+
+func TestTrimmedStringSetNull(t *testing.T) {
+	change := TrimmedStringFrom("test")
+	change.SetNull()
+	assertNullTrimmedStr(t, change, "SetNull()")
+	if change.String != "" {
+		t.Errorf("SetNull() should zero the value, got %#v", change.String)
+	}
+}
+
+func assertTrimmedStr(t *testing.T, s TrimmedString, want string, from string) {
+	if s.String != want {
+		t.Errorf("bad %s string: %q ≠ %q\n", from, s.String, want)
+	}
+	if !s.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullTrimmedStr(t *testing.T, s TrimmedString, from string) {
+	if s.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
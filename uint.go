@@ -3,9 +3,12 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/binary"
+	"encoding/xml"
+	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
 )
 
@@ -37,21 +40,27 @@ func UintFromPtr(i *uint) Uint {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (u *Uint) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		u.Valid = false
 		u.Uint = 0
+		u.Valid = false
 		return nil
 	}
 
-	var x uint64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONUint(data, strconv.IntSize, "Uint")
+	u.Uint, u.Valid = uint(x), valid
+	return err
+}
 
-	u.Uint = uint(x)
-	u.Valid = true
-	return nil
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Uint
+// to def and makes it valid, rather than null.
+func (u *Uint) UnmarshalJSONDefault(data []byte, def uint) error {
+	if bytes.Equal(data, NullBytes) {
+		u.SetValid(def)
+		return nil
+	}
+	return u.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -66,7 +75,7 @@ func (u *Uint) UnmarshalText(text []byte) error {
 	if u.Valid {
 		u.Uint = uint(res)
 	}
-	return err
+	return textOverflow("Uint", &u.Uint, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -74,15 +83,142 @@ func (u Uint) MarshalJSON() ([]byte, error) {
 	if !u.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatUint(uint64(u.Uint), 10)), nil
+	return u.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Uint to dst and returns
+// the extended buffer.
+func (u Uint) AppendJSON(dst []byte) []byte {
+	if !u.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendUint(dst, uint64(u.Uint), 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Uint as chosen by opts.
+func (u Uint) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(u)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Uint is null, which UnmarshalText
+// reads back as null.
 func (u Uint) MarshalText() ([]byte, error) {
 	if !u.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(u.Uint), 10)), nil
+	return strconv.AppendUint(make([]byte, 0, maxIntegerLen), uint64(u.Uint), 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Uint as opts.NullText.
+func (u Uint) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(u, u.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Uint is null.
+func (u Uint) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, u, u.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (u *Uint) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Uint is null.
+func (u Uint) MarshalYAML() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.Uint, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (u *Uint) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *uint
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint = *v
+	u.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Uint is null.
+func (u Uint) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !u.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeUint(uint64(u.Uint))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (u *Uint) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *uint
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint = *v
+	u.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Uint is encoded as a single byte.
+func (u Uint) MarshalBinary() ([]byte, error) {
+	if !u.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendUvarint([]byte{encodedValid}, uint64(u.Uint)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *Uint) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Uint")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		u.Uint = 0
+		u.Valid = false
+		return nil
+	}
+	x, err := decodeUvarint(value, "Uint")
+	if err != nil {
+		return err
+	}
+	if x > math.MaxUint {
+		return uintOverflow("Uint", strconv.FormatUint(x, 10), strconv.IntSize)
+	}
+	u.Uint = uint(x)
+	u.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (u Uint) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (u *Uint) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
 }
 
 // SetValid changes this Uint's value and also sets it to be non-null.
@@ -91,6 +227,24 @@ func (u *Uint) SetValid(n uint) {
 	u.Valid = true
 }
 
+// SetChecked sets this Uint to n and makes it non-null, or returns an error and
+// leaves it unchanged if n does not fit in a uint.
+func (u *Uint) SetChecked(n uint64) error {
+	if n > math.MaxUint {
+		return uintOverflow("Uint", strconv.FormatUint(n, 10), strconv.IntSize)
+	}
+	u.Uint = uint(n)
+	u.Valid = true
+	return nil
+}
+
+// SetNull sets this Uint to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (u *Uint) SetNull() {
+	u.Uint = 0
+	u.Valid = false
+}
+
 // Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
 func (u Uint) Ptr() *uint {
 	if !u.Valid {
@@ -99,36 +253,127 @@ func (u Uint) Ptr() *uint {
 	return &u.Uint
 }
 
-// IsZero returns true for invalid Uints, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint) ValueOrZero() uint {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (u Uint) ValueOr(def uint) uint {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint
+}
+
+// Or returns this Uint if it is valid, otherwise other.
+func (u Uint) Or(other Uint) Uint {
+	if !u.Valid {
+		return other
+	}
+	return u
+}
+
+// MustValue returns the inner value, and panics if this Uint is null.
+func (u Uint) MustValue() uint {
+	if !u.Valid {
+		panic("null.Uint: MustValue called on invalid value")
+	}
+	return u.Uint
+}
+
+// Map returns a new Uint holding fn applied to this Uint's value. A null Uint is
+// returned unchanged and fn is not called.
+func (u Uint) Map(fn func(uint) uint) Uint {
+	if !u.Valid {
+		return u
+	}
+	return NewUint(fn(u.Uint), true)
+}
+
+// IsZero returns true if this Uint is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (u Uint) IsZero() bool {
 	return !u.Valid
 }
 
+// IsNull returns true if this Uint is null, the same as !Valid.
+func (u Uint) IsNull() bool {
+	return !u.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Uint, or nil if it is null, for
+// a *Uint field tagged omitempty that should leave nulls out.
+func (u Uint) OmitEmpty() *Uint {
+	if !u.Valid {
+		return nil
+	}
+	return &u
+}
+
+// Equal returns true if both Uints are null or both hold the same value.
+func (u Uint) Equal(other Uint) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.Uint == other.Uint)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Uint is null.
+func (u Uint) String() string {
+	if !u.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatUint(uint64(u.Uint), 10)
+}
+
 // Scan implements the Scanner interface.
 func (u *Uint) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		u.Uint, u.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&u.Uint, value); err != nil {
+		u.Uint, u.Valid = 0, false
+		return scanError("Uint", value, &u.Uint, err)
+	}
 	u.Valid = true
-	return convert.ConvertAssign(&u.Uint, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
+// Values that do not fit in an int64 are returned as a decimal string
+// so that drivers store the correct magnitude.
 func (u Uint) Value() (driver.Value, error) {
 	if !u.Valid {
 		return nil, nil
 	}
+	if uint64(u.Uint) > math.MaxInt64 {
+		return strconv.FormatUint(uint64(u.Uint), 10), nil
+	}
 	return int64(u.Uint), nil
 }
 
+// ValueOrNil returns nil if this Uint is null, otherwise the same value as Value.
+func (u Uint) ValueOrNil() interface{} {
+	if !u.Valid {
+		return nil
+	}
+	if uint64(u.Uint) > math.MaxInt64 {
+		return strconv.FormatUint(uint64(u.Uint), 10)
+	}
+	return int64(u.Uint)
+}
+
 // Randomize for sqlboiler
 func (u *Uint) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		u.Uint = 0
 		u.Valid = false
 	} else {
-		u.Uint = uint(nextInt())
+		u.Uint = uint(randomUint(nextInt, fieldType, strconv.IntSize))
 		u.Valid = true
 	}
 }
@@ -3,11 +3,12 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+	"encoding/binary"
+	"encoding/xml"
 	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
 )
 
@@ -39,25 +40,27 @@ func Uint16FromPtr(i *uint16) Uint16 {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (u *Uint16) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		u.Valid = false
 		u.Uint16 = 0
+		u.Valid = false
 		return nil
 	}
 
-	var x uint64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONUint(data, 16, "Uint16")
+	u.Uint16, u.Valid = uint16(x), valid
+	return err
+}
 
-	if x > math.MaxUint16 {
-		return fmt.Errorf("json: %d overflows max uint8 value", x)
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Uint16
+// to def and makes it valid, rather than null.
+func (u *Uint16) UnmarshalJSONDefault(data []byte, def uint16) error {
+	if bytes.Equal(data, NullBytes) {
+		u.SetValid(def)
+		return nil
 	}
-
-	u.Uint16 = uint16(x)
-	u.Valid = true
-	return nil
+	return u.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -72,7 +75,7 @@ func (u *Uint16) UnmarshalText(text []byte) error {
 	if u.Valid {
 		u.Uint16 = uint16(res)
 	}
-	return err
+	return textOverflow("Uint16", &u.Uint16, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -80,15 +83,142 @@ func (u Uint16) MarshalJSON() ([]byte, error) {
 	if !u.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatUint(uint64(u.Uint16), 10)), nil
+	return u.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Uint16 to dst and returns
+// the extended buffer.
+func (u Uint16) AppendJSON(dst []byte) []byte {
+	if !u.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendUint(dst, uint64(u.Uint16), 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Uint16 as chosen by opts.
+func (u Uint16) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(u)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Uint16 is null, which UnmarshalText
+// reads back as null.
 func (u Uint16) MarshalText() ([]byte, error) {
 	if !u.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(u.Uint16), 10)), nil
+	return strconv.AppendUint(make([]byte, 0, maxIntegerLen), uint64(u.Uint16), 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Uint16 as opts.NullText.
+func (u Uint16) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(u, u.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Uint16 is null.
+func (u Uint16) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, u, u.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (u *Uint16) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Uint16 is null.
+func (u Uint16) MarshalYAML() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.Uint16, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (u *Uint16) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *uint16
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint16 = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint16 = *v
+	u.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Uint16 is null.
+func (u Uint16) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !u.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeUint(uint64(u.Uint16))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (u *Uint16) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *uint16
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint16 = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint16 = *v
+	u.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Uint16 is encoded as a single byte.
+func (u Uint16) MarshalBinary() ([]byte, error) {
+	if !u.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendUvarint([]byte{encodedValid}, uint64(u.Uint16)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *Uint16) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Uint16")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		u.Uint16 = 0
+		u.Valid = false
+		return nil
+	}
+	x, err := decodeUvarint(value, "Uint16")
+	if err != nil {
+		return err
+	}
+	if x > math.MaxUint16 {
+		return uintOverflow("Uint16", strconv.FormatUint(x, 10), 16)
+	}
+	u.Uint16 = uint16(x)
+	u.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (u Uint16) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (u *Uint16) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
 }
 
 // SetValid changes this Uint16's value and also sets it to be non-null.
@@ -97,6 +227,24 @@ func (u *Uint16) SetValid(n uint16) {
 	u.Valid = true
 }
 
+// SetChecked sets this Uint16 to n and makes it non-null, or returns an error and
+// leaves it unchanged if n does not fit in a uint16.
+func (u *Uint16) SetChecked(n uint64) error {
+	if n > math.MaxUint16 {
+		return uintOverflow("Uint16", strconv.FormatUint(n, 10), 16)
+	}
+	u.Uint16 = uint16(n)
+	u.Valid = true
+	return nil
+}
+
+// SetNull sets this Uint16 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (u *Uint16) SetNull() {
+	u.Uint16 = 0
+	u.Valid = false
+}
+
 // Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
 func (u Uint16) Ptr() *uint16 {
 	if !u.Valid {
@@ -105,19 +253,94 @@ func (u Uint16) Ptr() *uint16 {
 	return &u.Uint16
 }
 
-// IsZero returns true for invalid Uint16's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint16) ValueOrZero() uint16 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint16
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (u Uint16) ValueOr(def uint16) uint16 {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint16
+}
+
+// Or returns this Uint16 if it is valid, otherwise other.
+func (u Uint16) Or(other Uint16) Uint16 {
+	if !u.Valid {
+		return other
+	}
+	return u
+}
+
+// MustValue returns the inner value, and panics if this Uint16 is null.
+func (u Uint16) MustValue() uint16 {
+	if !u.Valid {
+		panic("null.Uint16: MustValue called on invalid value")
+	}
+	return u.Uint16
+}
+
+// Map returns a new Uint16 holding fn applied to this Uint16's value. A null Uint16 is
+// returned unchanged and fn is not called.
+func (u Uint16) Map(fn func(uint16) uint16) Uint16 {
+	if !u.Valid {
+		return u
+	}
+	return NewUint16(fn(u.Uint16), true)
+}
+
+// IsZero returns true if this Uint16 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (u Uint16) IsZero() bool {
 	return !u.Valid
 }
 
+// IsNull returns true if this Uint16 is null, the same as !Valid.
+func (u Uint16) IsNull() bool {
+	return !u.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Uint16, or nil if it is null, for
+// a *Uint16 field tagged omitempty that should leave nulls out.
+func (u Uint16) OmitEmpty() *Uint16 {
+	if !u.Valid {
+		return nil
+	}
+	return &u
+}
+
+// Equal returns true if both Uint16s are null or both hold the same value.
+func (u Uint16) Equal(other Uint16) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.Uint16 == other.Uint16)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Uint16 is null.
+func (u Uint16) String() string {
+	if !u.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatUint(uint64(u.Uint16), 10)
+}
+
 // Scan implements the Scanner interface.
 func (u *Uint16) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		u.Uint16, u.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&u.Uint16, value); err != nil {
+		u.Uint16, u.Valid = 0, false
+		return scanError("Uint16", value, &u.Uint16, err)
+	}
 	u.Valid = true
-	return convert.ConvertAssign(&u.Uint16, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -128,13 +351,21 @@ func (u Uint16) Value() (driver.Value, error) {
 	return int64(u.Uint16), nil
 }
 
+// ValueOrNil returns nil if this Uint16 is null, otherwise the same value as Value.
+func (u Uint16) ValueOrNil() interface{} {
+	if !u.Valid {
+		return nil
+	}
+	return int64(u.Uint16)
+}
+
 // Randomize for sqlboiler
 func (u *Uint16) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		u.Uint16 = 0
 		u.Valid = false
 	} else {
-		u.Uint16 = uint16(nextInt() % math.MaxUint16)
+		u.Uint16 = uint16(randomUint(nextInt, fieldType, 16))
 		u.Valid = true
 	}
 }
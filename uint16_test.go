@@ -2,6 +2,8 @@ package null
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"testing"
@@ -81,6 +83,49 @@ func TestUnmarshalUint16Overflow(t *testing.T) {
 	}
 }
 
+func TestUnmarshalUint16Bounds(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{in: "0", valid: true},
+		{in: "-1", valid: false},
+		{in: strconv.FormatUint(math.MaxUint16, 10), valid: true},
+		{in: strconv.FormatUint(math.MaxUint16+1, 10), valid: false},
+	}
+
+	for _, test := range tests {
+		var u Uint16
+		err := json.Unmarshal([]byte(test.in), &u)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.in, err)
+			}
+			if !u.Valid || strconv.FormatUint(uint64(u.Uint16), 10) != test.in {
+				t.Errorf("%s: bad uint16: %#v", test.in, u)
+			}
+		} else {
+			if err == nil {
+				t.Errorf("%s: expected error", test.in)
+			}
+			assertNullUint16(t, u, test.in)
+		}
+	}
+}
+
+func TestUnmarshalUint16Zero(t *testing.T) {
+	var u Uint16
+	err := json.Unmarshal([]byte(`0`), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint16 != 0 {
+		t.Errorf("bad zero uint16: %#v", u)
+	}
+
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+}
+
 func TestTextUnmarshalUint16(t *testing.T) {
 	var i Uint16
 	err := i.UnmarshalText([]byte("65534"))
@@ -93,6 +138,25 @@ func TestTextUnmarshalUint16(t *testing.T) {
 	assertNullUint16(t, blank, "UnmarshalText() empty uint16")
 }
 
+func TestTextUnmarshalUint16Negative(t *testing.T) {
+	for _, s := range []string{"-1", "-99999999999999999999"} {
+		u := Uint16From(1)
+		err := u.UnmarshalText([]byte(s))
+		var o *OverflowError
+		if !errors.As(err, &o) || o.Value != s || o.Bound != "0" {
+			t.Errorf("%s: expected an *OverflowError with bound 0, got %T: %v", s, err, err)
+		}
+		if u.Valid {
+			t.Errorf("%s: should be null", s)
+		}
+	}
+
+	var o *OverflowError
+	if err := new(Uint16).UnmarshalText([]byte("-1.5")); err == nil || errors.As(err, &o) {
+		t.Errorf("-1.5: expected a syntax error, got %v", err)
+	}
+}
+
 func TestMarshalUint16(t *testing.T) {
 	i := Uint16From(65534)
 	data, err := json.Marshal(i)
@@ -157,6 +221,15 @@ func TestUint16SetValid(t *testing.T) {
 	assertUint16(t, change, "SetValid()")
 }
 
+func TestUint16SetNull(t *testing.T) {
+	change := Uint16From(12345)
+	change.SetNull()
+	assertNullUint16(t, change, "SetNull()")
+	if change.Uint16 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Uint16)
+	}
+}
+
 func TestUint16Scan(t *testing.T) {
 	var i Uint16
 	err := i.Scan(65534)
@@ -169,6 +242,92 @@ func TestUint16Scan(t *testing.T) {
 	assertNullUint16(t, null, "scanned null")
 }
 
+func TestUint16Equal(t *testing.T) {
+	null := NewUint16(0, false)
+	other := NewUint16(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Uint16From(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Uint16From(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Uint16From(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestUint16ValueOr(t *testing.T) {
+	valid := Uint16From(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewUint16(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestUnmarshalUint16Negative(t *testing.T) {
+	var u Uint16
+	err := json.Unmarshal([]byte(`-1`), &u)
+	if err == nil || err.Error() != "json: -1 underflows min uint16 value" {
+		t.Errorf("bad error for negative uint16: %v", err)
+	}
+	assertNullUint16(t, u, "negative json")
+}
+
+func TestUint16String(t *testing.T) {
+	v := Uint16From(12345)
+	if s := v.String(); s != "12345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewUint16(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestUint16ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Uint16From(12345), "valid")
+	assertValueOrNil(t, NewUint16(0, false), "null")
+}
+
+func TestUint16MustValue(t *testing.T) {
+	v := Uint16From(12345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewUint16(0, false).MustValue() }, "Uint16")
+}
+
+func TestUint16Map(t *testing.T) {
+	mapped := Uint16From(12).Map(func(v uint16) uint16 { return v * 2 })
+	if !mapped.Valid || mapped.Uint16 != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewUint16(0, false).Map(func(v uint16) uint16 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullUint16(t, null, "Map() null")
+}
+
 func assertUint16(t *testing.T, i Uint16, from string) {
 	if i.Uint16 != 65534 {
 		t.Errorf("bad %s uint16: %d ≠ %d\n", from, i.Uint16, 65534)
@@ -3,12 +3,14 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+	"encoding/binary"
+	"encoding/xml"
 	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Uint32 is an nullable uint32.
@@ -38,26 +40,37 @@ func Uint32FromPtr(i *uint32) Uint32 {
 	return NewUint32(*i, true)
 }
 
+// Uint32FromWrapperpb creates a new Uint32 from a protobuf UInt32Value.
+// It will be null if w is nil.
+func Uint32FromWrapperpb(w *wrapperspb.UInt32Value) Uint32 {
+	if w == nil {
+		return NewUint32(0, false)
+	}
+	return NewUint32(w.Value, true)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (u *Uint32) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		u.Valid = false
 		u.Uint32 = 0
+		u.Valid = false
 		return nil
 	}
 
-	var x uint64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONUint(data, 32, "Uint32")
+	u.Uint32, u.Valid = uint32(x), valid
+	return err
+}
 
-	if x > math.MaxUint32 {
-		return fmt.Errorf("json: %d overflows max uint32 value", x)
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Uint32
+// to def and makes it valid, rather than null.
+func (u *Uint32) UnmarshalJSONDefault(data []byte, def uint32) error {
+	if bytes.Equal(data, NullBytes) {
+		u.SetValid(def)
+		return nil
 	}
-
-	u.Uint32 = uint32(x)
-	u.Valid = true
-	return nil
+	return u.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -72,7 +85,7 @@ func (u *Uint32) UnmarshalText(text []byte) error {
 	if u.Valid {
 		u.Uint32 = uint32(res)
 	}
-	return err
+	return textOverflow("Uint32", &u.Uint32, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -80,15 +93,142 @@ func (u Uint32) MarshalJSON() ([]byte, error) {
 	if !u.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatUint(uint64(u.Uint32), 10)), nil
+	return u.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Uint32 to dst and returns
+// the extended buffer.
+func (u Uint32) AppendJSON(dst []byte) []byte {
+	if !u.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendUint(dst, uint64(u.Uint32), 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Uint32 as chosen by opts.
+func (u Uint32) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(u)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Uint32 is null, which UnmarshalText
+// reads back as null.
 func (u Uint32) MarshalText() ([]byte, error) {
 	if !u.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(u.Uint32), 10)), nil
+	return strconv.AppendUint(make([]byte, 0, maxIntegerLen), uint64(u.Uint32), 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Uint32 as opts.NullText.
+func (u Uint32) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(u, u.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Uint32 is null.
+func (u Uint32) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, u, u.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (u *Uint32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Uint32 is null.
+func (u Uint32) MarshalYAML() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.Uint32, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (u *Uint32) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *uint32
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint32 = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint32 = *v
+	u.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Uint32 is null.
+func (u Uint32) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !u.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeUint(uint64(u.Uint32))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (u *Uint32) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *uint32
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint32 = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint32 = *v
+	u.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Uint32 is encoded as a single byte.
+func (u Uint32) MarshalBinary() ([]byte, error) {
+	if !u.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendUvarint([]byte{encodedValid}, uint64(u.Uint32)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *Uint32) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Uint32")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		u.Uint32 = 0
+		u.Valid = false
+		return nil
+	}
+	x, err := decodeUvarint(value, "Uint32")
+	if err != nil {
+		return err
+	}
+	if x > math.MaxUint32 {
+		return uintOverflow("Uint32", strconv.FormatUint(x, 10), 32)
+	}
+	u.Uint32 = uint32(x)
+	u.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (u Uint32) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (u *Uint32) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
 }
 
 // SetValid changes this Uint32's value and also sets it to be non-null.
@@ -97,6 +237,24 @@ func (u *Uint32) SetValid(n uint32) {
 	u.Valid = true
 }
 
+// SetChecked sets this Uint32 to n and makes it non-null, or returns an error and
+// leaves it unchanged if n does not fit in a uint32.
+func (u *Uint32) SetChecked(n uint64) error {
+	if n > math.MaxUint32 {
+		return uintOverflow("Uint32", strconv.FormatUint(n, 10), 32)
+	}
+	u.Uint32 = uint32(n)
+	u.Valid = true
+	return nil
+}
+
+// SetNull sets this Uint32 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (u *Uint32) SetNull() {
+	u.Uint32 = 0
+	u.Valid = false
+}
+
 // Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
 func (u Uint32) Ptr() *uint32 {
 	if !u.Valid {
@@ -105,19 +263,94 @@ func (u Uint32) Ptr() *uint32 {
 	return &u.Uint32
 }
 
-// IsZero returns true for invalid Uint32's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint32) ValueOrZero() uint32 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint32
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (u Uint32) ValueOr(def uint32) uint32 {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint32
+}
+
+// Or returns this Uint32 if it is valid, otherwise other.
+func (u Uint32) Or(other Uint32) Uint32 {
+	if !u.Valid {
+		return other
+	}
+	return u
+}
+
+// MustValue returns the inner value, and panics if this Uint32 is null.
+func (u Uint32) MustValue() uint32 {
+	if !u.Valid {
+		panic("null.Uint32: MustValue called on invalid value")
+	}
+	return u.Uint32
+}
+
+// Map returns a new Uint32 holding fn applied to this Uint32's value. A null Uint32 is
+// returned unchanged and fn is not called.
+func (u Uint32) Map(fn func(uint32) uint32) Uint32 {
+	if !u.Valid {
+		return u
+	}
+	return NewUint32(fn(u.Uint32), true)
+}
+
+// IsZero returns true if this Uint32 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (u Uint32) IsZero() bool {
 	return !u.Valid
 }
 
+// IsNull returns true if this Uint32 is null, the same as !Valid.
+func (u Uint32) IsNull() bool {
+	return !u.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Uint32, or nil if it is null, for
+// a *Uint32 field tagged omitempty that should leave nulls out.
+func (u Uint32) OmitEmpty() *Uint32 {
+	if !u.Valid {
+		return nil
+	}
+	return &u
+}
+
+// Equal returns true if both Uint32s are null or both hold the same value.
+func (u Uint32) Equal(other Uint32) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.Uint32 == other.Uint32)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Uint32 is null.
+func (u Uint32) String() string {
+	if !u.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatUint(uint64(u.Uint32), 10)
+}
+
 // Scan implements the Scanner interface.
 func (u *Uint32) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		u.Uint32, u.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&u.Uint32, value); err != nil {
+		u.Uint32, u.Valid = 0, false
+		return scanError("Uint32", value, &u.Uint32, err)
+	}
 	u.Valid = true
-	return convert.ConvertAssign(&u.Uint32, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -128,13 +361,29 @@ func (u Uint32) Value() (driver.Value, error) {
 	return int64(u.Uint32), nil
 }
 
+// ValueOrNil returns nil if this Uint32 is null, otherwise the same value as Value.
+func (u Uint32) ValueOrNil() interface{} {
+	if !u.Valid {
+		return nil
+	}
+	return int64(u.Uint32)
+}
+
+// ToWrapperpb returns this Uint32 as a protobuf UInt32Value, or nil if this Uint32 is null.
+func (u Uint32) ToWrapperpb() *wrapperspb.UInt32Value {
+	if !u.Valid {
+		return nil
+	}
+	return wrapperspb.UInt32(u.Uint32)
+}
+
 // Randomize for sqlboiler
 func (u *Uint32) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		u.Uint32 = 0
 		u.Valid = false
 	} else {
-		u.Uint32 = uint32(nextInt() % math.MaxUint32)
+		u.Uint32 = uint32(randomUint(nextInt, fieldType, 32))
 		u.Valid = true
 	}
 }
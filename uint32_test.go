@@ -2,6 +2,8 @@ package null
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"testing"
@@ -81,6 +83,49 @@ func TestUnmarshalUint32Overflow(t *testing.T) {
 	}
 }
 
+func TestUnmarshalUint32Bounds(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{in: "0", valid: true},
+		{in: "-1", valid: false},
+		{in: strconv.FormatUint(math.MaxUint32, 10), valid: true},
+		{in: strconv.FormatUint(math.MaxUint32+1, 10), valid: false},
+	}
+
+	for _, test := range tests {
+		var u Uint32
+		err := json.Unmarshal([]byte(test.in), &u)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.in, err)
+			}
+			if !u.Valid || strconv.FormatUint(uint64(u.Uint32), 10) != test.in {
+				t.Errorf("%s: bad uint32: %#v", test.in, u)
+			}
+		} else {
+			if err == nil {
+				t.Errorf("%s: expected error", test.in)
+			}
+			assertNullUint32(t, u, test.in)
+		}
+	}
+}
+
+func TestUnmarshalUint32Zero(t *testing.T) {
+	var u Uint32
+	err := json.Unmarshal([]byte(`0`), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint32 != 0 {
+		t.Errorf("bad zero uint32: %#v", u)
+	}
+
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+}
+
 func TestTextUnmarshalUint32(t *testing.T) {
 	var i Uint32
 	err := i.UnmarshalText([]byte("4294967294"))
@@ -93,6 +138,25 @@ func TestTextUnmarshalUint32(t *testing.T) {
 	assertNullUint32(t, blank, "UnmarshalText() empty uint32")
 }
 
+func TestTextUnmarshalUint32Negative(t *testing.T) {
+	for _, s := range []string{"-1", "-99999999999999999999"} {
+		u := Uint32From(1)
+		err := u.UnmarshalText([]byte(s))
+		var o *OverflowError
+		if !errors.As(err, &o) || o.Value != s || o.Bound != "0" {
+			t.Errorf("%s: expected an *OverflowError with bound 0, got %T: %v", s, err, err)
+		}
+		if u.Valid {
+			t.Errorf("%s: should be null", s)
+		}
+	}
+
+	var o *OverflowError
+	if err := new(Uint32).UnmarshalText([]byte("-1.5")); err == nil || errors.As(err, &o) {
+		t.Errorf("-1.5: expected a syntax error, got %v", err)
+	}
+}
+
 func TestMarshalUint32(t *testing.T) {
 	i := Uint32From(4294967294)
 	data, err := json.Marshal(i)
@@ -123,7 +187,7 @@ func TestUint32Pointer(t *testing.T) {
 	i := Uint32From(4294967294)
 	ptr := i.Ptr()
 	if *ptr != 4294967294 {
-		t.Errorf("bad %s uint32: %#v ≠ %d\n", "pointer", ptr, 4294967294)
+		t.Errorf("bad %s uint32: %#v ≠ %d\n", "pointer", ptr, uint32(4294967294))
 	}
 
 	null := NewUint32(0, false)
@@ -157,9 +221,18 @@ func TestUint32SetValid(t *testing.T) {
 	assertUint32(t, change, "SetValid()")
 }
 
+func TestUint32SetNull(t *testing.T) {
+	change := Uint32From(12345)
+	change.SetNull()
+	assertNullUint32(t, change, "SetNull()")
+	if change.Uint32 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Uint32)
+	}
+}
+
 func TestUint32Scan(t *testing.T) {
 	var i Uint32
-	err := i.Scan(4294967294)
+	err := i.Scan(int64(4294967294))
 	maybePanic(err)
 	assertUint32(t, i, "scanned uint32")
 
@@ -169,9 +242,95 @@ func TestUint32Scan(t *testing.T) {
 	assertNullUint32(t, null, "scanned null")
 }
 
+func TestUint32Equal(t *testing.T) {
+	null := NewUint32(0, false)
+	other := NewUint32(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Uint32From(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Uint32From(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Uint32From(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestUint32ValueOr(t *testing.T) {
+	valid := Uint32From(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewUint32(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestUnmarshalUint32Negative(t *testing.T) {
+	var u Uint32
+	err := json.Unmarshal([]byte(`-1`), &u)
+	if err == nil || err.Error() != "json: -1 underflows min uint32 value" {
+		t.Errorf("bad error for negative uint32: %v", err)
+	}
+	assertNullUint32(t, u, "negative json")
+}
+
+func TestUint32String(t *testing.T) {
+	v := Uint32From(12345)
+	if s := v.String(); s != "12345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewUint32(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestUint32ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Uint32From(12345), "valid")
+	assertValueOrNil(t, NewUint32(0, false), "null")
+}
+
+func TestUint32MustValue(t *testing.T) {
+	v := Uint32From(12345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewUint32(0, false).MustValue() }, "Uint32")
+}
+
+func TestUint32Map(t *testing.T) {
+	mapped := Uint32From(12).Map(func(v uint32) uint32 { return v * 2 })
+	if !mapped.Valid || mapped.Uint32 != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewUint32(0, false).Map(func(v uint32) uint32 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullUint32(t, null, "Map() null")
+}
+
 func assertUint32(t *testing.T, i Uint32, from string) {
 	if i.Uint32 != 4294967294 {
-		t.Errorf("bad %s uint32: %d ≠ %d\n", from, i.Uint32, 4294967294)
+		t.Errorf("bad %s uint32: %d ≠ %d\n", from, i.Uint32, uint32(4294967294))
 	}
 	if !i.Valid {
 		t.Error(from, "is invalid, but should be valid")
@@ -3,12 +3,14 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
-	"reflect"
+	"encoding/binary"
+	"encoding/xml"
+	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Uint64 is an nullable uint64.
@@ -38,7 +40,17 @@ func Uint64FromPtr(i *uint64) Uint64 {
 	return NewUint64(*i, true)
 }
 
+// Uint64FromWrapperpb creates a new Uint64 from a protobuf UInt64Value.
+// It will be null if w is nil.
+func Uint64FromWrapperpb(w *wrapperspb.UInt64Value) Uint64 {
+	if w == nil {
+		return NewUint64(0, false)
+	}
+	return NewUint64(w.Value, true)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (u *Uint64) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
 		u.Uint64 = 0
@@ -46,31 +58,19 @@ func (u *Uint64) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		// Unmarshal again, directly to int64, to avoid intermediate float64
-		err = json.Unmarshal(data, &u.Uint64)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			u.Valid = false
-			return nil
-		}
-		u.Uint64, err = strconv.ParseUint(str, 10, 64)
-	case nil:
-		u.Valid = false
+	x, valid, err := parseJSONUint(data, 64, "Uint64")
+	u.Uint64, u.Valid = x, valid
+	return err
+}
+
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Uint64
+// to def and makes it valid, rather than null.
+func (u *Uint64) UnmarshalJSONDefault(data []byte, def uint64) error {
+	if bytes.Equal(data, NullBytes) {
+		u.SetValid(def)
 		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint64", reflect.TypeOf(v).Name())
 	}
-
-	u.Valid = (err == nil) && (u.Uint64 != 0)
-	return err
+	return u.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -85,7 +85,7 @@ func (u *Uint64) UnmarshalText(text []byte) error {
 	if u.Valid {
 		u.Uint64 = uint64(res)
 	}
-	return err
+	return textOverflow("Uint64", &u.Uint64, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -93,15 +93,139 @@ func (u Uint64) MarshalJSON() ([]byte, error) {
 	if !u.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatUint(u.Uint64, 10)), nil
+	return u.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Uint64 to dst and returns
+// the extended buffer.
+func (u Uint64) AppendJSON(dst []byte) []byte {
+	if !u.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendUint(dst, u.Uint64, 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Uint64 as chosen by opts.
+func (u Uint64) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(u)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Uint64 is null, which UnmarshalText
+// reads back as null.
 func (u Uint64) MarshalText() ([]byte, error) {
 	if !u.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(u.Uint64, 10)), nil
+	return strconv.AppendUint(make([]byte, 0, maxIntegerLen), u.Uint64, 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Uint64 as opts.NullText.
+func (u Uint64) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(u, u.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Uint64 is null.
+func (u Uint64) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, u, u.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (u *Uint64) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Uint64 is null.
+func (u Uint64) MarshalYAML() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.Uint64, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (u *Uint64) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *uint64
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint64 = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint64 = *v
+	u.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Uint64 is null.
+func (u Uint64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !u.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeUint(u.Uint64)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (u *Uint64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *uint64
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint64 = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint64 = *v
+	u.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Uint64 is encoded as a single byte.
+func (u Uint64) MarshalBinary() ([]byte, error) {
+	if !u.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendUvarint([]byte{encodedValid}, u.Uint64), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *Uint64) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Uint64")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		u.Uint64 = 0
+		u.Valid = false
+		return nil
+	}
+	x, err := decodeUvarint(value, "Uint64")
+	if err != nil {
+		return err
+	}
+	u.Uint64 = x
+	u.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (u Uint64) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (u *Uint64) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
 }
 
 // SetValid changes this Uint64's value and also sets it to be non-null.
@@ -110,6 +234,13 @@ func (u *Uint64) SetValid(n uint64) {
 	u.Valid = true
 }
 
+// SetNull sets this Uint64 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (u *Uint64) SetNull() {
+	u.Uint64 = 0
+	u.Valid = false
+}
+
 // Ptr returns a pointer to this Uint64's value, or a nil pointer if this Uint64 is null.
 func (u Uint64) Ptr() *uint64 {
 	if !u.Valid {
@@ -118,36 +249,153 @@ func (u Uint64) Ptr() *uint64 {
 	return &u.Uint64
 }
 
-// IsZero returns true for invalid Uint64's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint64) ValueOrZero() uint64 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint64
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (u Uint64) ValueOr(def uint64) uint64 {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint64
+}
+
+// Or returns this Uint64 if it is valid, otherwise other.
+func (u Uint64) Or(other Uint64) Uint64 {
+	if !u.Valid {
+		return other
+	}
+	return u
+}
+
+// MustValue returns the inner value, and panics if this Uint64 is null.
+func (u Uint64) MustValue() uint64 {
+	if !u.Valid {
+		panic("null.Uint64: MustValue called on invalid value")
+	}
+	return u.Uint64
+}
+
+// Map returns a new Uint64 holding fn applied to this Uint64's value. A null Uint64 is
+// returned unchanged and fn is not called.
+func (u Uint64) Map(fn func(uint64) uint64) Uint64 {
+	if !u.Valid {
+		return u
+	}
+	return NewUint64(fn(u.Uint64), true)
+}
+
+// HasFlag returns true if every bit of mask is set. A null Uint64 has no
+// flags set.
+func (u Uint64) HasFlag(mask uint64) bool {
+	return u.ValueOrZero()&mask == mask
+}
+
+// SetFlag sets the bits of mask. A null Uint64 is treated as having no flags
+// set, and becomes valid.
+func (u *Uint64) SetFlag(mask uint64) {
+	u.SetValid(u.ValueOrZero() | mask)
+}
+
+// ClearFlag clears the bits of mask. A null Uint64 is treated as having no
+// flags set, and becomes valid with a value of zero.
+func (u *Uint64) ClearFlag(mask uint64) {
+	u.SetValid(u.ValueOrZero() &^ mask)
+}
+
+// IsZero returns true if this Uint64 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (u Uint64) IsZero() bool {
 	return !u.Valid
 }
 
+// IsNull returns true if this Uint64 is null, the same as !Valid.
+func (u Uint64) IsNull() bool {
+	return !u.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Uint64, or nil if it is null, for
+// a *Uint64 field tagged omitempty that should leave nulls out.
+func (u Uint64) OmitEmpty() *Uint64 {
+	if !u.Valid {
+		return nil
+	}
+	return &u
+}
+
+// Equal returns true if both Uint64s are null or both hold the same value.
+func (u Uint64) Equal(other Uint64) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.Uint64 == other.Uint64)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Uint64 is null.
+func (u Uint64) String() string {
+	if !u.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatUint(u.Uint64, 10)
+}
+
 // Scan implements the Scanner interface.
 func (u *Uint64) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		u.Uint64, u.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&u.Uint64, value); err != nil {
+		u.Uint64, u.Valid = 0, false
+		return scanError("Uint64", value, &u.Uint64, err)
+	}
 	u.Valid = true
-	return convert.ConvertAssign(&u.Uint64, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
+// Values that do not fit in an int64 are returned as a decimal string
+// so that drivers store the correct magnitude.
 func (u Uint64) Value() (driver.Value, error) {
 	if !u.Valid {
 		return nil, nil
 	}
+	if u.Uint64 > math.MaxInt64 {
+		return strconv.FormatUint(u.Uint64, 10), nil
+	}
 	return int64(u.Uint64), nil
 }
 
+// ValueOrNil returns nil if this Uint64 is null, otherwise the same value as Value.
+func (u Uint64) ValueOrNil() interface{} {
+	if !u.Valid {
+		return nil
+	}
+	if u.Uint64 > math.MaxInt64 {
+		return strconv.FormatUint(u.Uint64, 10)
+	}
+	return int64(u.Uint64)
+}
+
+// ToWrapperpb returns this Uint64 as a protobuf UInt64Value, or nil if this Uint64 is null.
+func (u Uint64) ToWrapperpb() *wrapperspb.UInt64Value {
+	if !u.Valid {
+		return nil
+	}
+	return wrapperspb.UInt64(u.Uint64)
+}
+
 // Randomize for sqlboiler
 func (u *Uint64) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		u.Uint64 = 0
 		u.Valid = false
 	} else {
-		u.Uint64 = uint64(nextInt())
+		u.Uint64 = uint64(randomUint(nextInt, fieldType, 64))
 		u.Valid = true
 	}
 }
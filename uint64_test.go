@@ -2,6 +2,10 @@ package null
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
 	"testing"
 )
 
@@ -74,6 +78,26 @@ func TestUnmarshalNonUintegerNumber64(t *testing.T) {
 	}
 }
 
+func TestUnmarshalUint64Zero(t *testing.T) {
+	var u Uint64
+	err := json.Unmarshal([]byte(`0`), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint64 != 0 {
+		t.Errorf("bad zero uint64: %#v", u)
+	}
+
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+
+	var str Uint64
+	err = json.Unmarshal([]byte(`"0"`), &str)
+	maybePanic(err)
+	if !str.Valid || str.Uint64 != 0 {
+		t.Errorf("bad zero string uint64: %#v", str)
+	}
+}
+
 func TestTextUnmarshalUint64(t *testing.T) {
 	var i Uint64
 	err := i.UnmarshalText([]byte("18446744073709551614"))
@@ -86,6 +110,25 @@ func TestTextUnmarshalUint64(t *testing.T) {
 	assertNullUint64(t, blank, "UnmarshalText() empty uint64")
 }
 
+func TestTextUnmarshalUint64Negative(t *testing.T) {
+	for _, s := range []string{"-1", "-99999999999999999999"} {
+		u := Uint64From(1)
+		err := u.UnmarshalText([]byte(s))
+		var o *OverflowError
+		if !errors.As(err, &o) || o.Value != s || o.Bound != "0" {
+			t.Errorf("%s: expected an *OverflowError with bound 0, got %T: %v", s, err, err)
+		}
+		if u.Valid {
+			t.Errorf("%s: should be null", s)
+		}
+	}
+
+	var o *OverflowError
+	if err := new(Uint64).UnmarshalText([]byte("-1.5")); err == nil || errors.As(err, &o) {
+		t.Errorf("-1.5: expected a syntax error, got %v", err)
+	}
+}
+
 func TestMarshalUint64(t *testing.T) {
 	i := Uint64From(18446744073709551614)
 	data, err := json.Marshal(i)
@@ -150,6 +193,15 @@ func TestUint64SetValid(t *testing.T) {
 	assertUint64(t, change, "SetValid()")
 }
 
+func TestUint64SetNull(t *testing.T) {
+	change := Uint64From(18446744073709551615)
+	change.SetNull()
+	assertNullUint64(t, change, "SetNull()")
+	if change.Uint64 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Uint64)
+	}
+}
+
 func TestUint64Scan(t *testing.T) {
 	var i Uint64
 	err := i.Scan(uint64(18446744073709551614))
@@ -162,6 +214,223 @@ func TestUint64Scan(t *testing.T) {
 	assertNullUint64(t, null, "scanned null")
 }
 
+func TestUint64Equal(t *testing.T) {
+	null := NewUint64(0, false)
+	other := NewUint64(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Uint64From(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Uint64From(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Uint64From(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestUint64ValueOr(t *testing.T) {
+	valid := Uint64From(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewUint64(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestUnmarshalUint64Negative(t *testing.T) {
+	var u Uint64
+	err := json.Unmarshal([]byte(`-1`), &u)
+	if err == nil || err.Error() != "json: -1 underflows min uint64 value" {
+		t.Errorf("bad error for negative uint64: %v", err)
+	}
+	assertNullUint64(t, u, "negative json")
+
+	var str Uint64
+	err = json.Unmarshal([]byte(`"-1"`), &str)
+	if err == nil || err.Error() != "json: -1 underflows min uint64 value" {
+		t.Errorf("bad error for negative string uint64: %v", err)
+	}
+	assertNullUint64(t, str, "negative string json")
+}
+
+func TestUnmarshalUint64Max(t *testing.T) {
+	var u Uint64
+	err := json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint64, 10)), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint64 != math.MaxUint64 {
+		t.Errorf("bad max uint64: %#v", u)
+	}
+
+	var overflow Uint64
+	err = json.Unmarshal([]byte(`18446744073709551616`), &overflow)
+	if err == nil {
+		panic("err should be present; decoded value overflows uint64")
+	}
+}
+
+func TestUint64Value(t *testing.T) {
+	small := Uint64From(math.MaxInt64)
+	if v, err := small.Value(); v != int64(math.MaxInt64) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	// the first value that no longer fits in an int64
+	boundary := Uint64From(math.MaxInt64 + 1)
+	if v, err := boundary.Value(); v != "9223372036854775808" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+	if v := boundary.ValueOrNil(); v != "9223372036854775808" {
+		t.Error("bad ValueOrNil():", v)
+	}
+
+	large := Uint64From(math.MaxUint64)
+	if v, err := large.Value(); v != "18446744073709551615" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+	if v := large.ValueOrNil(); v != "18446744073709551615" {
+		t.Error("bad ValueOrNil():", v)
+	}
+
+	null := NewUint64(0, false)
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+}
+
+func TestUint64String(t *testing.T) {
+	v := Uint64From(18446744073709551615)
+	if s := v.String(); s != "18446744073709551615" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewUint64(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestUint64ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Uint64From(18446744073709551615), "valid")
+	assertValueOrNil(t, NewUint64(0, false), "null")
+}
+
+func TestUint64MustValue(t *testing.T) {
+	v := Uint64From(18446744073709551615)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewUint64(0, false).MustValue() }, "Uint64")
+}
+
+func TestUint64Map(t *testing.T) {
+	mapped := Uint64From(12).Map(func(v uint64) uint64 { return v * 2 })
+	if !mapped.Valid || mapped.Uint64 != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewUint64(0, false).Map(func(v uint64) uint64 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullUint64(t, null, "Map() null")
+}
+
+func TestUint64Flags(t *testing.T) {
+	const (
+		flagA uint64 = 1 << iota
+		flagB
+		flagC
+	)
+
+	var flags Uint64
+	if flags.HasFlag(flagA) || !flags.HasFlag(0) {
+		t.Error("null should have no flags set")
+	}
+
+	flags.SetFlag(flagA | flagC)
+	if !flags.Valid || flags.Uint64 != flagA|flagC {
+		t.Errorf("bad SetFlag() on null: %#v", flags)
+	}
+	if !flags.HasFlag(flagA) || !flags.HasFlag(flagA|flagC) || flags.HasFlag(flagB) || flags.HasFlag(flagA|flagB) {
+		t.Errorf("bad HasFlag(): %#v", flags)
+	}
+
+	flags.ClearFlag(flagA)
+	if !flags.Valid || flags.Uint64 != flagC {
+		t.Errorf("bad ClearFlag(): %#v", flags)
+	}
+
+	// a stale value in a null is ignored
+	stale := NewUint64(flagB, false)
+	if stale.HasFlag(flagB) {
+		t.Error("null should have no flags set, even with a stale value")
+	}
+	stale.SetFlag(flagA)
+	if !stale.Valid || stale.Uint64 != flagA {
+		t.Errorf("bad SetFlag() on stale null: %#v", stale)
+	}
+
+	cleared := NewUint64(flagB, false)
+	cleared.ClearFlag(flagA)
+	if !cleared.Valid || cleared.Uint64 != 0 {
+		t.Errorf("bad ClearFlag() on null: %#v", cleared)
+	}
+}
+
+func TestUnmarshalUint64Inputs(t *testing.T) {
+	tests := []struct {
+		in    string
+		value uint64
+		valid bool
+		err   bool
+	}{
+		{in: `0`, valid: true},
+		{in: `42`, value: 42, valid: true},
+		{in: `18446744073709551615`, value: math.MaxUint64, valid: true},
+		{in: `18446744073709551616`, err: true},
+		{in: `-1`, err: true},
+		{in: `-0`, err: true},
+		{in: `1e3`, err: true},
+		{in: `01`, err: true},
+		{in: `"42"`, value: 42, valid: true},
+		{in: `"\u0034\u0032"`, value: 42, valid: true},
+		{in: `""`},
+		{in: `"-1"`, err: true},
+		{in: `"+1"`, err: true},
+		{in: `true`, err: true},
+	}
+	for _, test := range tests {
+		var u Uint64
+		err := json.Unmarshal([]byte(test.in), &u)
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error: %v", test.in, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if u.Valid != test.valid || u.Uint64 != test.value {
+			t.Errorf("%s: bad result: %#v", test.in, u)
+		}
+	}
+}
+
 func assertUint64(t *testing.T, i Uint64, from string) {
 	if i.Uint64 != 18446744073709551614 {
 		t.Errorf("bad %s uint64: %d ≠ %d\n", from, i.Uint64, uint64(18446744073709551614))
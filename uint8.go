@@ -3,11 +3,12 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+	"encoding/binary"
+	"encoding/xml"
 	"math"
 	"strconv"
 
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/volatiletech/null/convert"
 )
 
@@ -39,25 +40,27 @@ func Uint8FromPtr(i *uint8) Uint8 {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts a JSON number or a string holding one, and an empty string will be null.
 func (u *Uint8) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, NullBytes) {
-		u.Valid = false
 		u.Uint8 = 0
+		u.Valid = false
 		return nil
 	}
 
-	var x uint64
-	if err := json.Unmarshal(data, &x); err != nil {
-		return err
-	}
+	x, valid, err := parseJSONUint(data, 8, "Uint8")
+	u.Uint8, u.Valid = uint8(x), valid
+	return err
+}
 
-	if x > math.MaxUint8 {
-		return fmt.Errorf("json: %d overflows max uint8 value", x)
+// UnmarshalJSONDefault is like UnmarshalJSON, but a JSON null sets this Uint8
+// to def and makes it valid, rather than null.
+func (u *Uint8) UnmarshalJSONDefault(data []byte, def uint8) error {
+	if bytes.Equal(data, NullBytes) {
+		u.SetValid(def)
+		return nil
 	}
-
-	u.Uint8 = uint8(x)
-	u.Valid = true
-	return nil
+	return u.UnmarshalJSON(data)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -72,7 +75,7 @@ func (u *Uint8) UnmarshalText(text []byte) error {
 	if u.Valid {
 		u.Uint8 = uint8(res)
 	}
-	return err
+	return textOverflow("Uint8", &u.Uint8, string(text), err)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -80,15 +83,142 @@ func (u Uint8) MarshalJSON() ([]byte, error) {
 	if !u.Valid {
 		return NullBytes, nil
 	}
-	return []byte(strconv.FormatUint(uint64(u.Uint8), 10)), nil
+	return u.AppendJSON(make([]byte, 0, maxIntegerLen)), nil
+}
+
+// AppendJSON appends the MarshalJSON encoding of this Uint8 to dst and returns
+// the extended buffer.
+func (u Uint8) AppendJSON(dst []byte) []byte {
+	if !u.Valid {
+		return append(dst, NullBytes...)
+	}
+	return strconv.AppendUint(dst, uint64(u.Uint8), 10)
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null Uint8 as chosen by opts.
+func (u Uint8) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(u)
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Uint8 is null, which UnmarshalText
+// reads back as null.
 func (u Uint8) MarshalText() ([]byte, error) {
 	if !u.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(u.Uint8), 10)), nil
+	return strconv.AppendUint(make([]byte, 0, maxIntegerLen), uint64(u.Uint8), 10), nil
+}
+
+// MarshalTextWith is like MarshalText, but encodes a null Uint8 as opts.NullText.
+func (u Uint8) MarshalTextWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalText(u, u.Valid)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this Uint8 is null.
+func (u Uint8) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, u, u.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (u *Uint8) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this Uint8 is null.
+func (u Uint8) MarshalYAML() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.Uint8, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null will be null.
+func (u *Uint8) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *uint8
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint8 = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint8 = *v
+	u.Valid = true
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this Uint8 is null.
+func (u Uint8) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !u.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeUint(uint64(u.Uint8))
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil will be null.
+func (u *Uint8) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *uint8
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		u.Uint8 = 0
+		u.Valid = false
+		return nil
+	}
+	u.Uint8 = *v
+	u.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null Uint8 is encoded as a single byte.
+func (u Uint8) MarshalBinary() ([]byte, error) {
+	if !u.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return binary.AppendUvarint([]byte{encodedValid}, uint64(u.Uint8)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *Uint8) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "Uint8")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		u.Uint8 = 0
+		u.Valid = false
+		return nil
+	}
+	x, err := decodeUvarint(value, "Uint8")
+	if err != nil {
+		return err
+	}
+	if x > math.MaxUint8 {
+		return uintOverflow("Uint8", strconv.FormatUint(x, 10), 8)
+	}
+	u.Uint8 = uint8(x)
+	u.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (u Uint8) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (u *Uint8) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
 }
 
 // SetValid changes this Uint8's value and also sets it to be non-null.
@@ -97,6 +227,24 @@ func (u *Uint8) SetValid(n uint8) {
 	u.Valid = true
 }
 
+// SetChecked sets this Uint8 to n and makes it non-null, or returns an error and
+// leaves it unchanged if n does not fit in a uint8.
+func (u *Uint8) SetChecked(n uint64) error {
+	if n > math.MaxUint8 {
+		return uintOverflow("Uint8", strconv.FormatUint(n, 10), 8)
+	}
+	u.Uint8 = uint8(n)
+	u.Valid = true
+	return nil
+}
+
+// SetNull sets this Uint8 to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (u *Uint8) SetNull() {
+	u.Uint8 = 0
+	u.Valid = false
+}
+
 // Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
 func (u Uint8) Ptr() *uint8 {
 	if !u.Valid {
@@ -105,19 +253,94 @@ func (u Uint8) Ptr() *uint8 {
 	return &u.Uint8
 }
 
-// IsZero returns true for invalid Uint8's, for future omitempty support (Go 1.4?)
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint8) ValueOrZero() uint8 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint8
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (u Uint8) ValueOr(def uint8) uint8 {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint8
+}
+
+// Or returns this Uint8 if it is valid, otherwise other.
+func (u Uint8) Or(other Uint8) Uint8 {
+	if !u.Valid {
+		return other
+	}
+	return u
+}
+
+// MustValue returns the inner value, and panics if this Uint8 is null.
+func (u Uint8) MustValue() uint8 {
+	if !u.Valid {
+		panic("null.Uint8: MustValue called on invalid value")
+	}
+	return u.Uint8
+}
+
+// Map returns a new Uint8 holding fn applied to this Uint8's value. A null Uint8 is
+// returned unchanged and fn is not called.
+func (u Uint8) Map(fn func(uint8) uint8) Uint8 {
+	if !u.Valid {
+		return u
+	}
+	return NewUint8(fn(u.Uint8), true)
+}
+
+// IsZero returns true if this Uint8 is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
 func (u Uint8) IsZero() bool {
 	return !u.Valid
 }
 
+// IsNull returns true if this Uint8 is null, the same as !Valid.
+func (u Uint8) IsNull() bool {
+	return !u.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this Uint8, or nil if it is null, for
+// a *Uint8 field tagged omitempty that should leave nulls out.
+func (u Uint8) OmitEmpty() *Uint8 {
+	if !u.Valid {
+		return nil
+	}
+	return &u
+}
+
+// Equal returns true if both Uint8s are null or both hold the same value.
+func (u Uint8) Equal(other Uint8) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.Uint8 == other.Uint8)
+}
+
+// String implements fmt.Stringer.
+// It returns the value in its usual string form, or NullDisplay if this Uint8 is null.
+func (u Uint8) String() string {
+	if !u.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatUint(uint64(u.Uint8), 10)
+}
+
 // Scan implements the Scanner interface.
 func (u *Uint8) Scan(value interface{}) error {
+	value = sqlNullValue(value)
 	if value == nil {
 		u.Uint8, u.Valid = 0, false
 		return nil
 	}
+	if err := convert.ConvertAssign(&u.Uint8, value); err != nil {
+		u.Uint8, u.Valid = 0, false
+		return scanError("Uint8", value, &u.Uint8, err)
+	}
 	u.Valid = true
-	return convert.ConvertAssign(&u.Uint8, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -128,13 +351,21 @@ func (u Uint8) Value() (driver.Value, error) {
 	return int64(u.Uint8), nil
 }
 
+// ValueOrNil returns nil if this Uint8 is null, otherwise the same value as Value.
+func (u Uint8) ValueOrNil() interface{} {
+	if !u.Valid {
+		return nil
+	}
+	return int64(u.Uint8)
+}
+
 // Randomize for sqlboiler
 func (u *Uint8) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
 		u.Uint8 = 0
 		u.Valid = false
 	} else {
-		u.Uint8 = uint8(nextInt() % math.MaxUint8)
+		u.Uint8 = uint8(randomUint(nextInt, fieldType, 8))
 		u.Valid = true
 	}
 }
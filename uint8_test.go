@@ -2,6 +2,8 @@ package null
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"testing"
@@ -81,6 +83,49 @@ func TestUnmarshalUint8Overflow(t *testing.T) {
 	}
 }
 
+func TestUnmarshalUint8Bounds(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{in: "0", valid: true},
+		{in: "-1", valid: false},
+		{in: strconv.FormatUint(math.MaxUint8, 10), valid: true},
+		{in: strconv.FormatUint(math.MaxUint8+1, 10), valid: false},
+	}
+
+	for _, test := range tests {
+		var u Uint8
+		err := json.Unmarshal([]byte(test.in), &u)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.in, err)
+			}
+			if !u.Valid || strconv.FormatUint(uint64(u.Uint8), 10) != test.in {
+				t.Errorf("%s: bad uint8: %#v", test.in, u)
+			}
+		} else {
+			if err == nil {
+				t.Errorf("%s: expected error", test.in)
+			}
+			assertNullUint8(t, u, test.in)
+		}
+	}
+}
+
+func TestUnmarshalUint8Zero(t *testing.T) {
+	var u Uint8
+	err := json.Unmarshal([]byte(`0`), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint8 != 0 {
+		t.Errorf("bad zero uint8: %#v", u)
+	}
+
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+}
+
 func TestTextUnmarshalUint8(t *testing.T) {
 	var i Uint8
 	err := i.UnmarshalText([]byte("254"))
@@ -93,6 +138,25 @@ func TestTextUnmarshalUint8(t *testing.T) {
 	assertNullUint8(t, blank, "UnmarshalText() empty uint8")
 }
 
+func TestTextUnmarshalUint8Negative(t *testing.T) {
+	for _, s := range []string{"-1", "-99999999999999999999"} {
+		u := Uint8From(1)
+		err := u.UnmarshalText([]byte(s))
+		var o *OverflowError
+		if !errors.As(err, &o) || o.Value != s || o.Bound != "0" {
+			t.Errorf("%s: expected an *OverflowError with bound 0, got %T: %v", s, err, err)
+		}
+		if u.Valid {
+			t.Errorf("%s: should be null", s)
+		}
+	}
+
+	var o *OverflowError
+	if err := new(Uint8).UnmarshalText([]byte("-1.5")); err == nil || errors.As(err, &o) {
+		t.Errorf("-1.5: expected a syntax error, got %v", err)
+	}
+}
+
 func TestMarshalUint8(t *testing.T) {
 	i := Uint8From(254)
 	data, err := json.Marshal(i)
@@ -157,6 +221,15 @@ func TestUint8SetValid(t *testing.T) {
 	assertUint8(t, change, "SetValid()")
 }
 
+func TestUint8SetNull(t *testing.T) {
+	change := Uint8From(255)
+	change.SetNull()
+	assertNullUint8(t, change, "SetNull()")
+	if change.Uint8 != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Uint8)
+	}
+}
+
 func TestUint8Scan(t *testing.T) {
 	var i Uint8
 	err := i.Scan(254)
@@ -169,6 +242,119 @@ func TestUint8Scan(t *testing.T) {
 	assertNullUint8(t, null, "scanned null")
 }
 
+func TestUint8ScanBounds(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want int64
+	}{
+		{int64(0), 0},
+		{int64(255), 255},
+		{"255", 255},
+		{[]byte("0"), 0},
+	}
+	for _, test := range tests {
+		var u Uint8
+		maybePanic(u.Scan(test.in))
+		if v, err := u.Value(); !u.Valid || v != test.want || err != nil {
+			t.Errorf("%v: bad scanned uint8 or value: %#v, %#v, %v", test.in, u, v, err)
+		}
+	}
+
+	for _, in := range []interface{}{int64(256), "256", int64(-1)} {
+		u := Uint8From(1)
+		if err := u.Scan(in); err == nil {
+			t.Errorf("%v: expected error", in)
+		}
+		assertNullUint8(t, u, fmt.Sprint(in))
+	}
+}
+
+func TestUint8Equal(t *testing.T) {
+	null := NewUint8(0, false)
+	other := NewUint8(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := Uint8From(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := Uint8From(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := Uint8From(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestUint8ValueOr(t *testing.T) {
+	valid := Uint8From(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewUint8(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestUnmarshalUint8Negative(t *testing.T) {
+	var u Uint8
+	err := json.Unmarshal([]byte(`-1`), &u)
+	if err == nil || err.Error() != "json: -1 underflows min uint8 value" {
+		t.Errorf("bad error for negative uint8: %v", err)
+	}
+	assertNullUint8(t, u, "negative json")
+}
+
+func TestUint8String(t *testing.T) {
+	v := Uint8From(255)
+	if s := v.String(); s != "255" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewUint8(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestUint8ValueOrNil(t *testing.T) {
+	assertValueOrNil(t, Uint8From(255), "valid")
+	assertValueOrNil(t, NewUint8(0, false), "null")
+}
+
+func TestUint8MustValue(t *testing.T) {
+	v := Uint8From(255)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewUint8(0, false).MustValue() }, "Uint8")
+}
+
+func TestUint8Map(t *testing.T) {
+	mapped := Uint8From(12).Map(func(v uint8) uint8 { return v * 2 })
+	if !mapped.Valid || mapped.Uint8 != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewUint8(0, false).Map(func(v uint8) uint8 {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullUint8(t, null, "Map() null")
+}
+
 func assertUint8(t *testing.T, i Uint8, from string) {
 	if i.Uint8 != 254 {
 		t.Errorf("bad %s uint8: %d ≠ %d\n", from, i.Uint8, 254)
@@ -2,6 +2,10 @@ package null
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
 	"testing"
 )
 
@@ -63,6 +67,39 @@ func TestUnmarshalNonUintegerNumber(t *testing.T) {
 	}
 }
 
+func TestUnmarshalUintOverflow(t *testing.T) {
+	if strconv.IntSize == 64 {
+		var u Uint
+		err := json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint64, 10)), &u)
+		maybePanic(err)
+		return
+	}
+
+	// Max uint should decode successfully
+	var u Uint
+	err := json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint32, 10)), &u)
+	maybePanic(err)
+
+	// Attempt to overflow
+	err = json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint32+1, 10)), &u)
+	if err == nil {
+		panic("err should be present; decoded value overflows uint")
+	}
+}
+
+func TestUnmarshalUintZero(t *testing.T) {
+	var u Uint
+	err := json.Unmarshal([]byte(`0`), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint != 0 {
+		t.Errorf("bad zero uint: %#v", u)
+	}
+
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "zero json marshal")
+}
+
 func TestTextUnmarshalUint(t *testing.T) {
 	var i Uint
 	err := i.UnmarshalText([]byte("12345"))
@@ -75,6 +112,25 @@ func TestTextUnmarshalUint(t *testing.T) {
 	assertNullUint(t, blank, "UnmarshalText() empty uint")
 }
 
+func TestTextUnmarshalUintNegative(t *testing.T) {
+	for _, s := range []string{"-1", "-99999999999999999999"} {
+		u := UintFrom(1)
+		err := u.UnmarshalText([]byte(s))
+		var o *OverflowError
+		if !errors.As(err, &o) || o.Value != s || o.Bound != "0" {
+			t.Errorf("%s: expected an *OverflowError with bound 0, got %T: %v", s, err, err)
+		}
+		if u.Valid {
+			t.Errorf("%s: should be null", s)
+		}
+	}
+
+	var o *OverflowError
+	if err := new(Uint).UnmarshalText([]byte("-1.5")); err == nil || errors.As(err, &o) {
+		t.Errorf("-1.5: expected a syntax error, got %v", err)
+	}
+}
+
 func TestMarshalUint(t *testing.T) {
 	i := UintFrom(12345)
 	data, err := json.Marshal(i)
@@ -139,6 +195,15 @@ func TestUintSetValid(t *testing.T) {
 	assertUint(t, change, "SetValid()")
 }
 
+func TestUintSetNull(t *testing.T) {
+	change := UintFrom(12345)
+	change.SetNull()
+	assertNullUint(t, change, "SetNull()")
+	if change.Uint != 0 {
+		t.Errorf("SetNull() should zero the value, got %#v", change.Uint)
+	}
+}
+
 func TestUintScan(t *testing.T) {
 	var i Uint
 	err := i.Scan(12345)
@@ -151,6 +216,111 @@ func TestUintScan(t *testing.T) {
 	assertNullUint(t, null, "scanned null")
 }
 
+func TestUintEqual(t *testing.T) {
+	null := NewUint(0, false)
+	other := NewUint(42, false)
+	if !null.Equal(other) {
+		t.Error("Equal() should be true for two nulls")
+	}
+
+	valid := UintFrom(42)
+	if null.Equal(valid) || valid.Equal(null) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+
+	same := UintFrom(42)
+	if !valid.Equal(same) {
+		t.Error("Equal() should be true for equal values")
+	}
+
+	different := UintFrom(24)
+	if valid.Equal(different) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestUintValueOr(t *testing.T) {
+	valid := UintFrom(42)
+	if valid.ValueOrZero() != 42 {
+		t.Errorf("ValueOrZero() should return the value: %v", valid.ValueOrZero())
+	}
+	if valid.ValueOr(24) != 42 {
+		t.Errorf("ValueOr() should return the value: %v", valid.ValueOr(24))
+	}
+
+	// the payload of a null value must never be returned
+	null := NewUint(42, false)
+	if null.ValueOrZero() != 0 {
+		t.Errorf("ValueOrZero() should return zero: %v", null.ValueOrZero())
+	}
+	if null.ValueOr(24) != 24 {
+		t.Errorf("ValueOr() should return the default: %v", null.ValueOr(24))
+	}
+}
+
+func TestUnmarshalUintNegative(t *testing.T) {
+	var u Uint
+	err := json.Unmarshal([]byte(`-1`), &u)
+	if err == nil || err.Error() != "json: -1 underflows min uint value" {
+		t.Errorf("bad error for negative uint: %v", err)
+	}
+	assertNullUint(t, u, "negative json")
+}
+
+func TestUintValue(t *testing.T) {
+	small := UintFrom(42)
+	if v, err := small.Value(); v != int64(42) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	null := NewUint(0, false)
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	if strconv.IntSize == 64 {
+		large := UintFrom(math.MaxUint)
+		if v, err := large.Value(); v != strconv.FormatUint(math.MaxUint, 10) || err != nil {
+			t.Error("bad value or err:", v, err)
+		}
+	}
+}
+
+func TestUintString(t *testing.T) {
+	v := UintFrom(12345)
+	if s := v.String(); s != "12345" {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewUint(0, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestUintValueOrNil(t *testing.T) {
+	assertValueOrNil(t, UintFrom(12345), "valid")
+	assertValueOrNil(t, NewUint(0, false), "null")
+}
+
+func TestUintMustValue(t *testing.T) {
+	v := UintFrom(12345)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewUint(0, false).MustValue() }, "Uint")
+}
+
+func TestUintMap(t *testing.T) {
+	mapped := UintFrom(12).Map(func(v uint) uint { return v * 2 })
+	if !mapped.Valid || mapped.Uint != 24 {
+		t.Errorf("bad Map(): %#v", mapped)
+	}
+
+	null := NewUint(0, false).Map(func(v uint) uint {
+		t.Error("Map() should not call fn for a null value")
+		return v
+	})
+	assertNullUint(t, null, "Map() null")
+}
+
 func assertUint(t *testing.T, i Uint, from string) {
 	if i.Uint != 12345 {
 		t.Errorf("bad %s uint: %d ≠ %d\n", from, i.Uint, 12345)
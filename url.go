@@ -0,0 +1,348 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/volatiletech/sqlboiler/randomize"
+)
+
+// URL is a nullable *url.URL, for link columns. It marshals to and from the
+// string form of the URL, and a nil URL.URL is treated as null. Relative URLs
+// are accepted.
+type URL struct {
+	URL   *url.URL
+	Valid bool
+}
+
+// NewURL creates a new URL
+func NewURL(u *url.URL, valid bool) URL {
+	return URL{
+		URL:   u,
+		Valid: valid,
+	}
+}
+
+// URLFrom creates a new URL that will be null if u is nil. There is no
+// URLFromPtr, since a *url.URL is already a pointer.
+func URLFrom(u *url.URL) URL {
+	return NewURL(u, u != nil)
+}
+
+// ParseURL parses a URL such as "https://example.com/" into a valid URL, as UnmarshalText
+// does, but returns an error rather than a null URL for empty text.
+func ParseURL(s string) (URL, error) {
+	return parseText[URL]("URL", s)
+}
+
+// MustURL is like ParseURL, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustURL(s string) URL {
+	v, err := ParseURL(s)
+	return mustParse("MustURL", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It parses a JSON string with url.Parse, and an empty string will be null.
+func (u *URL) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		u.URL = nil
+		u.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		u.URL = nil
+		u.Valid = false
+		return err
+	}
+
+	return u.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null URL if the input is blank, or if it parses to
+// an empty URL such as "#", which would marshal as blank.
+func (u *URL) UnmarshalText(text []byte) error {
+	var err error
+	if len(text) > 0 {
+		u.URL, err = parseURL(string(text))
+	}
+	if len(text) == 0 || (err == nil && u.URL.String() == "") {
+		u.URL = nil
+	}
+	u.Valid = err == nil && u.URL != nil
+	return err
+}
+
+// parseURL parses s with url.Parse, returning nil on error.
+func parseURL(s string) (*url.URL, error) {
+	parsed, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("null: invalid URL for null.URL: %w", err)
+	}
+	return parsed, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this URL is null.
+func (u URL) MarshalJSON() ([]byte, error) {
+	if !u.Valid || u.URL == nil {
+		return NullBytes, nil
+	}
+	return appendJSONString(nil, u.URL.String()), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null URL as chosen by opts.
+func (u URL) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(u)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this URL is null.
+func (u URL) MarshalText() ([]byte, error) {
+	if !u.Valid || u.URL == nil {
+		return []byte{}, nil
+	}
+	return []byte(u.URL.String()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this URL is null.
+func (u URL) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, u, u.Valid && u.URL != nil)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (u *URL) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this URL is null.
+func (u URL) MarshalYAML() (interface{}, error) {
+	if !u.Valid || u.URL == nil {
+		return nil, nil
+	}
+	return u.URL.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (u *URL) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return u.UnmarshalText(nil)
+	}
+	return u.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this URL is null.
+func (u URL) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !u.Valid || u.URL == nil {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(u.URL.String())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (u *URL) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return u.UnmarshalText(nil)
+	}
+	return u.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null URL is encoded as a single byte.
+func (u URL) MarshalBinary() ([]byte, error) {
+	if !u.Valid || u.URL == nil {
+		return []byte{encodedNull}, nil
+	}
+	return appendLengthPrefixed([]byte{encodedValid}, []byte(u.URL.String())), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *URL) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "URL")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		u.URL = nil
+		u.Valid = false
+		return nil
+	}
+	b, err := decodeLengthPrefixed(value, "URL")
+	if err != nil {
+		return err
+	}
+	parsed, err := parseURL(string(b))
+	if err != nil {
+		return err
+	}
+	u.URL = parsed
+	u.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (u URL) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (u *URL) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
+}
+
+// SetValid changes this URL's value and also sets it to be non-null.
+func (u *URL) SetValid(n *url.URL) {
+	u.URL = n
+	u.Valid = true
+}
+
+// SetNull sets this URL to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (u *URL) SetNull() {
+	u.URL = nil
+	u.Valid = false
+}
+
+// Ptr returns this URL's value, or a nil pointer if this URL is null.
+func (u URL) Ptr() *url.URL {
+	if !u.Valid {
+		return nil
+	}
+	return u.URL
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (u URL) ValueOrZero() *url.URL {
+	if !u.Valid {
+		return nil
+	}
+	return u.URL
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (u URL) ValueOr(def *url.URL) *url.URL {
+	if !u.Valid || u.URL == nil {
+		return def
+	}
+	return u.URL
+}
+
+// Or returns this URL if it is valid, otherwise other.
+func (u URL) Or(other URL) URL {
+	if !u.Valid {
+		return other
+	}
+	return u
+}
+
+// MustValue returns the inner value, and panics if this URL is null.
+func (u URL) MustValue() *url.URL {
+	if !u.Valid || u.URL == nil {
+		panic("null.URL: MustValue called on invalid value")
+	}
+	return u.URL
+}
+
+// IsZero returns true if this URL is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (u URL) IsZero() bool {
+	return !u.Valid || u.URL == nil
+}
+
+// IsNull returns true if this URL is null, the same as !Valid.
+func (u URL) IsNull() bool {
+	return !u.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this URL, or nil if it is null, for
+// a *URL field tagged omitempty that should leave nulls out.
+func (u URL) OmitEmpty() *URL {
+	if !u.Valid {
+		return nil
+	}
+	return &u
+}
+
+// Equal returns true if both URLs are null or both have the same string form.
+func (u URL) Equal(other URL) bool {
+	if u.IsZero() || other.IsZero() {
+		return u.IsZero() == other.IsZero()
+	}
+	return u.URL.String() == other.URL.String()
+}
+
+// String implements fmt.Stringer.
+// It returns the string form of the URL, or NullDisplay if this URL is null.
+func (u URL) String() string {
+	if !u.Valid || u.URL == nil {
+		return NullDisplay
+	}
+	return u.URL.String()
+}
+
+// Scan implements the Scanner interface.
+// It parses a string or []byte with url.Parse, and an empty string will be null.
+func (u *URL) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	switch x := value.(type) {
+	case string:
+		return scanError("URL", value, nil, u.UnmarshalText([]byte(x)))
+	case []byte:
+		return scanError("URL", value, nil, u.UnmarshalText(x))
+	case nil:
+		u.URL, u.Valid = nil, false
+		return nil
+	}
+	u.URL, u.Valid = nil, false
+	return scanError("URL", value, nil, errUnsupportedScanType)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the string form of the URL.
+func (u URL) Value() (driver.Value, error) {
+	if !u.Valid || u.URL == nil {
+		return nil, nil
+	}
+	return u.URL.String(), nil
+}
+
+// ValueOrNil returns nil if this URL is null, otherwise the same value as Value.
+func (u URL) ValueOrNil() interface{} {
+	if !u.Valid || u.URL == nil {
+		return nil
+	}
+	return u.URL.String()
+}
+
+// Randomize for sqlboiler
+func (u *URL) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		u.URL = nil
+		u.Valid = false
+	} else {
+		u.URL = &url.URL{Scheme: "https", Host: "example.com", Path: "/" + randomize.Str(nextInt, 8)}
+		u.Valid = true
+	}
+}
@@ -0,0 +1,229 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+var (
+	urlString      = "https://example.com/path?q=1#frag"
+	urlJSON        = []byte(`"` + urlString + `"`)
+	urlValue, _    = url.Parse(urlString)
+	relativeString = "../images/logo.png"
+)
+
+func TestURLFrom(t *testing.T) {
+	u := URLFrom(urlValue)
+	assertURL(t, u, urlString, "URLFrom()")
+
+	null := URLFrom(nil)
+	assertNullURL(t, null, "URLFrom(nil)")
+}
+
+func TestUnmarshalURL(t *testing.T) {
+	var u URL
+	err := json.Unmarshal(urlJSON, &u)
+	maybePanic(err)
+	assertURL(t, u, urlString, "absolute url json")
+	if !u.URL.IsAbs() || u.URL.Host != "example.com" {
+		t.Errorf("bad absolute url: %#v", u.URL)
+	}
+
+	var rel URL
+	err = json.Unmarshal([]byte(`"`+relativeString+`"`), &rel)
+	maybePanic(err)
+	assertURL(t, rel, relativeString, "relative url json")
+	if rel.URL.IsAbs() {
+		t.Errorf("relative url should not be absolute: %#v", rel.URL)
+	}
+
+	var null URL
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullURL(t, null, "null json")
+
+	var blank URL
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullURL(t, blank, "blank json string")
+
+	var badType URL
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullURL(t, badType, "wrong type json")
+
+	var malformed URL
+	err = json.Unmarshal([]byte(`"http://[::1"`), &malformed)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullURL(t, malformed, "malformed url json")
+}
+
+func TestTextUnmarshalURL(t *testing.T) {
+	var u URL
+	err := u.UnmarshalText([]byte(urlString))
+	maybePanic(err)
+	assertURL(t, u, urlString, "UnmarshalText() url")
+
+	var blank URL
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullURL(t, blank, "UnmarshalText() empty url")
+}
+
+func TestMarshalURL(t *testing.T) {
+	u := URLFrom(urlValue)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(urlJSON), "non-empty json marshal")
+
+	// invalid values should be encoded as null
+	null := NewURL(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	unset := NewURL(nil, true)
+	data, err = json.Marshal(unset)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "nil url json marshal")
+}
+
+func TestMarshalURLText(t *testing.T) {
+	u := URLFrom(urlValue)
+	data, err := u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, urlString, "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewURL(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestURLBinary(t *testing.T) {
+	rel, _ := url.Parse(relativeString)
+	for _, in := range []URL{URLFrom(urlValue), URLFrom(rel), NewURL(nil, false)} {
+		data, err := in.MarshalBinary()
+		maybePanic(err)
+		var out URL
+		err = out.UnmarshalBinary(data)
+		maybePanic(err)
+		if !out.Equal(in) {
+			t.Errorf("bad binary round trip: %v ≠ %v", out, in)
+		}
+	}
+}
+
+func TestURLSetValid(t *testing.T) {
+	change := NewURL(nil, false)
+	assertNullURL(t, change, "SetValid()")
+	change.SetValid(urlValue)
+	assertURL(t, change, urlString, "SetValid()")
+}
+
+func TestURLSetNull(t *testing.T) {
+	change := URLFrom(urlValue)
+	change.SetNull()
+	assertNullURL(t, change, "SetNull()")
+	if change.URL != nil {
+		t.Errorf("SetNull() should zero the value, got %v", change.URL)
+	}
+}
+
+func TestURLEqual(t *testing.T) {
+	if !NewURL(nil, false).Equal(NewURL(urlValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if URLFrom(urlValue).Equal(NewURL(nil, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	same, _ := url.Parse(urlString)
+	if !URLFrom(urlValue).Equal(URLFrom(same)) {
+		t.Error("Equal() should be true for equal URLs")
+	}
+	other, _ := url.Parse("https://example.org/")
+	if URLFrom(urlValue).Equal(URLFrom(other)) {
+		t.Error("Equal() should be false for different URLs")
+	}
+}
+
+func TestURLScanValue(t *testing.T) {
+	var u URL
+	err := u.Scan(urlString)
+	maybePanic(err)
+	assertURL(t, u, urlString, "scanned string")
+	if v, err := u.Value(); v != urlString || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var rel URL
+	err = rel.Scan([]byte(relativeString))
+	maybePanic(err)
+	assertURL(t, rel, relativeString, "scanned []byte")
+
+	var null URL
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullURL(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var malformed URL
+	err = malformed.Scan("%zz")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullURL(t, malformed, "scanned malformed")
+
+	var wrong URL
+	err = wrong.Scan(int64(1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullURL(t, wrong, "scanned wrong type")
+}
+
+func TestURLString(t *testing.T) {
+	v := URLFrom(urlValue)
+	if s := v.String(); s != urlString {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewURL(nil, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestURLValueOrNil(t *testing.T) {
+	assertValueOrNil(t, URLFrom(urlValue), "valid")
+	assertValueOrNil(t, NewURL(nil, false), "null")
+}
+
+func TestURLMustValue(t *testing.T) {
+	v := URLFrom(urlValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewURL(nil, false).MustValue() }, "URL")
+}
+
+func assertURL(t *testing.T, u URL, want string, from string) {
+	if u.URL == nil || u.URL.String() != want {
+		t.Errorf("bad %s url: %v ≠ %s\n", from, u.URL, want)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullURL(t *testing.T, u URL, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,354 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/gofrs/uuid"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// UUID is a nullable uuid.UUID. The all-zeros UUID is read as null wherever it
+// comes from: JSON, text, YAML, msgpack or Scan. UUIDFrom(uuid.Nil) is still
+// valid, but it will read back as null.
+type UUID struct {
+	UUID  uuid.UUID
+	Valid bool
+}
+
+// NewUUID creates a new UUID
+func NewUUID(u uuid.UUID, valid bool) UUID {
+	return UUID{
+		UUID:  u,
+		Valid: valid,
+	}
+}
+
+// UUIDFrom creates a new UUID that will always be valid.
+func UUIDFrom(u uuid.UUID) UUID {
+	return NewUUID(u, true)
+}
+
+// UUIDFromPtr creates a new UUID that will be null if u is nil.
+func UUIDFromPtr(u *uuid.UUID) UUID {
+	if u == nil {
+		return NewUUID(uuid.Nil, false)
+	}
+	return NewUUID(*u, true)
+}
+
+// ParseUUID parses a UUID such as "6ba7b810-9dad-11d1-80b4-00c04fd430c8" into a valid UUID, as UnmarshalText
+// does, but returns an error rather than a null UUID for empty text or the all-zeros UUID.
+func ParseUUID(s string) (UUID, error) {
+	return parseText[UUID]("UUID", s)
+}
+
+// MustUUID is like ParseUUID, but panics if s cannot be parsed. It is meant
+// for test fixtures and package level values written as string literals.
+func MustUUID(s string) UUID {
+	v, err := ParseUUID(s)
+	return mustParse("MustUUID", s, v, err)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// An empty string or the all-zeros UUID will be null.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		u.UUID = uuid.Nil
+		u.Valid = false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		u.UUID, u.Valid = uuid.Nil, false
+		return err
+	}
+
+	return u.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty text or the all-zeros UUID will be null.
+func (u *UUID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		u.UUID = uuid.Nil
+		u.Valid = false
+		return nil
+	}
+
+	var id uuid.UUID
+	if err := id.UnmarshalText(text); err != nil {
+		u.UUID, u.Valid = uuid.Nil, false
+		return err
+	}
+
+	u.UUID = id
+	u.Valid = !id.IsNil()
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return NullBytes, nil
+	}
+	return []byte(`"` + u.UUID.String() + `"`), nil
+}
+
+// MarshalJSONWith is like MarshalJSON, but encodes a null UUID as chosen by opts.
+func (u UUID) MarshalJSONWith(opts MarshalOptions) ([]byte, error) {
+	return opts.marshalJSON(u)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UUID) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte{}, nil
+	}
+	return []byte(u.UUID.String()), nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with xsi:nil="true" if this UUID is null.
+func (u UUID) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(enc, start, u, u.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with xsi:nil="true" or no content will be null.
+func (u *UUID) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a YAML null if this UUID is null.
+func (u UUID) MarshalYAML() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// A YAML null or empty string will be null.
+func (u *UUID) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v *string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return u.UnmarshalText(nil)
+	}
+	return u.UnmarshalText([]byte(*v))
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode a msgpack nil if this UUID is null.
+func (u UUID) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !u.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(u.UUID.String())
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A msgpack nil or empty string will be null.
+func (u *UUID) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var v *string
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		return u.UnmarshalText(nil)
+	}
+	return u.UnmarshalText([]byte(*v))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// A null UUID is encoded as a single byte.
+func (u UUID) MarshalBinary() ([]byte, error) {
+	if !u.Valid {
+		return []byte{encodedNull}, nil
+	}
+	return append([]byte{encodedValid}, u.UUID.Bytes()...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *UUID) UnmarshalBinary(data []byte) error {
+	value, valid, err := decodeHeader(data, "UUID")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		u.UUID = uuid.Nil
+		u.Valid = false
+		return nil
+	}
+	value, err = decodeFixed(value, uuid.Size, "UUID")
+	if err != nil {
+		return err
+	}
+	copy(u.UUID[:], value)
+	u.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (u UUID) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the UnmarshalBinary encoding.
+func (u *UUID) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
+}
+
+// SetValid changes this UUID's value and also sets it to be non-null.
+func (u *UUID) SetValid(n uuid.UUID) {
+	u.UUID = n
+	u.Valid = true
+}
+
+// SetNull sets this UUID to null and zeroes its value, so that no stale value
+// is left in the exported field.
+func (u *UUID) SetNull() {
+	u.UUID = uuid.Nil
+	u.Valid = false
+}
+
+// Ptr returns a pointer to this UUID's value, or a nil pointer if this UUID is null.
+func (u UUID) Ptr() *uuid.UUID {
+	if !u.Valid {
+		return nil
+	}
+	return &u.UUID
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u UUID) ValueOrZero() uuid.UUID {
+	if !u.Valid {
+		return uuid.Nil
+	}
+	return u.UUID
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (u UUID) ValueOr(def uuid.UUID) uuid.UUID {
+	if !u.Valid {
+		return def
+	}
+	return u.UUID
+}
+
+// Or returns this UUID if it is valid, otherwise other.
+func (u UUID) Or(other UUID) UUID {
+	if !u.Valid {
+		return other
+	}
+	return u
+}
+
+// MustValue returns the inner value, and panics if this UUID is null.
+func (u UUID) MustValue() uuid.UUID {
+	if !u.Valid {
+		panic("null.UUID: MustValue called on invalid value")
+	}
+	return u.UUID
+}
+
+// IsZero returns true if this UUID is null, so that the omitzero struct tag
+// option (Go 1.24 and later) leaves out nulls but still encodes valid zero values.
+func (u UUID) IsZero() bool {
+	return !u.Valid
+}
+
+// IsNull returns true if this UUID is null, the same as !Valid.
+func (u UUID) IsNull() bool {
+	return !u.Valid
+}
+
+// OmitEmpty returns a pointer to a copy of this UUID, or nil if it is null, for
+// a *UUID field tagged omitempty that should leave nulls out.
+func (u UUID) OmitEmpty() *UUID {
+	if !u.Valid {
+		return nil
+	}
+	return &u
+}
+
+// Equal returns true if both UUIDs are null or both hold the same value.
+func (u UUID) Equal(other UUID) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.UUID == other.UUID)
+}
+
+// String implements fmt.Stringer.
+// It returns the canonical hyphenated form, or NullDisplay if this UUID is null.
+func (u UUID) String() string {
+	if !u.Valid {
+		return NullDisplay
+	}
+	return u.UUID.String()
+}
+
+// Scan implements the Scanner interface. It accepts the canonical text form
+// as a string or []byte, and the 16 byte binary form. As in UnmarshalText, the
+// all-zeros UUID will be null.
+func (u *UUID) Scan(value interface{}) error {
+	value = sqlNullValue(value)
+	var err error
+	switch x := value.(type) {
+	case string:
+		u.UUID, err = uuid.FromString(x)
+	case []byte:
+		if len(x) == uuid.Size {
+			u.UUID, err = uuid.FromBytes(x)
+		} else {
+			u.UUID, err = uuid.FromString(string(x))
+		}
+	case nil:
+		u.UUID, u.Valid = uuid.Nil, false
+		return nil
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil || u.UUID.IsNil() {
+		u.UUID, u.Valid = uuid.Nil, false
+	} else {
+		u.Valid = true
+	}
+	return scanError("UUID", value, nil, err)
+}
+
+// Value implements the driver Valuer interface.
+func (u UUID) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.String(), nil
+}
+
+// ValueOrNil returns nil if this UUID is null, otherwise the same value as Value.
+func (u UUID) ValueOrNil() interface{} {
+	if !u.Valid {
+		return nil
+	}
+	return u.UUID.String()
+}
+
+// Randomize for sqlboiler
+func (u *UUID) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		u.UUID = uuid.Nil
+		u.Valid = false
+	} else {
+		for i := range u.UUID {
+			u.UUID[i] = byte(nextInt() % 256)
+		}
+		u.UUID.SetVersion(uuid.V4)
+		u.UUID.SetVariant(uuid.VariantRFC4122)
+		u.Valid = true
+	}
+}
@@ -0,0 +1,293 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+)
+
+var (
+	uuidString = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	uuidJSON   = []byte(`"` + uuidString + `"`)
+	uuidValue  = uuid.Must(uuid.FromString(uuidString))
+)
+
+func TestUUIDFrom(t *testing.T) {
+	u := UUIDFrom(uuidValue)
+	assertUUID(t, u, "UUIDFrom()")
+
+	zero := UUIDFrom(uuid.Nil)
+	if !zero.Valid {
+		t.Error("UUIDFrom(uuid.Nil)", "is invalid, but should be valid")
+	}
+}
+
+func TestUUIDFromPtr(t *testing.T) {
+	v := uuidValue
+	u := UUIDFromPtr(&v)
+	assertUUID(t, u, "UUIDFromPtr()")
+
+	null := UUIDFromPtr(nil)
+	assertNullUUID(t, null, "UUIDFromPtr(nil)")
+}
+
+func TestUnmarshalUUID(t *testing.T) {
+	var u UUID
+	err := json.Unmarshal(uuidJSON, &u)
+	maybePanic(err)
+	assertUUID(t, u, "uuid json")
+
+	var null UUID
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUUID(t, null, "null json")
+
+	var blank UUID
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullUUID(t, blank, "blank json string")
+
+	var zero UUID
+	err = json.Unmarshal([]byte(`"00000000-0000-0000-0000-000000000000"`), &zero)
+	maybePanic(err)
+	assertNullUUID(t, zero, "all-zeros json string")
+
+	var badType UUID
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullUUID(t, badType, "wrong type json")
+
+	var invalid UUID
+	err = json.Unmarshal([]byte(`"not-a-uuid"`), &invalid)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullUUID(t, invalid, "invalid uuid json")
+}
+
+func TestTextUnmarshalUUID(t *testing.T) {
+	var u UUID
+	err := u.UnmarshalText([]byte(uuidString))
+	maybePanic(err)
+	assertUUID(t, u, "UnmarshalText() uuid")
+
+	var blank UUID
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUUID(t, blank, "UnmarshalText() empty uuid")
+}
+
+func TestMarshalUUID(t *testing.T) {
+	u := UUIDFrom(uuidValue)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(uuidJSON), "non-empty json marshal")
+
+	// invalid values should be encoded as null
+	null := NewUUID(uuid.Nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalUUIDText(t *testing.T) {
+	u := UUIDFrom(uuidValue)
+	data, err := u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, uuidString, "non-empty text marshal")
+
+	// invalid values should be encoded as an empty string
+	null := NewUUID(uuid.Nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUUIDPointer(t *testing.T) {
+	u := UUIDFrom(uuidValue)
+	ptr := u.Ptr()
+	if *ptr != uuidValue {
+		t.Errorf("bad %s uuid: %#v ≠ %s\n", "pointer", ptr, uuidValue)
+	}
+
+	null := NewUUID(uuid.Nil, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uuid: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUUIDIsZero(t *testing.T) {
+	u := UUIDFrom(uuidValue)
+	if u.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewUUID(uuid.Nil, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestUUIDSetValid(t *testing.T) {
+	change := NewUUID(uuid.Nil, false)
+	assertNullUUID(t, change, "SetValid()")
+	change.SetValid(uuidValue)
+	assertUUID(t, change, "SetValid()")
+}
+
+func TestUUIDSetNull(t *testing.T) {
+	change := UUIDFrom(uuidValue)
+	change.SetNull()
+	assertNullUUID(t, change, "SetNull()")
+	if change.UUID != uuid.Nil {
+		t.Errorf("SetNull() should zero the value, got %#v", change.UUID)
+	}
+}
+
+func TestUUIDEqual(t *testing.T) {
+	if !NewUUID(uuid.Nil, false).Equal(NewUUID(uuidValue, false)) {
+		t.Error("Equal() should be true for two nulls")
+	}
+	if UUIDFrom(uuidValue).Equal(NewUUID(uuid.Nil, false)) {
+		t.Error("Equal() should be false for a null and a valid value")
+	}
+	if !UUIDFrom(uuidValue).Equal(UUIDFrom(uuidValue)) {
+		t.Error("Equal() should be true for equal values")
+	}
+	if UUIDFrom(uuidValue).Equal(UUIDFrom(uuid.Nil)) {
+		t.Error("Equal() should be false for different values")
+	}
+}
+
+func TestUUIDScanValue(t *testing.T) {
+	var u UUID
+	err := u.Scan(uuidString)
+	maybePanic(err)
+	assertUUID(t, u, "scanned string")
+	if v, err := u.Value(); v != uuidString || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var text UUID
+	err = text.Scan([]byte(uuidString))
+	maybePanic(err)
+	assertUUID(t, text, "scanned []byte")
+
+	var binary UUID
+	err = binary.Scan(uuidValue.Bytes())
+	maybePanic(err)
+	assertUUID(t, binary, "scanned binary")
+
+	var null UUID
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUUID(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong UUID
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUUID(t, wrong, "scanned wrong")
+}
+
+func TestUUIDAllZeros(t *testing.T) {
+	// the all-zeros UUID is null whether it comes from the database or JSON
+	zeros := uuid.Nil.String()
+	decoders := map[string]func(u *UUID) error{
+		"Scan string":   func(u *UUID) error { return u.Scan(zeros) },
+		"Scan []byte":   func(u *UUID) error { return u.Scan([]byte(zeros)) },
+		"Scan binary":   func(u *UUID) error { return u.Scan(uuid.Nil.Bytes()) },
+		"UnmarshalText": func(u *UUID) error { return u.UnmarshalText([]byte(zeros)) },
+		"UnmarshalJSON": func(u *UUID) error { return json.Unmarshal([]byte(`"`+zeros+`"`), u) },
+	}
+	for name, decode := range decoders {
+		u := UUIDFrom(uuidValue)
+		maybePanic(decode(&u))
+		assertNullUUID(t, u, name)
+		if u.UUID != uuid.Nil {
+			t.Errorf("%s should zero the value, got %v", name, u.UUID)
+		}
+	}
+
+	// a valid all-zeros UUID reads back as null through either path
+	v, err := UUIDFrom(uuid.Nil).Value()
+	maybePanic(err)
+	var scanned UUID
+	maybePanic(scanned.Scan(v))
+	data, err := json.Marshal(UUIDFrom(uuid.Nil))
+	maybePanic(err)
+	var unmarshaled UUID
+	maybePanic(json.Unmarshal(data, &unmarshaled))
+	if scanned != unmarshaled {
+		t.Errorf("the all-zeros UUID should read back the same way: %#v ≠ %#v", scanned, unmarshaled)
+	}
+
+	bad := UUIDFrom(uuidValue)
+	if err := bad.UnmarshalText([]byte("not-a-uuid")); err == nil {
+		t.Error("expected error")
+	}
+	assertNullUUID(t, bad, "bad text")
+}
+
+func TestUUIDRandomize(t *testing.T) {
+	var seed int64
+	nextInt := func() int64 {
+		seed++
+		return seed
+	}
+
+	var u UUID
+	u.Randomize(nextInt, "uuid", false)
+	if !u.Valid || u.UUID.Version() != uuid.V4 || u.UUID.Variant() != uuid.VariantRFC4122 {
+		t.Errorf("bad randomized uuid: %#v", u)
+	}
+
+	u.Randomize(nextInt, "uuid", true)
+	assertNullUUID(t, u, "Randomize() null")
+}
+
+func TestUUIDString(t *testing.T) {
+	v := UUIDFrom(uuidValue)
+	if s := v.String(); s != uuidString {
+		t.Errorf("bad String(): %q", s)
+	}
+
+	null := NewUUID(uuid.Nil, false)
+	if s := fmt.Sprint(null); s != NullDisplay {
+		t.Errorf("bad null String(): %q", s)
+	}
+}
+
+func TestUUIDValueOrNil(t *testing.T) {
+	assertValueOrNil(t, UUIDFrom(uuidValue), "valid")
+	assertValueOrNil(t, NewUUID(uuid.Nil, false), "null")
+}
+
+func TestUUIDMustValue(t *testing.T) {
+	v := UUIDFrom(uuidValue)
+	assertMustValue(t, v.MustValue(), v.ValueOrZero(), func() { NewUUID(uuid.Nil, false).MustValue() }, "UUID")
+}
+
+func assertUUID(t *testing.T, u UUID, from string) {
+	if u.UUID != uuidValue {
+		t.Errorf("bad %s uuid: %s ≠ %s\n", from, u.UUID, uuidValue)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUUID(t *testing.T, u UUID, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
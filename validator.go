@@ -0,0 +1,42 @@
+package null
+
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterValidators registers ValidatorValue with v for every type in this
+// package, so that validate tags apply to the contained value rather than to
+// the struct. A null value is seen as nil: add omitempty, as in
+// `validate:"omitempty,min=1,max=10"`, to let nulls pass, or required to
+// reject them. A valid zero value is not null, so it is still validated.
+// Generic types must be registered for each instantiation used:
+//
+//	v.RegisterCustomTypeFunc(null.ValidatorValue, null.Null[int]{})
+func RegisterValidators(v *validator.Validate) {
+	v.RegisterCustomTypeFunc(ValidatorValue,
+		BigFloat{}, BigInt{}, BigRat{}, Bool{}, Byte{}, Bytes{}, CIDR{}, Complex64{}, Complex128{}, Date{},
+		Decimal{}, Duration{}, Float32{}, Float64{}, FormattedTime{}, Hstore{},
+		Int{}, IntBool{}, Int8{}, Int16{}, Int32{}, Int64{}, IP{}, JSON{}, LooseString{}, MAC{}, Map{},
+		Rune{}, String{}, StringSlice{}, TextBytes{}, Time{}, TimeString{}, TrimmedString{},
+		Uint{}, Uint8{}, Uint16{}, Uint32{}, Uint64{}, URL{}, UUID{},
+	)
+}
+
+// ValidatorValue is a validator.CustomTypeFunc for the types in this package.
+// It returns nil for a null value, and otherwise a pointer to a copy of the
+// contained value, such as an *int for an Int. The validator looks through the
+// pointer, while omitempty still sees a valid zero value as present.
+func ValidatorValue(field reflect.Value) interface{} {
+	if n, ok := field.Interface().(interface{ IsZero() bool }); ok && n.IsZero() {
+		return nil
+	}
+	if field.Kind() != reflect.Struct || field.NumField() == 0 {
+		return nil
+	}
+	// every type keeps its value in the first field
+	p := reflect.New(field.Field(0).Type())
+	p.Elem().Set(field.Field(0))
+	return p.Interface()
+}
@@ -0,0 +1,56 @@
+package null
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type validatedRecord struct {
+	Count    Int       `validate:"omitempty,min=1,max=10"`
+	Name     String    `validate:"required,max=5"`
+	Ratio    Float64   `validate:"omitempty,gte=0,lte=1"`
+	Attempts Null[int] `validate:"omitempty,min=1"`
+}
+
+func TestRegisterValidators(t *testing.T) {
+	v := validator.New()
+	RegisterValidators(v)
+	v.RegisterCustomTypeFunc(ValidatorValue, Null[int]{})
+
+	valid := validatedRecord{Count: IntFrom(5), Name: StringFrom("bob"), Ratio: Float64From(0.5), Attempts: NullFrom(1)}
+	if err := v.Struct(valid); err != nil {
+		t.Errorf("expected no error: %v", err)
+	}
+
+	// omitempty lets nulls pass, even when they hold a stale out of range value
+	nulls := validatedRecord{Count: NewInt(100, false), Name: StringFrom("bob"), Ratio: NewFloat64(-1, false), Attempts: NewNull(0, false)}
+	if err := v.Struct(nulls); err != nil {
+		t.Errorf("expected no error for nulls: %v", err)
+	}
+
+	// a valid zero value is checked rather than omitted
+	tests := []struct {
+		rec   validatedRecord
+		field string
+		tag   string
+	}{
+		{validatedRecord{Count: IntFrom(0), Name: StringFrom("bob")}, "Count", "min"},
+		{validatedRecord{Count: IntFrom(11), Name: StringFrom("bob")}, "Count", "max"},
+		{validatedRecord{Name: StringFrom("toolong")}, "Name", "max"},
+		{validatedRecord{Name: NewString("bob", false)}, "Name", "required"},
+		{validatedRecord{Name: StringFrom("bob"), Ratio: Float64From(1.5)}, "Ratio", "lte"},
+		{validatedRecord{Name: StringFrom("bob"), Attempts: NullFrom(0)}, "Attempts", "min"},
+	}
+	for _, test := range tests {
+		err := v.Struct(test.rec)
+		errs, ok := err.(validator.ValidationErrors)
+		if !ok || len(errs) != 1 {
+			t.Errorf("%s: expected one validation error, got %v", test.field, err)
+			continue
+		}
+		if errs[0].Field() != test.field || errs[0].Tag() != test.tag {
+			t.Errorf("%s: bad error %v", test.field, errs[0])
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package null
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestWrapperpbConversions(t *testing.T) {
+	conversions := []struct {
+		typ         string
+		valid, null bool
+	}{
+		{"String",
+			StringFromWrapperpb(wrapperspb.String("test")) == StringFrom("test") &&
+				StringFrom("test").ToWrapperpb().GetValue() == "test",
+			!StringFromWrapperpb(nil).Valid && NewString("test", false).ToWrapperpb() == nil},
+		{"Int64",
+			Int64FromWrapperpb(wrapperspb.Int64(-1)) == Int64From(-1) &&
+				Int64From(-1).ToWrapperpb().GetValue() == -1,
+			!Int64FromWrapperpb(nil).Valid && NewInt64(1, false).ToWrapperpb() == nil},
+		{"Int32",
+			Int32FromWrapperpb(wrapperspb.Int32(-1)) == Int32From(-1) &&
+				Int32From(-1).ToWrapperpb().GetValue() == -1,
+			!Int32FromWrapperpb(nil).Valid && NewInt32(1, false).ToWrapperpb() == nil},
+		{"Uint64",
+			Uint64FromWrapperpb(wrapperspb.UInt64(1)) == Uint64From(1) &&
+				Uint64From(1).ToWrapperpb().GetValue() == 1,
+			!Uint64FromWrapperpb(nil).Valid && NewUint64(1, false).ToWrapperpb() == nil},
+		{"Uint32",
+			Uint32FromWrapperpb(wrapperspb.UInt32(1)) == Uint32From(1) &&
+				Uint32From(1).ToWrapperpb().GetValue() == 1,
+			!Uint32FromWrapperpb(nil).Valid && NewUint32(1, false).ToWrapperpb() == nil},
+		{"Float32",
+			Float32FromWrapperpb(wrapperspb.Float(1.5)) == Float32From(1.5) &&
+				Float32From(1.5).ToWrapperpb().GetValue() == 1.5,
+			!Float32FromWrapperpb(nil).Valid && NewFloat32(1, false).ToWrapperpb() == nil},
+		{"Float64",
+			Float64FromWrapperpb(wrapperspb.Double(1.5)) == Float64From(1.5) &&
+				Float64From(1.5).ToWrapperpb().GetValue() == 1.5,
+			!Float64FromWrapperpb(nil).Valid && NewFloat64(1, false).ToWrapperpb() == nil},
+		{"Bool",
+			BoolFromWrapperpb(wrapperspb.Bool(false)) == BoolFrom(false) &&
+				BoolFrom(false).ToWrapperpb() != nil && !BoolFrom(false).ToWrapperpb().GetValue(),
+			!BoolFromWrapperpb(nil).Valid && NewBool(true, false).ToWrapperpb() == nil},
+		{"Bytes",
+			BytesFromWrapperpb(wrapperspb.Bytes([]byte("hi"))).Equal(BytesFrom([]byte("hi"))) &&
+				bytes.Equal(BytesFrom([]byte("hi")).ToWrapperpb().GetValue(), []byte("hi")) &&
+				BytesFromWrapperpb(wrapperspb.Bytes(nil)).Valid,
+			!BytesFromWrapperpb(nil).Valid && NewBytes([]byte("hi"), false).ToWrapperpb() == nil},
+	}
+	for _, c := range conversions {
+		if !c.valid {
+			t.Errorf("bad %s conversion of a valid value to or from a wrapper", c.typ)
+		}
+		if !c.null {
+			t.Errorf("bad %s conversion of a null value to or from a nil wrapper", c.typ)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package null
+
+import (
+	"encoding"
+	"encoding/xml"
+)
+
+// xsiNamespace is the XML Schema instance namespace that defines xsi:nil.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// xsiNilAttrs declares the xsi prefix and marks an element as nil. The prefix
+// is written literally, since encoding/xml would otherwise invent its own.
+var xsiNilAttrs = []xml.Attr{
+	{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+	{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+}
+
+// marshalXML encodes start as an empty element with xsi:nil="true" if valid
+// is false, and otherwise with the text form of m as its content.
+func marshalXML(e *xml.Encoder, start xml.StartElement, m encoding.TextMarshaler, valid bool) error {
+	if !valid {
+		start.Attr = append(start.Attr, xsiNilAttrs...)
+		return e.EncodeElement("", start)
+	}
+	text, err := m.MarshalText()
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(string(text), start)
+}
+
+// unmarshalXML decodes the content of start into u with UnmarshalText. An
+// element with xsi:nil="true" or no content is passed as empty text, which
+// every type treats as null.
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, u encoding.TextUnmarshaler) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	if isXSINil(start) {
+		return u.UnmarshalText(nil)
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+// isXSINil reports whether start has an xsi:nil="true" attribute. A document
+// that uses the xsi prefix without declaring it is accepted too.
+func isXSINil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+	return false
+}
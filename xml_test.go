@@ -0,0 +1,104 @@
+package null
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type xmlRecord struct {
+	XMLName xml.Name `xml:"record"`
+	Str     String   `xml:"str"`
+	Int     Int64    `xml:"int"`
+	Float   Float64  `xml:"float"`
+	Bool    Bool     `xml:"bool"`
+	Time    Time     `xml:"time"`
+	IP      IP       `xml:"ip"`
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	in := xmlRecord{
+		Str:   StringFrom("test"),
+		Int:   Int64From(9223372036854775806),
+		Float: Float64From(1.2345),
+		Bool:  BoolFrom(true),
+		Time:  TimeFrom(timeValue),
+		IP:    IPFrom(ipValue),
+	}
+	data, err := xml.Marshal(in)
+	maybePanic(err)
+	want := "<record><str>test</str><int>9223372036854775806</int><float>1.2345</float>" +
+		"<bool>true</bool><time>" + timeString + "</time><ip>192.168.0.1</ip></record>"
+	assertJSONEquals(t, data, want, "xml marshal")
+
+	var out xmlRecord
+	err = xml.Unmarshal(data, &out)
+	maybePanic(err)
+	assertStr(t, out.Str, "xml string")
+	assertInt64(t, out.Int, "xml int")
+	assertFloat64(t, out.Float, "xml float")
+	assertBool(t, out.Bool, "xml bool")
+	assertTime(t, out.Time, "xml time")
+	assertIP(t, out.IP, ipValue, "xml ip")
+}
+
+func TestXMLNull(t *testing.T) {
+	data, err := xml.Marshal(xmlRecord{})
+	maybePanic(err)
+	nilElem := func(name string) string {
+		return "<" + name + ` xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></` + name + ">"
+	}
+	want := "<record>" + nilElem("str") + nilElem("int") + nilElem("float") +
+		nilElem("bool") + nilElem("time") + nilElem("ip") + "</record>"
+	assertJSONEquals(t, data, want, "xml null marshal")
+
+	// start from valid values to be sure that decoding clears them
+	out := xmlRecord{
+		Str:  StringFrom("test"),
+		Int:  Int64From(9223372036854775806),
+		Bool: BoolFrom(true),
+		Time: TimeFrom(timeValue),
+	}
+	err = xml.Unmarshal(data, &out)
+	maybePanic(err)
+	assertNullStr(t, out.Str, "xml null string")
+	assertNullInt64(t, out.Int, "xml null int")
+	assertNullFloat64(t, out.Float, "xml null float")
+	assertNullBool(t, out.Bool, "xml null bool")
+	assertNullTime(t, out.Time, "xml null time")
+	assertNullIP(t, out.IP, "xml null ip")
+}
+
+func TestUnmarshalXMLNil(t *testing.T) {
+	data := []byte(`<record xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+		`<str xsi:nil="true">ignored</str><int></int><bool/><float xsi:nil="false">1.2345</float></record>`)
+	var out xmlRecord
+	err := xml.Unmarshal(data, &out)
+	maybePanic(err)
+	assertNullStr(t, out.Str, "xsi:nil string")
+	assertNullInt64(t, out.Int, "empty int")
+	assertNullBool(t, out.Bool, "self-closing bool")
+	assertFloat64(t, out.Float, "xsi:nil false float")
+
+	// an undeclared xsi prefix is accepted too
+	var undeclared xmlRecord
+	err = xml.Unmarshal([]byte(`<record><str xsi:nil="true"></str></record>`), &undeclared)
+	maybePanic(err)
+	assertNullStr(t, undeclared.Str, "undeclared xsi:nil string")
+
+	var bad xmlRecord
+	err = xml.Unmarshal([]byte(`<record><int>test</int></record>`), &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestXMLNullTextAttr(t *testing.T) {
+	// xml encodes attributes with MarshalText, which must not fail for a null
+	type elem struct {
+		Name    String        `xml:"name,attr"`
+		Trimmed TrimmedString `xml:"trimmed,attr"`
+	}
+	data, err := xml.Marshal(elem{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `<elem name="" trimmed=""></elem>`, "null text attr xml marshal")
+}
@@ -0,0 +1,154 @@
+package null
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+type yamlPayload struct {
+	Bool     Bool      `yaml:"bool"`
+	Byte     Byte      `yaml:"byte"`
+	Bytes    Bytes     `yaml:"bytes"`
+	Float64  Float64   `yaml:"float64"`
+	Int8     Int8      `yaml:"int8"`
+	Int64    Int64     `yaml:"int64"`
+	Uint64   Uint64    `yaml:"uint64"`
+	String   String    `yaml:"string"`
+	Time     Time      `yaml:"time"`
+	UUID     UUID      `yaml:"uuid"`
+	Decimal  Decimal   `yaml:"decimal"`
+	Duration Duration  `yaml:"duration"`
+	IP       IP        `yaml:"ip"`
+	Null     Null[int] `yaml:"null"`
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	in := yamlPayload{
+		Bool:     BoolFrom(true),
+		Byte:     ByteFrom('b'),
+		Bytes:    BytesFrom([]byte{0xde, 0xad, 0xbe, 0xef}),
+		Float64:  Float64From(1.2345),
+		Int8:     Int8From(-128),
+		Int64:    Int64From(0),
+		Uint64:   Uint64From(18446744073709551615),
+		String:   StringFrom(""),
+		Time:     TimeFrom(timeValue),
+		UUID:     UUIDFrom(uuidValue),
+		Decimal:  DecimalFrom(decimalValue),
+		Duration: DurationFrom(durationValue),
+		IP:       IPFrom(ipValue),
+		Null:     NullFrom(12345),
+	}
+
+	data, err := yaml.Marshal(in)
+	maybePanic(err)
+
+	var out yamlPayload
+	err = yaml.Unmarshal(data, &out)
+	maybePanic(err)
+
+	if !out.Bool.Equal(in.Bool) || !out.Byte.Equal(in.Byte) || !out.Bytes.Equal(in.Bytes) ||
+		!out.Float64.Equal(in.Float64) || !out.Int8.Equal(in.Int8) || !out.Int64.Equal(in.Int64) ||
+		!out.Uint64.Equal(in.Uint64) || !out.String.Equal(in.String) || !out.Time.Equal(in.Time) ||
+		!out.UUID.Equal(in.UUID) || !out.Decimal.Equal(in.Decimal) || !out.Duration.Equal(in.Duration) ||
+		!out.IP.Equal(in.IP) || out.Null != in.Null {
+		t.Errorf("bad yaml round trip:\n%s\n%#v", data, out)
+	}
+}
+
+func TestYAMLRoundTripNull(t *testing.T) {
+	data, err := yaml.Marshal(yamlPayload{})
+	maybePanic(err)
+
+	want := `bool: null
+byte: null
+bytes: null
+float64: null
+int8: null
+int64: null
+uint64: null
+string: null
+time: null
+uuid: null
+decimal: null
+duration: null
+ip: null
+"null": null
+`
+	if string(data) != want {
+		t.Errorf("bad null yaml marshal:\n%s", data)
+	}
+
+	var out yamlPayload
+	err = yaml.Unmarshal(data, &out)
+	maybePanic(err)
+	assertNullInt8(t, out.Int8, "null yaml int8")
+	assertNullStr(t, out.String, "null yaml string")
+	assertNullBytes(t, out.Bytes, "null yaml bytes")
+}
+
+func TestUnmarshalYAMLNull(t *testing.T) {
+	inputs := []string{"int8: ~", "int8: null", "int8:", "{}"}
+	for _, in := range inputs {
+		var out struct {
+			Int8 Int8 `yaml:"int8"`
+		}
+		err := yaml.Unmarshal([]byte(in), &out)
+		maybePanic(err)
+		assertNullInt8(t, out.Int8, in)
+	}
+
+	var out struct {
+		Duration Duration `yaml:"duration"`
+		Time     Time     `yaml:"time"`
+	}
+	err := yaml.Unmarshal([]byte("duration: 5400000000000\ntime: 2012-12-21T21:21:21Z"), &out)
+	maybePanic(err)
+	assertDuration(t, out.Duration, "yaml nanoseconds")
+	if !out.Time.Valid || !out.Time.Time.Equal(timeValue) {
+		t.Errorf("bad yaml time: %#v", out.Time)
+	}
+}
+
+func TestUnmarshalYAMLError(t *testing.T) {
+	var out struct {
+		Int8 Int8 `yaml:"int8"`
+		Uint Uint `yaml:"uint"`
+	}
+	if err := yaml.Unmarshal([]byte("int8: 128"), &out); err == nil {
+		t.Error("expected error for overflowing int8")
+	}
+	if err := yaml.Unmarshal([]byte("uint: -1"), &out); err == nil {
+		t.Error("expected error for negative uint")
+	}
+	assertNullInt8(t, out.Int8, "overflowing yaml")
+}
+
+func TestYAMLByte(t *testing.T) {
+	type payload struct {
+		Byte Byte `yaml:"byte"`
+	}
+	for _, n := range []byte{0, 'b', '"', 200, 255} {
+		data, err := yaml.Marshal(payload{ByteFrom(n)})
+		maybePanic(err)
+		var out payload
+		if err := yaml.Unmarshal(data, &out); err != nil || !out.Byte.Equal(ByteFrom(n)) {
+			t.Errorf("%d: bad yaml round trip through %q: %#v, %v", n, data, out.Byte, err)
+		}
+	}
+
+	// the one-character form of earlier versions still reads back
+	var out payload
+	maybePanic(yaml.Unmarshal([]byte("byte: b"), &out))
+	if !out.Byte.Equal(ByteFrom('b')) {
+		t.Errorf("bad yaml character byte: %#v", out.Byte)
+	}
+
+	for _, in := range []string{"byte: 256", "byte: -1", "byte: bb"} {
+		out := payload{ByteFrom('b')}
+		if err := yaml.Unmarshal([]byte(in), &out); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+	}
+}